@@ -0,0 +1,496 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vmware-go-sdk/vmwarev1"
+	"github.com/go-openapi/strfmt"
+)
+
+// The collectors of a scrape, reported by the vmware_exporter_collector_success metric.
+const (
+	collectorDirectorSites            = "director_sites"
+	collectorVdcs                     = "vdcs"
+	collectorDirectorSiteRegions      = "director_site_regions"
+	collectorMultitenantDirectorSites = "multitenant_director_sites"
+	collectorUsageMeterRegistrations  = "usage_meter_registrations"
+	collectorC2cConnections           = "c2c_connections"
+)
+
+// The known values of the status metrics. Every value gets a series, so that alerts such as "a VDC is modifying for
+// more than an hour" can use "for" durations.
+var (
+	directorSiteStatuses = []string{
+		vmwarev1.DirectorSite_Status_Creating,
+		vmwarev1.DirectorSite_Status_Deleted,
+		vmwarev1.DirectorSite_Status_Deleting,
+		vmwarev1.DirectorSite_Status_ReadyToUse,
+		vmwarev1.DirectorSite_Status_Updating,
+	}
+	pvdcStatuses = []string{
+		vmwarev1.PVDC_Status_Creating,
+		vmwarev1.PVDC_Status_Deleted,
+		vmwarev1.PVDC_Status_Deleting,
+		vmwarev1.PVDC_Status_ReadyToUse,
+		vmwarev1.PVDC_Status_Updating,
+	}
+	vdcStatuses = []string{
+		vmwarev1.VDC_Status_Creating,
+		vmwarev1.VDC_Status_Deleted,
+		vmwarev1.VDC_Status_Deleting,
+		vmwarev1.VDC_Status_Failed,
+		vmwarev1.VDC_Status_Modifying,
+		vmwarev1.VDC_Status_ReadyToUse,
+	}
+	serviceStatuses = []string{
+		vmwarev1.Service_Status_Creating,
+		vmwarev1.Service_Status_Deleted,
+		vmwarev1.Service_Status_Deleting,
+		vmwarev1.Service_Status_ReadyToUse,
+		vmwarev1.Service_Status_Updating,
+	}
+	transitGatewayConnectionStatuses = []string{
+		vmwarev1.TransitGatewayConnection_Status_Creating,
+		vmwarev1.TransitGatewayConnection_Status_Deleting,
+		vmwarev1.TransitGatewayConnection_Status_Detached,
+		vmwarev1.TransitGatewayConnection_Status_Pending,
+		vmwarev1.TransitGatewayConnection_Status_ReadyToUse,
+	}
+	vcdaConnectionStatuses = []string{
+		vmwarev1.VcdaConnection_Status_Creating,
+		vmwarev1.VcdaConnection_Status_Deleted,
+		vmwarev1.VcdaConnection_Status_Deleting,
+		vmwarev1.VcdaConnection_Status_ReadyToUse,
+		vmwarev1.VcdaConnection_Status_Updating,
+	}
+	c2cConnectionStatuses = []string{
+		vmwarev1.VcdaC2c_Status_Creating,
+		vmwarev1.VcdaC2c_Status_Deleted,
+		vmwarev1.VcdaC2c_Status_Deleting,
+		vmwarev1.VcdaC2c_Status_ReadyToUse,
+		vmwarev1.VcdaC2c_Status_Updating,
+	}
+	usageMeterHealths = []string{
+		vmwarev1.UsageMeter_Health_Error,
+		vmwarev1.UsageMeter_Health_Ok,
+		vmwarev1.UsageMeter_Health_Unknown,
+	}
+)
+
+// snapshot holds the results of a scrape of the API.
+type snapshot struct {
+	collectedAt time.Time
+	duration    time.Duration
+
+	directorSites            []vmwarev1.DirectorSite
+	vdcs                     []vmwarev1.VDC
+	directorSiteRegions      []vmwarev1.DirectorSiteRegion
+	multitenantDirectorSites []vmwarev1.MultitenantDirectorSite
+	usageMeterRegistrations  []vmwarev1.UsageMeterRegistration
+	c2cConnections           []vmwarev1.InventoryC2cConnection
+
+	// The errors of the collectors that failed, by collector. The results of a failed collector are those of the
+	// previous scrape.
+	errors map[string]error
+}
+
+// Exporter : Polls the API and exposes the state of the resources as Prometheus metrics.
+type Exporter struct {
+	// The service client used to call the API.
+	Service *vmwarev1.VmwareV1
+
+	// An optional inventory file, as written by vmwarev1.Inventory.WriteFile, whose Cloud to Cloud connections are
+	// exported. The API cannot list these connections, so they are read from the file on every scrape.
+	C2cInventoryPath string
+
+	// The timeout of a scrape. If zero, 5 minutes are used.
+	FetchTimeout time.Duration
+
+	mutex   sync.Mutex
+	current *snapshot
+	now     func() time.Time
+}
+
+// defaultFetchTimeout is the timeout of a scrape when Exporter.FetchTimeout is zero.
+const defaultFetchTimeout = 5 * time.Minute
+
+// NewExporter : Instantiate Exporter
+func NewExporter(service *vmwarev1.VmwareV1) *Exporter {
+	return &Exporter{
+		Service:      service,
+		FetchTimeout: defaultFetchTimeout,
+	}
+}
+
+// currentTime returns the current time, from time.Now unless the clock of the exporter was replaced.
+func (exporter *Exporter) currentTime() time.Time {
+	if exporter.now == nil {
+		return time.Now()
+	}
+	return exporter.now()
+}
+
+// Run scrapes the API once per "interval" until "ctx" is canceled.
+func (exporter *Exporter) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		exporter.Scrape(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Scrape calls the API and replaces the results of the previous scrape. A failed call keeps the previous results of
+// its collector, and is reported by the vmware_exporter_collector_success metric.
+func (exporter *Exporter) Scrape(ctx context.Context) {
+	timeout := exporter.FetchTimeout
+	if timeout <= 0 {
+		timeout = defaultFetchTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	exporter.mutex.Lock()
+	previous := exporter.current
+	exporter.mutex.Unlock()
+	if previous == nil {
+		previous = &snapshot{}
+	}
+
+	start := exporter.currentTime()
+	next := &snapshot{errors: map[string]error{}}
+	service := exporter.Service
+
+	directorSites, _, err := service.ListDirectorSitesWithContext(ctx, service.NewListDirectorSitesOptions())
+	if err == nil {
+		next.directorSites = directorSites.DirectorSites
+	} else {
+		next.directorSites = previous.directorSites
+		next.errors[collectorDirectorSites] = err
+	}
+
+	vdcs, _, err := service.ListVdcsWithContext(ctx, service.NewListVdcsOptions())
+	if err == nil {
+		next.vdcs = vdcs.Vdcs
+	} else {
+		next.vdcs = previous.vdcs
+		next.errors[collectorVdcs] = err
+	}
+
+	regions, _, err := service.ListDirectorSiteRegionsWithContext(ctx, service.NewListDirectorSiteRegionsOptions())
+	if err == nil {
+		next.directorSiteRegions = regions.DirectorSiteRegions
+	} else {
+		next.directorSiteRegions = previous.directorSiteRegions
+		next.errors[collectorDirectorSiteRegions] = err
+	}
+
+	multitenantSites, _, err := service.ListMultitenantDirectorSitesWithContext(ctx, service.NewListMultitenantDirectorSitesOptions())
+	if err == nil {
+		next.multitenantDirectorSites = multitenantSites.MultitenantDirectorSites
+	} else {
+		next.multitenantDirectorSites = previous.multitenantDirectorSites
+		next.errors[collectorMultitenantDirectorSites] = err
+	}
+
+	registrations, _, err := service.ListUsageMeterRegistrationsWithContext(ctx, service.NewListUsageMeterRegistrationsOptions())
+	if err == nil {
+		next.usageMeterRegistrations = registrations.UsageMeterRegistrations
+	} else {
+		next.usageMeterRegistrations = previous.usageMeterRegistrations
+		next.errors[collectorUsageMeterRegistrations] = err
+	}
+
+	if exporter.C2cInventoryPath != "" {
+		inventory, err := vmwarev1.ReadInventoryFile(exporter.C2cInventoryPath)
+		if err == nil {
+			next.c2cConnections = inventory.C2cConnections
+		} else {
+			next.c2cConnections = previous.c2cConnections
+			next.errors[collectorC2cConnections] = err
+		}
+	}
+
+	next.collectedAt = exporter.currentTime()
+	next.duration = next.collectedAt.Sub(start)
+
+	exporter.mutex.Lock()
+	exporter.current = next
+	exporter.mutex.Unlock()
+}
+
+// Handler returns the HTTP handler of the exporter, which serves /metrics and /healthz.
+func (exporter *Exporter) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		_ = exporter.metrics().write(w)
+	})
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintln(w, "ok")
+	})
+	return mux
+}
+
+// siteLabels are the labels shared by the metrics of the resources of a Cloud Director site.
+type siteLabels struct {
+	region        string
+	siteID        string
+	siteName      string
+	resourceGroup string
+}
+
+func (labels siteLabels) pairs(more ...string) []string {
+	return append([]string{
+		"region", labels.region,
+		"director_site_id", labels.siteID,
+		"director_site", labels.siteName,
+		"resource_group", labels.resourceGroup,
+	}, more...)
+}
+
+// metrics returns the metrics of the last scrape. Durations are computed at the time of the request.
+func (exporter *Exporter) metrics() *metricSet {
+	exporter.mutex.Lock()
+	current := exporter.current
+	exporter.mutex.Unlock()
+
+	set := newMetricSet()
+	if current == nil {
+		set.add("vmware_exporter_up", "Whether the exporter has completed a scrape of the API.", 0)
+		return set
+	}
+	now := exporter.currentTime()
+	set.add("vmware_exporter_up", "Whether the exporter has completed a scrape of the API.", 1)
+	set.add("vmware_exporter_last_scrape_timestamp_seconds", "The time of the last scrape of the API.",
+		float64(current.collectedAt.Unix()))
+	set.add("vmware_exporter_scrape_duration_seconds", "The duration of the last scrape of the API.",
+		current.duration.Seconds())
+	collectors := []string{
+		collectorDirectorSites,
+		collectorVdcs,
+		collectorDirectorSiteRegions,
+		collectorMultitenantDirectorSites,
+		collectorUsageMeterRegistrations,
+	}
+	if exporter.C2cInventoryPath != "" {
+		collectors = append(collectors, collectorC2cConnections)
+	}
+	for _, collector := range collectors {
+		success := 1.0
+		if current.errors[collector] != nil {
+			success = 0
+		}
+		set.add("vmware_exporter_collector_success", "Whether the last call of a collector succeeded.", success,
+			"collector", collector)
+	}
+
+	// The region of each data center and the labels of each site.
+	dataCenterRegions := map[string]string{}
+	for _, region := range current.directorSiteRegions {
+		for _, dataCenter := range region.DataCenters {
+			dataCenterRegions[stringValue(dataCenter.Name)] = stringValue(region.Name)
+		}
+	}
+	sites := map[string]siteLabels{}
+	pvdcDataCenters := map[string]string{}
+	for _, site := range current.multitenantDirectorSites {
+		sites[stringValue(site.ID)] = siteLabels{
+			region:   stringValue(site.Region),
+			siteID:   stringValue(site.ID),
+			siteName: stringValue(site.Name),
+		}
+		for _, pvdc := range site.Pvdcs {
+			pvdcDataCenters[stringValue(pvdc.ID)] = stringValue(pvdc.DataCenterName)
+		}
+	}
+	for _, site := range current.directorSites {
+		labels := siteLabels{siteID: stringValue(site.ID), siteName: stringValue(site.Name)}
+		if site.ResourceGroup != nil {
+			labels.resourceGroup = stringValue(site.ResourceGroup.Name)
+		}
+		for _, pvdc := range site.Pvdcs {
+			pvdcDataCenters[stringValue(pvdc.ID)] = stringValue(pvdc.DataCenterName)
+			if labels.region == "" {
+				labels.region = dataCenterRegions[stringValue(pvdc.DataCenterName)]
+			}
+		}
+		sites[labels.siteID] = labels
+	}
+
+	for _, site := range current.directorSites {
+		labels := sites[stringValue(site.ID)]
+		set.addStatus("vmware_director_site_status", "The status of a Cloud Director site.",
+			stringValue(site.Status), directorSiteStatuses, labels.pairs("type", stringValue(site.Type))...)
+		addPending(set, now, "director_site", stringValue(site.ID), site.OrderedAt, site.ProvisionedAt, labels)
+
+		for _, pvdc := range site.Pvdcs {
+			dataCenter := stringValue(pvdc.DataCenterName)
+			pvdcLabels := labels
+			if region := dataCenterRegions[dataCenter]; region != "" {
+				pvdcLabels.region = region
+			}
+			set.addStatus("vmware_pvdc_status", "The status of a resource pool.", stringValue(pvdc.Status), pvdcStatuses,
+				pvdcLabels.pairs("pvdc_id", stringValue(pvdc.ID), "pvdc", stringValue(pvdc.Name), "data_center", dataCenter)...)
+
+			for _, cluster := range pvdc.Clusters {
+				clusterPairs := pvdcLabels.pairs("pvdc_id", stringValue(pvdc.ID), "cluster_id", stringValue(cluster.ID),
+					"cluster", stringValue(cluster.Name), "host_profile", stringValue(cluster.HostProfile))
+				set.addStatus("vmware_cluster_status", "The status of a cluster.", stringValue(cluster.Status),
+					pvdcStatuses, clusterPairs...)
+				set.add("vmware_cluster_hosts", "The number of hosts of a cluster.", float64(int64Value(cluster.HostCount)),
+					clusterPairs...)
+				addFileShares(set, cluster.FileShares, clusterPairs)
+			}
+		}
+
+		for _, service := range site.Services {
+			set.addStatus("vmware_service_status", "The status of a service of a Cloud Director site.",
+				stringValue(service.Status), serviceStatuses,
+				labels.pairs("service_id", stringValue(service.ID), "service", stringValue(service.Name))...)
+			addPending(set, now, "service", stringValue(service.ID), service.OrderedAt, service.ProvisionedAt, labels)
+
+			for _, connection := range service.Connections {
+				set.addStatus("vmware_vcda_connection_status", "The status of a VCDA connection endpoint.",
+					stringValue(connection.Status), vcdaConnectionStatuses,
+					labels.pairs("connection_id", stringValue(connection.ID), "type", stringValue(connection.Type),
+						"data_center", stringValue(connection.DataCenterName))...)
+			}
+			for _, sobr := range service.Sobrs {
+				set.add("vmware_sobr_size", "The size of a scale-out backup repository, as reported by the API.",
+					float64(int64Value(sobr.Size)),
+					labels.pairs("service_id", stringValue(service.ID), "sobr_id", stringValue(sobr.ID),
+						"sobr", stringValue(sobr.Name), "storage_type", stringValue(sobr.StorageType),
+						"data_center", stringValue(sobr.DataCenter), "status", stringValue(sobr.Status))...)
+			}
+		}
+	}
+
+	for _, vdc := range current.vdcs {
+		labels := siteLabels{}
+		pvdcID := ""
+		if vdc.DirectorSite != nil {
+			labels = sites[stringValue(vdc.DirectorSite.ID)]
+			labels.siteID = stringValue(vdc.DirectorSite.ID)
+			if vdc.DirectorSite.Pvdc != nil {
+				pvdcID = stringValue(vdc.DirectorSite.Pvdc.ID)
+				if region := dataCenterRegions[pvdcDataCenters[pvdcID]]; region != "" {
+					labels.region = region
+				}
+			}
+		}
+		vdcPairs := labels.pairs("vdc_id", stringValue(vdc.ID), "vdc", stringValue(vdc.Name))
+		set.addStatus("vmware_vdc_status", "The status of a virtual data center.", stringValue(vdc.Status), vdcStatuses,
+			append(vdcPairs, "type", stringValue(vdc.Type), "pvdc_id", pvdcID)...)
+		addPending(set, now, "vdc", stringValue(vdc.ID), vdc.OrderedAt, vdc.ProvisionedAt, labels)
+
+		edgeCounts := map[[2]string]int{}
+		for _, edge := range vdc.Edges {
+			edgeCounts[[2]string{stringValue(edge.Type), stringValue(edge.Size)}]++
+			for _, transitGateway := range edge.TransitGateways {
+				for _, connection := range transitGateway.Connections {
+					set.addStatus("vmware_transit_gateway_connection_status",
+						"The status of a connection of a transit gateway to an edge.", stringValue(connection.Status),
+						transitGatewayConnectionStatuses,
+						append(vdcPairs, "edge_id", stringValue(edge.ID), "transit_gateway_id", stringValue(transitGateway.ID),
+							"connection", stringValue(connection.Name), "zone", stringValue(connection.Zone))...)
+				}
+			}
+		}
+		for key, count := range edgeCounts {
+			set.add("vmware_vdc_edges", "The number of edges of a virtual data center, by type and size.", float64(count),
+				append(vdcPairs, "edge_type", key[0], "edge_size", key[1])...)
+		}
+	}
+
+	for _, registration := range current.usageMeterRegistrations {
+		health, usageMeterID := "", ""
+		if registration.UsageMeter != nil {
+			health = stringValue(registration.UsageMeter.Health)
+			usageMeterID = stringValue(registration.UsageMeter.ID)
+		}
+		set.addStatus("vmware_usage_meter_health", "The health of a registered Usage Meter.", health, usageMeterHealths,
+			"registration_id", stringValue(registration.ID), "registration", stringValue(registration.Name),
+			"usage_meter_id", usageMeterID)
+	}
+
+	for _, c2c := range current.c2cConnections {
+		labels := sites[c2c.DirectorSiteID]
+		labels.siteID = c2c.DirectorSiteID
+		set.addStatus("vmware_vcda_c2c_connection_status", "The status of a VCDA Cloud to Cloud connection.",
+			stringValue(c2c.Connection.Status), c2cConnectionStatuses,
+			labels.pairs("connection_id", stringValue(c2c.Connection.ID),
+				"peer_site", stringValue(c2c.Connection.PeerSiteName), "peer_region", stringValue(c2c.Connection.PeerRegion))...)
+	}
+	return set
+}
+
+// addPending adds the time since a resource was ordered, while it is not provisioned.
+func addPending(set *metricSet, now time.Time, resource string, id string, orderedAt *strfmt.DateTime,
+	provisionedAt *strfmt.DateTime, labels siteLabels) {
+	if orderedAt == nil || provisionedAt != nil {
+		return
+	}
+	set.add("vmware_pending_provisioning_seconds", "The time since a resource that is not provisioned yet was ordered.",
+		now.Sub(time.Time(*orderedAt)).Seconds(), labels.pairs("resource", resource, "id", id)...)
+}
+
+// addFileShares adds the size of each storage tier of "fileShares".
+func addFileShares(set *metricSet, fileShares *vmwarev1.FileShares, pairs []string) {
+	if fileShares == nil {
+		return
+	}
+	tiers := []struct {
+		iops string
+		size *int64
+	}{
+		{"0.25", fileShares.STORAGEPOINTTWOFIVEIOPSGB},
+		{"2", fileShares.STORAGETWOIOPSGB},
+		{"4", fileShares.STORAGEFOURIOPSGB},
+		{"10", fileShares.STORAGETENIOPSGB},
+	}
+	for _, tier := range tiers {
+		if tier.size == nil {
+			continue
+		}
+		set.add("vmware_cluster_file_shares_gigabytes", "The size of the file shares of a cluster, by IOPS/GB tier.",
+			float64(*tier.size), append(pairs, "iops_per_gb", tier.iops)...)
+	}
+}
+
+func stringValue(value *string) string {
+	return core.StringNilMapper(value)
+}
+
+func int64Value(value *int64) int64 {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
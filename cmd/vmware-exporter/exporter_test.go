@@ -0,0 +1,196 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vmware-go-sdk/vmwarev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const exporterDirectorSitesJSON = `{"director_sites": [{"id": "site1", "name": "site-one", "status": "ready_to_use", "type": "single_tenant",
+	"ordered_at": "2026-01-01T10:00:00.000Z", "provisioned_at": "2026-01-01T11:00:00.000Z",
+	"resource_group": {"id": "rg1", "name": "Default", "crn": "crn:rg1"},
+	"pvdcs": [{"id": "pvdc1", "name": "pvdc-one", "data_center_name": "dal10", "status": "ready_to_use",
+		"clusters": [{"id": "cluster1", "name": "cluster-one", "host_count": 3, "host_profile": "BM_2S_20_CORES_192_GB",
+			"status": "ready_to_use", "file_shares": {"STORAGE_TWO_IOPS_GB": 2000, "STORAGE_TEN_IOPS_GB": 100}}]}],
+	"services": [{"id": "svc1", "name": "veeam", "status": "creating", "ordered_at": "2026-01-01T11:30:00.000Z",
+		"connections": [], "sobrs": [{"id": "sobr1", "name": "repo \"one\"", "size": 50, "storage_type": "cos", "type": "default", "status": "ready_to_use"}]},
+		{"id": "svc2", "name": "vcda", "status": "ready_to_use", "ordered_at": "2026-01-01T10:00:00.000Z", "provisioned_at": "2026-01-01T10:30:00.000Z",
+		"connections": [{"id": "conn1", "status": "ready_to_use", "type": "private", "data_center_name": "dal10"}], "sobrs": []}]}]}`
+
+const exporterVdcsJSON = `{"vdcs": [{"id": "vdc1", "name": "vdc-one", "status": "modifying", "type": "single_tenant",
+	"ordered_at": "2026-01-01T10:00:00.000Z", "provisioned_at": "2026-01-01T10:30:00.000Z",
+	"director_site": {"id": "site1", "pvdc": {"id": "pvdc1"}},
+	"edges": [{"id": "edge1", "type": "performance", "size": "medium", "status": "ready_to_use",
+		"transit_gateways": [{"id": "tgw1", "status": "ready_to_use", "connections": [{"name": "conn-a", "status": "detached", "zone": "us-south-1"}]}]},
+		{"id": "edge2", "type": "performance", "size": "medium", "status": "ready_to_use", "transit_gateways": []}]},
+	{"id": "vdc2", "name": "vdc-two", "status": "creating", "type": "multitenant", "ordered_at": "2026-01-01T11:00:00.000Z",
+	"director_site": {"id": "mt1", "pvdc": {"id": "mtpvdc1"}}, "edges": []}]}`
+
+const exporterRegionsJSON = `{"director_site_regions": [{"name": "us-south", "data_centers": [{"name": "dal10"}]},
+	{"name": "eu-de", "data_centers": [{"name": "fra02"}]}]}`
+
+const exporterMultitenantSitesJSON = `{"multitenant_director_sites": [{"id": "mt1", "name": "mt-site", "region": "eu-de",
+	"pvdcs": [{"id": "mtpvdc1", "name": "mt-pvdc", "data_center_name": "fra02"}]}]}`
+
+const exporterUsageMetersJSON = `{"usage_meter_registrations": [{"id": "reg1", "name": "meter", "status": "active",
+	"access_token": "token-secret", "usage_meter": {"id": "um1", "health": "error"}}]}`
+
+var _ = Describe(`Exporter`, func() {
+	var (
+		testServer *httptest.Server
+		exporter   *Exporter
+		failVdcs   bool
+		mutex      sync.Mutex
+		scrape     func() string
+	)
+
+	BeforeEach(func() {
+		failVdcs = false
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			mutex.Lock()
+			defer mutex.Unlock()
+			res.Header().Set("Content-type", "application/json")
+			body := map[string]string{
+				"/director_sites":             exporterDirectorSitesJSON,
+				"/vdcs":                       exporterVdcsJSON,
+				"/director_site_regions":      exporterRegionsJSON,
+				"/multitenant_director_sites": exporterMultitenantSitesJSON,
+				"/usage_meter_registrations":  exporterUsageMetersJSON,
+			}[req.URL.EscapedPath()]
+			if body == "" || (failVdcs && req.URL.EscapedPath() == "/vdcs") {
+				res.WriteHeader(500)
+				fmt.Fprint(res, `{"errors": [{"code": "internal_error", "message": "upstream is down"}]}`)
+				return
+			}
+			res.WriteHeader(200)
+			fmt.Fprint(res, body)
+		}))
+
+		vmwareService, serviceErr := vmwarev1.NewVmwareV1(&vmwarev1.VmwareV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		exporter = NewExporter(vmwareService)
+		exporter.now = func() time.Time {
+			return time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+		}
+
+		scrape = func() string {
+			exporterServer := httptest.NewServer(exporter.Handler())
+			defer exporterServer.Close()
+			res, err := http.Get(exporterServer.URL + "/metrics")
+			Expect(err).To(BeNil())
+			defer res.Body.Close()
+			Expect(res.StatusCode).To(Equal(200))
+			Expect(res.Header.Get("Content-Type")).To(HavePrefix("text/plain; version=0.0.4"))
+			body, err := io.ReadAll(res.Body)
+			Expect(err).To(BeNil())
+			return string(body)
+		}
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Reports that nothing was scraped yet`, func() {
+		Expect(scrape()).To(Equal("# HELP vmware_exporter_up Whether the exporter has completed a scrape of the API.\n" +
+			"# TYPE vmware_exporter_up gauge\nvmware_exporter_up 0\n"))
+	})
+	It(`Exposes the state of the resources`, func() {
+		exporter.Scrape(context.Background())
+		metrics := scrape()
+
+		site := `region="us-south",director_site_id="site1",director_site="site-one",resource_group="Default"`
+		for _, line := range []string{
+			"# TYPE vmware_vdc_status gauge",
+			`vmware_exporter_collector_success{collector="vdcs"} 1`,
+			`vmware_director_site_status{` + site + `,type="single_tenant",status="ready_to_use"} 1`,
+			`vmware_director_site_status{` + site + `,type="single_tenant",status="updating"} 0`,
+			`vmware_pvdc_status{` + site + `,pvdc_id="pvdc1",pvdc="pvdc-one",data_center="dal10",status="ready_to_use"} 1`,
+			`vmware_cluster_hosts{` + site + `,pvdc_id="pvdc1",cluster_id="cluster1",cluster="cluster-one",host_profile="BM_2S_20_CORES_192_GB"} 3`,
+			`vmware_cluster_file_shares_gigabytes{` + site + `,pvdc_id="pvdc1",cluster_id="cluster1",cluster="cluster-one",host_profile="BM_2S_20_CORES_192_GB",iops_per_gb="2"} 2000`,
+			`vmware_service_status{` + site + `,service_id="svc1",service="veeam",status="creating"} 1`,
+			`vmware_pending_provisioning_seconds{` + site + `,resource="service",id="svc1"} 1800`,
+			`vmware_vcda_connection_status{` + site + `,connection_id="conn1",type="private",data_center="dal10",status="ready_to_use"} 1`,
+			`vmware_sobr_size{` + site + `,service_id="svc1",sobr_id="sobr1",sobr="repo \"one\"",storage_type="cos",data_center="",status="ready_to_use"} 50`,
+			`vmware_vdc_status{` + site + `,vdc_id="vdc1",vdc="vdc-one",type="single_tenant",pvdc_id="pvdc1",status="modifying"} 1`,
+			`vmware_vdc_edges{` + site + `,vdc_id="vdc1",vdc="vdc-one",edge_type="performance",edge_size="medium"} 2`,
+			`vmware_transit_gateway_connection_status{` + site + `,vdc_id="vdc1",vdc="vdc-one",edge_id="edge1",transit_gateway_id="tgw1",connection="conn-a",zone="us-south-1",status="detached"} 1`,
+			`vmware_vdc_status{region="eu-de",director_site_id="mt1",director_site="mt-site",resource_group="",vdc_id="vdc2",vdc="vdc-two",type="multitenant",pvdc_id="mtpvdc1",status="creating"} 1`,
+			`vmware_pending_provisioning_seconds{region="eu-de",director_site_id="mt1",director_site="mt-site",resource_group="",resource="vdc",id="vdc2"} 3600`,
+			`vmware_usage_meter_health{registration_id="reg1",registration="meter",usage_meter_id="um1",status="error"} 1`,
+		} {
+			Expect(metrics).To(ContainSubstring(line + "\n"))
+		}
+		Expect(metrics).ToNot(ContainSubstring("token-secret"))
+		Expect(metrics).ToNot(ContainSubstring(`resource="director_site"`))
+		Expect(strings.Count(metrics, "# TYPE vmware_vdc_status gauge")).To(Equal(1))
+	})
+	It(`Scrapes without its constructor`, func() {
+		literal := &Exporter{Service: exporter.Service}
+		literal.Scrape(context.Background())
+		exporter = literal
+		Expect(scrape()).To(ContainSubstring(`vmware_exporter_collector_success{collector="vdcs"} 1` + "\n"))
+	})
+	It(`Keeps the previous results of a failed collector`, func() {
+		exporter.Scrape(context.Background())
+		mutex.Lock()
+		failVdcs = true
+		mutex.Unlock()
+		exporter.Scrape(context.Background())
+
+		metrics := scrape()
+		Expect(metrics).To(ContainSubstring(`vmware_exporter_collector_success{collector="vdcs"} 0`))
+		Expect(metrics).To(ContainSubstring(`vmware_exporter_collector_success{collector="director_sites"} 1`))
+		Expect(metrics).To(ContainSubstring(`vdc_id="vdc1"`))
+	})
+	It(`Exports the Cloud to Cloud connections of an inventory file`, func() {
+		dir, err := os.MkdirTemp("", "vmware-exporter")
+		Expect(err).To(BeNil())
+		defer os.RemoveAll(dir)
+
+		inventory := &vmwarev1.Inventory{}
+		inventory.AddC2cConnection("site1", vmwarev1.VcdaC2c{
+			ID:           core.StringPtr("c2c1"),
+			Status:       core.StringPtr(vmwarev1.VcdaC2c_Status_Updating),
+			PeerSiteName: core.StringPtr("peer"),
+			PeerRegion:   core.StringPtr("eu-de"),
+		})
+		exporter.C2cInventoryPath = filepath.Join(dir, "inventory.json")
+		Expect(inventory.WriteFile(exporter.C2cInventoryPath)).To(Succeed())
+
+		exporter.Scrape(context.Background())
+		metrics := scrape()
+		Expect(metrics).To(ContainSubstring(`vmware_exporter_collector_success{collector="c2c_connections"} 1`))
+		Expect(metrics).To(ContainSubstring(`vmware_vcda_c2c_connection_status{region="us-south",director_site_id="site1",director_site="site-one",resource_group="Default",connection_id="c2c1",peer_site="peer",peer_region="eu-de",status="updating"} 1`))
+	})
+})
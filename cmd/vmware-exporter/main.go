@@ -0,0 +1,83 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Command vmware-exporter polls a VMware account and exposes the state of its resources as Prometheus metrics, so
+// that alerts can fire on a VDC stuck in "modifying", an unhealthy Usage Meter or a detached transit gateway
+// connection.
+//
+// The credentials and the service URL are read from the external configuration of the "vmware" service, for example
+// the VMWARE_URL, VMWARE_AUTH_TYPE and VMWARE_APIKEY environment variables.
+//
+// The API is scraped in the background once per -interval; /metrics serves the results of the last scrape. Status
+// metrics have one series per known status, set to 1 for the current one. Metrics of the resources of a Cloud
+// Director site carry the region, director_site_id, director_site and resource_group labels.
+//
+// Cloud to Cloud connections cannot be listed by the API. To export them, pass an inventory file that records them
+// with -c2c-inventory; it is read again on every scrape.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/IBM/vmware-go-sdk/vmwarev1"
+)
+
+func main() {
+	listen := flag.String("listen", "127.0.0.1:9810", "the address to listen on")
+	interval := flag.Duration("interval", time.Minute, "how often the API is scraped")
+	c2cInventory := flag.String("c2c-inventory", "", "an inventory file whose Cloud to Cloud connections are exported")
+	flag.Parse()
+
+	service, err := vmwarev1.NewVmwareV1UsingExternalConfig(&vmwarev1.VmwareV1Options{
+		ServiceName: vmwarev1.DefaultServiceName,
+	})
+	if err != nil {
+		log.Fatalf("vmware-exporter: %s", err)
+	}
+	service.EnableLogRedaction()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	exporter := NewExporter(service)
+	exporter.C2cInventoryPath = *c2cInventory
+	go exporter.Run(ctx, *interval)
+
+	server := &http.Server{
+		Addr:              *listen,
+		Handler:           exporter.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	log.Printf("vmware-exporter: listening on %s", *listen)
+	err = server.ListenAndServe()
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Fatalf("vmware-exporter: %s", err)
+	}
+}
@@ -0,0 +1,142 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// label is a label of a sample.
+type label struct {
+	name  string
+	value string
+}
+
+// sample is a value of a metric family.
+type sample struct {
+	labels []label
+	value  float64
+}
+
+// family is a metric family of the Prometheus text exposition format (version 0.0.4).
+type family struct {
+	name    string
+	help    string
+	samples []sample
+}
+
+// metricSet collects the samples of a scrape. Families are written in the order in which they were first added.
+type metricSet struct {
+	families []*family
+	byName   map[string]*family
+}
+
+func newMetricSet() *metricSet {
+	return &metricSet{byName: map[string]*family{}}
+}
+
+// add adds a sample to the gauge "name". Labels are passed as name/value pairs.
+func (set *metricSet) add(name string, help string, value float64, labelPairs ...string) {
+	f := set.byName[name]
+	if f == nil {
+		f = &family{name: name, help: help}
+		set.byName[name] = f
+		set.families = append(set.families, f)
+	}
+	labels := make([]label, 0, len(labelPairs)/2)
+	for i := 0; i+1 < len(labelPairs); i += 2 {
+		labels = append(labels, label{name: labelPairs[i], value: labelPairs[i+1]})
+	}
+	f.samples = append(f.samples, sample{labels: labels, value: value})
+}
+
+// addStatus adds one sample per status of "statuses" to the gauge "name", set to 1 for "current" and 0 for the others,
+// so that alerts can use "for" durations on a series that always exists. An unknown current status gets a sample too.
+func (set *metricSet) addStatus(name string, help string, current string, statuses []string, labelPairs ...string) {
+	found := false
+	for _, status := range statuses {
+		value := 0.0
+		if status == current {
+			value = 1
+			found = true
+		}
+		set.add(name, help, value, append(labelPairs, "status", status)...)
+	}
+	if !found && current != "" {
+		set.add(name, help, 1, append(labelPairs, "status", current)...)
+	}
+}
+
+// write writes the metrics in the text exposition format. Samples are sorted by their labels, so that the output is
+// stable.
+func (set *metricSet) write(w io.Writer) error {
+	var b strings.Builder
+	for _, f := range set.families {
+		samples := make([]string, 0, len(f.samples))
+		for _, s := range f.samples {
+			samples = append(samples, f.name+formatLabels(s.labels)+" "+formatValue(s.value)+"\n")
+		}
+		sort.Strings(samples)
+
+		fmt.Fprintf(&b, "# HELP %s %s\n", f.name, escapeHelp(f.help))
+		fmt.Fprintf(&b, "# TYPE %s gauge\n", f.name)
+		for _, s := range samples {
+			b.WriteString(s)
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func formatLabels(labels []label) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	parts := make([]string, len(labels))
+	for i, l := range labels {
+		parts[i] = l.name + `="` + escapeLabelValue(l.value) + `"`
+	}
+	return "{" + strings.Join(parts, ",") + "}"
+}
+
+func formatValue(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	case math.IsNaN(value):
+		return "NaN"
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+var labelValueReplacer = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+
+var helpReplacer = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+
+func escapeLabelValue(value string) string {
+	return labelValueReplacer.Replace(value)
+}
+
+func escapeHelp(help string) string {
+	return helpReplacer.Replace(help)
+}
@@ -0,0 +1,289 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/vmware-go-sdk/vmwarev1"
+)
+
+// Headers set on every cached response.
+const (
+	headerFetchedAt    = "X-Cache-Fetched-At"
+	headerStale        = "X-Cache-Stale"
+	headerRefreshError = "X-Cache-Refresh-Error"
+)
+
+// endpoint is a read-only view of one list operation.
+type endpoint struct {
+	path  string
+	fetch func(ctx context.Context, service *vmwarev1.VmwareV1) (interface{}, error)
+}
+
+// The endpoints served by the gateway. They mirror the paths of the API. Licenses and Usage Meter registrations are
+// left out on purpose: they carry secrets that the gateway must not hand out without credentials.
+var endpoints = []endpoint{
+	{
+		path: "/director_sites",
+		fetch: func(ctx context.Context, service *vmwarev1.VmwareV1) (interface{}, error) {
+			result, _, err := service.ListDirectorSitesWithContext(ctx, service.NewListDirectorSitesOptions())
+			if err != nil {
+				return nil, err
+			}
+			for i := range result.DirectorSites {
+				result.DirectorSites[i].RhelVmActivationKey = nil
+			}
+			return result, nil
+		},
+	},
+	{
+		path: "/vdcs",
+		fetch: func(ctx context.Context, service *vmwarev1.VmwareV1) (interface{}, error) {
+			result, _, err := service.ListVdcsWithContext(ctx, service.NewListVdcsOptions())
+			return result, err
+		},
+	},
+	{
+		path: "/director_site_regions",
+		fetch: func(ctx context.Context, service *vmwarev1.VmwareV1) (interface{}, error) {
+			result, _, err := service.ListDirectorSiteRegionsWithContext(ctx, service.NewListDirectorSiteRegionsOptions())
+			return result, err
+		},
+	},
+	{
+		path: "/director_site_host_profiles",
+		fetch: func(ctx context.Context, service *vmwarev1.VmwareV1) (interface{}, error) {
+			result, _, err := service.ListDirectorSiteHostProfilesWithContext(ctx, service.NewListDirectorSiteHostProfilesOptions())
+			return result, err
+		},
+	},
+	{
+		path: "/multitenant_director_sites",
+		fetch: func(ctx context.Context, service *vmwarev1.VmwareV1) (interface{}, error) {
+			result, _, err := service.ListMultitenantDirectorSitesWithContext(ctx, service.NewListMultitenantDirectorSitesOptions())
+			return result, err
+		},
+	},
+}
+
+// snapshot is the cached response of an endpoint.
+type snapshot struct {
+	body      []byte
+	etag      string
+	fetchedAt time.Time
+}
+
+// cacheEntry holds the latest snapshot of an endpoint. Fetches are serialized, so that concurrent requests for an
+// empty entry call the API once.
+type cacheEntry struct {
+	endpoint   endpoint
+	fetchMutex sync.Mutex
+	current    atomic.Pointer[snapshot]
+	lastError  atomic.Pointer[string]
+	refreshing atomic.Bool
+}
+
+// Gateway : Serves a cached, read-only view of the account over HTTP.
+type Gateway struct {
+	// The service client used to call the API.
+	Service *vmwarev1.VmwareV1
+
+	// How long a response is fresh. Stale responses are still served while they are refreshed in the background.
+	TTL time.Duration
+
+	// The timeout of each call to the API.
+	FetchTimeout time.Duration
+
+	entries map[string]*cacheEntry
+	now     func() time.Time
+}
+
+// NewGateway : Instantiate Gateway
+func NewGateway(service *vmwarev1.VmwareV1, ttl time.Duration) *Gateway {
+	gateway := &Gateway{
+		Service:      service,
+		TTL:          ttl,
+		FetchTimeout: time.Minute,
+		entries:      map[string]*cacheEntry{},
+		now:          time.Now,
+	}
+	for _, e := range endpoints {
+		gateway.entries[e.path] = &cacheEntry{endpoint: e}
+	}
+	return gateway
+}
+
+// Handler returns the HTTP handler of the gateway.
+func (gateway *Gateway) Handler() http.Handler {
+	mux := http.NewServeMux()
+	for path, entry := range gateway.entries {
+		mux.Handle(path, gateway.handleEntry(entry))
+	}
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintln(w, "ok")
+	})
+	return mux
+}
+
+// Run refreshes every endpoint once per "interval" until "ctx" is canceled. A failed refresh keeps the previous
+// snapshot and is reported in the X-Cache-Refresh-Error header.
+func (gateway *Gateway) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		gateway.RefreshAll(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RefreshAll refreshes every endpoint.
+func (gateway *Gateway) RefreshAll(ctx context.Context) {
+	for _, entry := range gateway.entries {
+		entry.fetchMutex.Lock()
+		_, _ = gateway.refresh(ctx, entry)
+		entry.fetchMutex.Unlock()
+	}
+}
+
+// refresh fetches the endpoint of "entry" and stores the new snapshot. The caller must hold entry.fetchMutex.
+func (gateway *Gateway) refresh(ctx context.Context, entry *cacheEntry) (*snapshot, error) {
+	ctx, cancel := context.WithTimeout(ctx, gateway.FetchTimeout)
+	defer cancel()
+
+	result, err := entry.endpoint.fetch(ctx, gateway.Service)
+	var body []byte
+	if err == nil {
+		body, err = json.Marshal(result)
+	}
+	if err != nil {
+		message := err.Error()
+		entry.lastError.Store(&message)
+		return nil, err
+	}
+
+	sum := sha256.Sum256(body)
+	current := &snapshot{
+		body:      body,
+		etag:      `"` + hex.EncodeToString(sum[:16]) + `"`,
+		fetchedAt: gateway.now(),
+	}
+	entry.current.Store(current)
+	entry.lastError.Store(nil)
+	return current, nil
+}
+
+// get returns the snapshot of "entry", fetching it when the cache is empty and refreshing it in the background when
+// it is stale.
+func (gateway *Gateway) get(ctx context.Context, entry *cacheEntry) (*snapshot, error) {
+	current := entry.current.Load()
+	if current == nil {
+		entry.fetchMutex.Lock()
+		defer entry.fetchMutex.Unlock()
+		if current = entry.current.Load(); current != nil {
+			return current, nil
+		}
+		return gateway.refresh(ctx, entry)
+	}
+
+	if gateway.now().Sub(current.fetchedAt) >= gateway.TTL && entry.refreshing.CompareAndSwap(false, true) {
+		go func() {
+			defer entry.refreshing.Store(false)
+			entry.fetchMutex.Lock()
+			defer entry.fetchMutex.Unlock()
+			_, _ = gateway.refresh(context.Background(), entry)
+		}()
+	}
+	return current, nil
+}
+
+func (gateway *Gateway) handleEntry(entry *cacheEntry) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			writeError(w, http.StatusMethodNotAllowed, "the gateway is read-only")
+			return
+		}
+
+		current, err := gateway.get(r.Context(), entry)
+		if err != nil {
+			writeError(w, http.StatusBadGateway, err.Error())
+			return
+		}
+
+		age := gateway.now().Sub(current.fetchedAt)
+		if age < 0 {
+			age = 0
+		}
+		header := w.Header()
+		header.Set("ETag", current.etag)
+		header.Set("Age", strconv.Itoa(int(age.Seconds())))
+		header.Set(headerFetchedAt, current.fetchedAt.UTC().Format(time.RFC3339))
+		header.Set(headerStale, strconv.FormatBool(age >= gateway.TTL))
+		if remaining := gateway.TTL - age; remaining > 0 {
+			header.Set("Cache-Control", fmt.Sprintf("max-age=%d", int(remaining.Seconds())))
+		} else {
+			header.Set("Cache-Control", "max-age=0")
+		}
+		if lastError := entry.lastError.Load(); lastError != nil {
+			header.Set(headerRefreshError, *lastError)
+		}
+
+		if etagMatches(r.Header.Get("If-None-Match"), current.etag) {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		header.Set("Content-Type", "application/json")
+		header.Set("Content-Length", strconv.Itoa(len(current.body)))
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(current.body)
+		}
+	}
+}
+
+// etagMatches reports whether the If-None-Match header "ifNoneMatch" matches "etag".
+func etagMatches(ifNoneMatch string, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": message})
+}
@@ -0,0 +1,217 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vmware-go-sdk/vmwarev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// fakeUpstream stands in for the VMware API and counts the calls to each path.
+type fakeUpstream struct {
+	sync.Mutex
+	calls    map[string]int
+	vdcName  string
+	failVdcs bool
+}
+
+func (upstream *fakeUpstream) handler() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		defer GinkgoRecover()
+		upstream.Lock()
+		defer upstream.Unlock()
+		upstream.calls[req.URL.EscapedPath()]++
+
+		res.Header().Set("Content-type", "application/json")
+		switch req.URL.EscapedPath() {
+		case "/director_sites":
+			res.WriteHeader(200)
+			fmt.Fprint(res, `{"director_sites": [{"id": "site1", "name": "site", "rhel_vm_activation_key": "rhel-secret", "pvdcs": [], "services": []}]}`)
+		case "/vdcs":
+			if upstream.failVdcs {
+				res.WriteHeader(500)
+				fmt.Fprint(res, `{"errors": [{"code": "internal_error", "message": "upstream is down"}]}`)
+				return
+			}
+			res.WriteHeader(200)
+			fmt.Fprintf(res, `{"vdcs": [{"id": "vdc1", "name": "%s", "edges": []}]}`, upstream.vdcName)
+		default:
+			res.WriteHeader(200)
+			fmt.Fprint(res, `{}`)
+		}
+	}
+}
+
+func (upstream *fakeUpstream) callCount(path string) int {
+	upstream.Lock()
+	defer upstream.Unlock()
+	return upstream.calls[path]
+}
+
+var _ = Describe(`Gateway`, func() {
+	var (
+		upstream      *fakeUpstream
+		upstreamSrv   *httptest.Server
+		gateway       *Gateway
+		gatewaySrv    *httptest.Server
+		now           time.Time
+		nowMutex      sync.Mutex
+		advanceClock  func(time.Duration)
+		getGatewayURL func(path string, etag string) *http.Response
+	)
+
+	BeforeEach(func() {
+		upstream = &fakeUpstream{calls: map[string]int{}, vdcName: "first"}
+		upstreamSrv = httptest.NewServer(upstream.handler())
+
+		vmwareService, serviceErr := vmwarev1.NewVmwareV1(&vmwarev1.VmwareV1Options{
+			URL:           upstreamSrv.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+
+		now = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+		gateway = NewGateway(vmwareService, time.Minute)
+		gateway.now = func() time.Time {
+			nowMutex.Lock()
+			defer nowMutex.Unlock()
+			return now
+		}
+		advanceClock = func(d time.Duration) {
+			nowMutex.Lock()
+			defer nowMutex.Unlock()
+			now = now.Add(d)
+		}
+		gatewaySrv = httptest.NewServer(gateway.Handler())
+
+		getGatewayURL = func(path string, etag string) *http.Response {
+			req, err := http.NewRequest(http.MethodGet, gatewaySrv.URL+path, nil)
+			Expect(err).To(BeNil())
+			if etag != "" {
+				req.Header.Set("If-None-Match", etag)
+			}
+			res, err := http.DefaultClient.Do(req)
+			Expect(err).To(BeNil())
+			return res
+		}
+	})
+	AfterEach(func() {
+		gatewaySrv.Close()
+		upstreamSrv.Close()
+	})
+
+	It(`Serves the list operations from the cache`, func() {
+		res := getGatewayURL("/director_sites", "")
+		body, _ := io.ReadAll(res.Body)
+		res.Body.Close()
+		Expect(res.StatusCode).To(Equal(200))
+		Expect(res.Header.Get("Content-Type")).To(Equal("application/json"))
+		Expect(string(body)).To(ContainSubstring(`"id":"site1"`))
+		Expect(string(body)).ToNot(ContainSubstring("rhel-secret"))
+		Expect(res.Header.Get("X-Cache-Stale")).To(Equal("false"))
+		Expect(res.Header.Get("X-Cache-Fetched-At")).To(Equal("2026-01-01T12:00:00Z"))
+		Expect(res.Header.Get("Cache-Control")).To(Equal("max-age=60"))
+		etag := res.Header.Get("ETag")
+		Expect(etag).ToNot(BeEmpty())
+
+		advanceClock(20 * time.Second)
+		res = getGatewayURL("/director_sites", etag)
+		res.Body.Close()
+		Expect(res.StatusCode).To(Equal(http.StatusNotModified))
+		Expect(res.Header.Get("Age")).To(Equal("20"))
+		Expect(res.Header.Get("Cache-Control")).To(Equal("max-age=40"))
+		Expect(upstream.callCount("/director_sites")).To(Equal(1))
+
+		res = getGatewayURL("/director_sites", `"other", W/`+etag)
+		res.Body.Close()
+		Expect(res.StatusCode).To(Equal(http.StatusNotModified))
+	})
+	It(`Serves stale responses while refreshing them in the background`, func() {
+		res := getGatewayURL("/vdcs", "")
+		res.Body.Close()
+		etag := res.Header.Get("ETag")
+
+		upstream.Lock()
+		upstream.vdcName = "second"
+		upstream.Unlock()
+		advanceClock(2 * time.Minute)
+
+		res = getGatewayURL("/vdcs", etag)
+		res.Body.Close()
+		Expect(res.StatusCode).To(Equal(http.StatusNotModified))
+		Expect(res.Header.Get("X-Cache-Stale")).To(Equal("true"))
+		Expect(res.Header.Get("Cache-Control")).To(Equal("max-age=0"))
+
+		Eventually(func() int { return upstream.callCount("/vdcs") }).Should(Equal(2))
+		Eventually(func() string {
+			res := getGatewayURL("/vdcs", etag)
+			body, _ := io.ReadAll(res.Body)
+			res.Body.Close()
+			return string(body)
+		}).Should(ContainSubstring(`"name":"second"`))
+	})
+	It(`Keeps the last response when a refresh fails`, func() {
+		gateway.RefreshAll(context.Background())
+		Expect(upstream.callCount("/director_site_regions")).To(Equal(1))
+
+		upstream.Lock()
+		upstream.failVdcs = true
+		upstream.Unlock()
+		gateway.RefreshAll(context.Background())
+
+		res := getGatewayURL("/vdcs", "")
+		body, _ := io.ReadAll(res.Body)
+		res.Body.Close()
+		Expect(res.StatusCode).To(Equal(200))
+		Expect(string(body)).To(ContainSubstring(`"name":"first"`))
+		Expect(res.Header.Get("X-Cache-Refresh-Error")).To(ContainSubstring("upstream is down"))
+	})
+	It(`Reports upstream errors when nothing is cached`, func() {
+		upstream.failVdcs = true
+		res := getGatewayURL("/vdcs", "")
+		body, _ := io.ReadAll(res.Body)
+		res.Body.Close()
+		Expect(res.StatusCode).To(Equal(http.StatusBadGateway))
+		Expect(string(body)).To(ContainSubstring("upstream is down"))
+	})
+	It(`Is read-only`, func() {
+		res, err := http.Post(gatewaySrv.URL+"/vdcs", "application/json", nil)
+		Expect(err).To(BeNil())
+		res.Body.Close()
+		Expect(res.StatusCode).To(Equal(http.StatusMethodNotAllowed))
+		Expect(res.Header.Get("Allow")).To(Equal("GET, HEAD"))
+		Expect(upstream.callCount("/vdcs")).To(Equal(0))
+
+		res = getGatewayURL("/licenses", "")
+		res.Body.Close()
+		Expect(res.StatusCode).To(Equal(http.StatusNotFound))
+
+		res = getGatewayURL("/healthz", "")
+		res.Body.Close()
+		Expect(res.StatusCode).To(Equal(http.StatusOK))
+	})
+})
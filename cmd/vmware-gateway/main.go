@@ -0,0 +1,90 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command vmware-gateway serves a cached, read-only JSON view of a VMware account, so that tools can read the
+// inventory without holding IAM credentials.
+//
+// The credentials and the service URL are read from the external configuration of the "vmware" service, for example
+// the VMWARE_URL, VMWARE_AUTH_TYPE and VMWARE_APIKEY environment variables.
+//
+// Endpoints (GET and HEAD only):
+//
+//	/director_sites
+//	/vdcs
+//	/director_site_regions
+//	/director_site_host_profiles
+//	/multitenant_director_sites
+//	/healthz
+//
+// Responses carry an ETag and honor If-None-Match. The Age, X-Cache-Fetched-At and X-Cache-Stale headers tell how old
+// the data is, and X-Cache-Refresh-Error reports the last failed refresh.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/IBM/vmware-go-sdk/vmwarev1"
+)
+
+func main() {
+	listen := flag.String("listen", "127.0.0.1:8080", "the address to listen on")
+	ttl := flag.Duration("ttl", 5*time.Minute, "how long responses are fresh")
+	refresh := flag.Duration("refresh", 0, "refresh every endpoint at this interval; defaults to the TTL")
+	flag.Parse()
+	if *refresh <= 0 {
+		*refresh = *ttl
+	}
+
+	service, err := vmwarev1.NewVmwareV1UsingExternalConfig(&vmwarev1.VmwareV1Options{
+		ServiceName: vmwarev1.DefaultServiceName,
+	})
+	if err != nil {
+		log.Fatalf("vmware-gateway: %s", err)
+	}
+	service.EnableLogRedaction()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	gateway := NewGateway(service, *ttl)
+	go gateway.Run(ctx, *refresh)
+
+	server := &http.Server{
+		Addr:              *listen,
+		Handler:           gateway.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	log.Printf("vmware-gateway: listening on %s", *listen)
+	err = server.ListenAndServe()
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Fatalf("vmware-gateway: %s", err)
+	}
+}
@@ -0,0 +1,357 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// The kinds of model fields.
+const (
+	kindPointer   = "pointer"   // A pointer to a primitive value, such as *string.
+	kindDateTime  = "date-time" // A *strfmt.DateTime.
+	kindValues    = "values"    // A slice of primitive values, such as []string.
+	kindModel     = "model"     // A pointer to a model.
+	kindModels    = "models"    // A slice of models.
+	kindInterface = "interface" // A model interface, such as VDCEdgePrototypeNetworkHaIntf.
+	kindJSON      = "json"      // A generic JSON value, such as map[string]interface{}.
+)
+
+// The JSON names of the fields that are volatile, in addition to the date-times.
+var volatileFields = []string{"href"}
+
+type model struct {
+	Name   string
+	Fields []field
+}
+
+type field struct {
+	Name     string
+	JSONName string
+	Kind     string
+	Type     string // The model, interface or JSON type of the field, for the model, models, interface and json kinds.
+	Volatile bool
+}
+
+type modelInterface struct {
+	Name            string
+	Implementations []string
+}
+
+// Generate returns the source of the DeepCopy, Equal and Diff methods of the models of the service file "source", and
+// of the list of the models.
+func Generate(filename string, source []byte) ([]byte, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), filename, source, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	structs := map[string]*ast.StructType{}
+	var names []string
+	interfaces := map[string]*modelInterface{}
+	markers := map[string]string{} // The marker methods of the interfaces, such as isaVDCEdgePrototypeNetworkHa.
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			switch t := typeSpec.Type.(type) {
+			case *ast.StructType:
+				if isModel(typeSpec.Name.Name) {
+					structs[typeSpec.Name.Name] = t
+					names = append(names, typeSpec.Name.Name)
+				}
+			case *ast.InterfaceType:
+				if len(t.Methods.List) == 1 && len(t.Methods.List[0].Names) == 1 {
+					interfaces[typeSpec.Name.Name] = &modelInterface{Name: typeSpec.Name.Name}
+					markers[t.Methods.List[0].Names[0].Name] = typeSpec.Name.Name
+				}
+			}
+		}
+	}
+	for _, decl := range file.Decls {
+		fun, ok := decl.(*ast.FuncDecl)
+		if !ok || fun.Recv == nil || markers[fun.Name.Name] == "" {
+			continue
+		}
+		star, ok := fun.Recv.List[0].Type.(*ast.StarExpr)
+		if !ok {
+			continue
+		}
+		if receiver, ok := star.X.(*ast.Ident); ok && structs[receiver.Name] != nil {
+			intf := interfaces[markers[fun.Name.Name]]
+			intf.Implementations = append(intf.Implementations, receiver.Name)
+		}
+	}
+
+	sort.Strings(names)
+	models := make([]model, 0, len(names))
+	for _, name := range names {
+		m := model{Name: name}
+		for _, f := range structs[name].Fields.List {
+			if len(f.Names) != 1 {
+				return nil, fmt.Errorf("%s: embedded and grouped fields are not supported", name)
+			}
+			fieldName := f.Names[0].Name
+			jsonName := fieldName
+			if f.Tag != nil {
+				tag, _ := strconv.Unquote(f.Tag.Value)
+				if value, ok := reflect.StructTag(tag).Lookup("json"); ok {
+					jsonName, _, _ = strings.Cut(value, ",")
+				}
+			}
+			kind, typeName, err := classify(f.Type, structs, interfaces)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %w", name, fieldName, err)
+			}
+			m.Fields = append(m.Fields, field{
+				Name:     fieldName,
+				JSONName: jsonName,
+				Kind:     kind,
+				Type:     typeName,
+				Volatile: kind == kindDateTime || contains(volatileFields, jsonName),
+			})
+		}
+		models = append(models, m)
+	}
+
+	var intfs []*modelInterface
+	for _, intf := range interfaces {
+		if len(intf.Implementations) > 0 {
+			sort.Strings(intf.Implementations)
+			intfs = append(intfs, intf)
+		}
+	}
+	sort.Slice(intfs, func(i, j int) bool { return intfs[i].Name < intfs[j].Name })
+
+	buf := &bytes.Buffer{}
+	write(buf, filepath.Base(filename), models, intfs)
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting the generated source: %w", err)
+	}
+	return formatted, nil
+}
+
+// isModel returns true if the struct "name" is a model, rather than the service or the options of an operation.
+func isModel(name string) bool {
+	return !strings.HasSuffix(name, "Options") && !strings.HasSuffix(name, "V1") && !strings.HasSuffix(name, "Pager")
+}
+
+func classify(expr ast.Expr, structs map[string]*ast.StructType, interfaces map[string]*modelInterface) (kind string, typeName string, err error) {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		switch x := t.X.(type) {
+		case *ast.Ident:
+			if structs[x.Name] != nil {
+				return kindModel, x.Name, nil
+			}
+			if isPrimitive(x.Name) {
+				return kindPointer, "", nil
+			}
+		case *ast.SelectorExpr:
+			if pkg, ok := x.X.(*ast.Ident); ok && pkg.Name == "strfmt" && x.Sel.Name == "DateTime" {
+				return kindDateTime, "", nil
+			}
+		}
+	case *ast.ArrayType:
+		if t.Len != nil {
+			break
+		}
+		if elt, ok := t.Elt.(*ast.Ident); ok {
+			if structs[elt.Name] != nil {
+				return kindModels, elt.Name, nil
+			}
+			if isPrimitive(elt.Name) {
+				return kindValues, "", nil
+			}
+		}
+	case *ast.Ident:
+		if interfaces[t.Name] != nil {
+			return kindInterface, t.Name, nil
+		}
+	case *ast.InterfaceType:
+		return kindJSON, "interface{}", nil
+	case *ast.MapType:
+		if key, ok := t.Key.(*ast.Ident); ok && key.Name == "string" {
+			if _, ok := t.Value.(*ast.InterfaceType); ok {
+				return kindJSON, "map[string]interface{}", nil
+			}
+		}
+	}
+	return "", "", fmt.Errorf("unsupported field type %T", expr)
+}
+
+func isPrimitive(name string) bool {
+	switch name {
+	case "string", "bool", "int64", "int", "float64", "float32":
+		return true
+	}
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// receiver returns the receiver name that the SDK generator uses for "typeName", such as vDCPatch.
+func receiver(typeName string) string {
+	r, size := utf8.DecodeRuneInString(typeName)
+	return string(unicode.ToLower(r)) + typeName[size:]
+}
+
+func write(buf *bytes.Buffer, source string, models []model, intfs []*modelInterface) {
+	fmt.Fprintf(buf, header, source)
+	for _, m := range models {
+		writeModel(buf, m)
+	}
+	for _, intf := range intfs {
+		writeInterface(buf, intf)
+	}
+
+	buf.WriteString("\n// allModels has the zero value of every model, so that their fields can be inspected with reflection.\n")
+	buf.WriteString("var allModels = []interface{}{\n")
+	for _, m := range models {
+		fmt.Fprintf(buf, "%s{},\n", m.Name)
+	}
+	buf.WriteString("}\n")
+}
+
+func writeModel(buf *bytes.Buffer, m model) {
+	r := receiver(m.Name)
+	if r == "d" || r == "path" || r == "other" || r == "out" {
+		r = "_" + r
+	}
+
+	fmt.Fprintf(buf, "\n// DeepCopy returns a copy of the %s that shares no memory with it.\n", m.Name)
+	fmt.Fprintf(buf, "func (%s *%s) DeepCopy() *%s {\n", r, m.Name, m.Name)
+	fmt.Fprintf(buf, "if %s == nil {\nreturn nil\n}\n", r)
+	fmt.Fprintf(buf, "out := new(%s)\n", m.Name)
+	for _, f := range m.Fields {
+		in := r + "." + f.Name
+		switch f.Kind {
+		case kindPointer, kindDateTime:
+			fmt.Fprintf(buf, "out.%s = copyPointer(%s)\n", f.Name, in)
+		case kindValues:
+			fmt.Fprintf(buf, "out.%s = copyValues(%s)\n", f.Name, in)
+		case kindModel:
+			fmt.Fprintf(buf, "out.%s = %s.DeepCopy()\n", f.Name, in)
+		case kindModels:
+			fmt.Fprintf(buf, "out.%s = copyModels(%s, (*%s).DeepCopy)\n", f.Name, in, f.Type)
+		case kindInterface:
+			fmt.Fprintf(buf, "out.%s = deepCopy%s(%s)\n", f.Name, f.Type, in)
+		case kindJSON:
+			if f.Type == "interface{}" {
+				fmt.Fprintf(buf, "out.%s = copyJSONValue(%s)\n", f.Name, in)
+			} else {
+				fmt.Fprintf(buf, "if %s != nil {\nout.%s = copyJSONValue(%s).(%s)\n}\n", in, f.Name, in, f.Type)
+			}
+		}
+	}
+	buf.WriteString("return out\n}\n")
+
+	fmt.Fprintf(buf, "\n// Equal returns true if the %s and \"other\" have the same fields, apart from those ignored by \"options\".\n", m.Name)
+	fmt.Fprintf(buf, "func (%s *%s) Equal(other *%s, options ...*DiffOptions) bool {\n", r, m.Name, m.Name)
+	fmt.Fprintf(buf, "d := newDiffer(options, true)\n%s.diff(d, \"\", other)\nreturn !d.differs\n}\n", r)
+
+	fmt.Fprintf(buf, "\n// Diff returns the fields of the %s that differ in \"other\", apart from those ignored by \"options\".\n", m.Name)
+	fmt.Fprintf(buf, "func (%s *%s) Diff(other *%s, options ...*DiffOptions) []FieldChange {\n", r, m.Name, m.Name)
+	fmt.Fprintf(buf, "d := newDiffer(options, false)\n%s.diff(d, \"\", other)\nreturn d.changes\n}\n", r)
+
+	fmt.Fprintf(buf, "\nfunc (%s *%s) diff(d *differ, path string, other *%s) {\n", r, m.Name, m.Name)
+	fmt.Fprintf(buf, "if diffNil(d, path, %s, other) {\nreturn\n}\n", r)
+	for _, f := range m.Fields {
+		old, other := r+"."+f.Name, "other."+f.Name
+		fmt.Fprintf(buf, "if fieldPath, ok := d.field(path, %q, %t); ok {\n", f.JSONName, f.Volatile)
+		switch f.Kind {
+		case kindPointer:
+			fmt.Fprintf(buf, "diffPointers(d, fieldPath, %s, %s)\n", old, other)
+		case kindDateTime:
+			fmt.Fprintf(buf, "diffDateTimes(d, fieldPath, %s, %s)\n", old, other)
+		case kindValues:
+			fmt.Fprintf(buf, "diffValues(d, fieldPath, %s, %s)\n", old, other)
+		case kindModel:
+			fmt.Fprintf(buf, "%s.diff(d, fieldPath, %s)\n", old, other)
+		case kindModels:
+			fmt.Fprintf(buf, "diffModels(d, fieldPath, %s, %s, (*%s).diff)\n", old, other, f.Type)
+		case kindInterface:
+			fmt.Fprintf(buf, "diff%s(d, fieldPath, %s, %s)\n", f.Type, old, other)
+		case kindJSON:
+			fmt.Fprintf(buf, "diffJSON(d, fieldPath, %s, %s)\n", old, other)
+		}
+		buf.WriteString("}\n")
+	}
+	buf.WriteString("}\n")
+}
+
+func writeInterface(buf *bytes.Buffer, intf *modelInterface) {
+	fmt.Fprintf(buf, "\n// deepCopy%s returns a copy of \"in\", whatever its implementation.\n", intf.Name)
+	fmt.Fprintf(buf, "func deepCopy%s(in %s) %s {\n", intf.Name, intf.Name, intf.Name)
+	buf.WriteString("switch in := in.(type) {\n")
+	for _, implementation := range intf.Implementations {
+		fmt.Fprintf(buf, "case *%s:\nif in != nil {\nreturn in.DeepCopy()\n}\n", implementation)
+	}
+	buf.WriteString("}\nreturn in\n}\n")
+
+	fmt.Fprintf(buf, "\n// diff%s compares \"old\" and \"other\", which must have the same implementation to be equal.\n", intf.Name)
+	fmt.Fprintf(buf, "func diff%s(d *differ, path string, old %s, other %s) {\n", intf.Name, intf.Name, intf.Name)
+	buf.WriteString("switch old := old.(type) {\ncase nil:\nif other == nil {\nreturn\n}\n")
+	for _, implementation := range intf.Implementations {
+		fmt.Fprintf(buf, "case *%s:\nif other, ok := other.(*%s); ok {\nold.diff(d, path, other)\nreturn\n}\n", implementation, implementation)
+	}
+	buf.WriteString("}\nd.change(path, old, other)\n}\n")
+}
+
+const header = `/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by modelgen from %s. DO NOT EDIT.
+
+package vmwarev1
+`
@@ -0,0 +1,93 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Generate`, func() {
+	It(`Keeps the generated model methods of vmwarev1 up to date`, func() {
+		source, err := os.ReadFile("../../vmwarev1/vmware_v1.go")
+		Expect(err).To(BeNil())
+		generated, err := Generate("vmware_v1.go", source)
+		Expect(err).To(BeNil())
+		committed, err := os.ReadFile("../../vmwarev1/vmware_v1_models.go")
+		Expect(err).To(BeNil())
+		Expect(string(generated)).To(Equal(string(committed)), `run "go generate ./vmwarev1"`)
+	})
+
+	It(`Rejects the fields it cannot compare`, func() {
+		_, err := Generate("service.go", []byte(`package vmwarev1
+
+type Model struct {
+	Values map[string]string `+"`json:\"values\"`"+`
+}
+`))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("Model.Values: unsupported field type"))
+	})
+
+	It(`Generates the methods of models with every kind of field`, func() {
+		generated, err := Generate("service.go", []byte(`package vmwarev1
+
+import "github.com/go-openapi/strfmt"
+
+type Parent struct {
+	Name     *string           `+"`json:\"name\"`"+`
+	Tags     []string          `+"`json:\"tags\"`"+`
+	At       *strfmt.DateTime  `+"`json:\"at\"`"+`
+	Href     *string           `+"`json:\"href\"`"+`
+	Child    *Child            `+"`json:\"child\"`"+`
+	Children []Child           `+"`json:\"children\"`"+`
+	Kind     KindIntf          `+"`json:\"kind\"`"+`
+	Extra    map[string]interface{} `+"`json:\"extra\"`"+`
+}
+
+type Child struct {
+	ID *string `+"`json:\"id\"`"+`
+}
+func (*Child) isaKind() bool {
+	return true
+}
+
+type KindIntf interface {
+	isaKind() bool
+}
+
+type ParentOptions struct {
+	Parent *Parent
+}
+`))
+		Expect(err).To(BeNil())
+		source := string(generated)
+		Expect(source).To(ContainSubstring(`out.Tags = copyValues(parent.Tags)`))
+		Expect(source).To(ContainSubstring(`out.Children = copyModels(parent.Children, (*Child).DeepCopy)`))
+		Expect(source).To(ContainSubstring(`out.Kind = deepCopyKindIntf(parent.Kind)`))
+		Expect(source).To(ContainSubstring(`out.Extra = copyJSONValue(parent.Extra).(map[string]interface{})`))
+		Expect(source).To(ContainSubstring(`d.field(path, "at", true)`))
+		Expect(source).To(ContainSubstring(`d.field(path, "href", true)`))
+		Expect(source).To(ContainSubstring(`d.field(path, "name", false)`))
+		Expect(source).To(ContainSubstring(`diffKindIntf(d, fieldPath, parent.Kind, other.Kind)`))
+		Expect(source).To(ContainSubstring(`case *Child:`))
+		Expect(source).To(ContainSubstring("var allModels = []interface{}{\n\tChild{},\n\tParent{},\n}"))
+		Expect(source).ToNot(ContainSubstring(`ParentOptions`))
+	})
+})
@@ -0,0 +1,53 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command modelgen generates the DeepCopy, Equal and Diff methods of the models of a generated service file, such as
+// vmwarev1/vmware_v1.go, and the list of the models. It is run by "go generate ./vmwarev1".
+//
+//	modelgen -output vmware_v1_models.go vmware_v1.go
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+func main() {
+	output := flag.String("output", "", "the file the methods are written to (default: standard output)")
+	flag.Parse()
+	if flag.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: modelgen [-output file] <service file>")
+		os.Exit(2)
+	}
+
+	input := flag.Arg(0)
+	source, err := os.ReadFile(input)
+	if err == nil {
+		source, err = Generate(input, source)
+	}
+	if err == nil {
+		if *output == "" {
+			_, err = os.Stdout.Write(source)
+		} else {
+			err = os.WriteFile(*output, source, 0644)
+		}
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "modelgen:", err)
+		os.Exit(1)
+	}
+}
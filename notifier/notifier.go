@@ -0,0 +1,341 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package notifier watches VMware resources through periodic Get calls and posts a notification to webhooks whenever
+// the status of a resource changes, for example when a VDC finishes provisioning or fails.
+//
+// Payloads are JSON, built from a template, and signed with HMAC-SHA256. Failed deliveries are retried with an
+// exponential backoff; notifications that cannot be delivered are appended to a dead-letter file.
+package notifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vmware-go-sdk/common"
+	"github.com/IBM/vmware-go-sdk/vmwarev1"
+)
+
+// Constants associated with the Resource.Kind property.
+// The type of a watched resource.
+const (
+	Resource_Kind_DirectorSite = "director_site"
+	Resource_Kind_Vdc          = "vdc"
+	Resource_Kind_Cluster      = "cluster"
+	Resource_Kind_VcdaService  = "vcda_service"
+)
+
+// Status_NotFound is the status of a watched resource that the API no longer returns.
+const Status_NotFound = "not_found"
+
+// DefaultPollInterval is the default interval between two polls of the watched resources.
+const DefaultPollInterval = time.Minute
+
+// Resource : A resource watched by a Notifier.
+type Resource struct {
+	// The type of the resource.
+	Kind string `json:"kind"`
+
+	// The ID of the resource. The ID of a VCDA service is the ID of its Cloud Director site.
+	ID string `json:"id"`
+
+	// The ID of the Cloud Director site of a cluster.
+	DirectorSiteID string `json:"director_site_id,omitempty"`
+
+	// The ID of the resource pool of a cluster.
+	PvdcID string `json:"pvdc_id,omitempty"`
+}
+
+// DirectorSite returns the Resource of a Cloud Director site.
+func DirectorSite(id string) Resource {
+	return Resource{Kind: Resource_Kind_DirectorSite, ID: id}
+}
+
+// Vdc returns the Resource of a virtual data center.
+func Vdc(id string) Resource {
+	return Resource{Kind: Resource_Kind_Vdc, ID: id}
+}
+
+// Cluster returns the Resource of a cluster.
+func Cluster(directorSiteID string, pvdcID string, id string) Resource {
+	return Resource{Kind: Resource_Kind_Cluster, ID: id, DirectorSiteID: directorSiteID, PvdcID: pvdcID}
+}
+
+// VcdaService returns the Resource of the VCDA service of a Cloud Director site.
+func VcdaService(directorSiteID string) Resource {
+	return Resource{Kind: Resource_Kind_VcdaService, ID: directorSiteID, DirectorSiteID: directorSiteID}
+}
+
+// key identifies the resource in the state of a Notifier.
+func (resource Resource) key() string {
+	return resource.Kind + "/" + resource.DirectorSiteID + "/" + resource.PvdcID + "/" + resource.ID
+}
+
+// Event : A status transition of a watched resource.
+type Event struct {
+	// A unique ID of the event, which receivers can use to discard duplicate deliveries.
+	ID string `json:"id"`
+
+	// The resource whose status changed.
+	Resource Resource `json:"resource"`
+
+	// The name of the resource.
+	Name string `json:"name,omitempty"`
+
+	// The status before the transition.
+	PreviousStatus string `json:"previous_status"`
+
+	// The status after the transition.
+	Status string `json:"status"`
+
+	// The reasons of the status of a VDC, such as "insufficent_cpu".
+	Reasons []string `json:"reasons,omitempty"`
+
+	// When the transition was observed.
+	ObservedAt time.Time `json:"observed_at"`
+}
+
+// observation is the state of a resource returned by a poll.
+type observation struct {
+	name    string
+	status  string
+	reasons []string
+}
+
+// Notifier : Watches resources and notifies webhooks of their status transitions. The first poll of a resource records
+// its status without notifying.
+type Notifier struct {
+	// The service client used to get the resources.
+	Service *vmwarev1.VmwareV1
+
+	// The webhooks that are notified of every transition.
+	Webhooks []*Webhook
+
+	// The interval between two polls. If zero, DefaultPollInterval is used.
+	PollInterval time.Duration
+
+	// The number of attempts to deliver a notification to a webhook. If zero, 5 attempts are made.
+	MaxAttempts int
+
+	// The delay before the second attempt; it doubles after each failed attempt. If zero, 1 second is used.
+	RetryDelay time.Duration
+
+	// The file to which the notifications that could not be delivered are appended, as JSON lines. If empty, they
+	// are dropped.
+	DeadLetterPath string
+
+	// The HTTP client used to post the notifications. If nil, a client with a 30 second timeout is used.
+	HTTPClient *http.Client
+
+	mutex     sync.Mutex
+	resources []Resource
+	statuses  map[string]string
+	sequence  int64
+
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+// NewNotifier : Instantiate Notifier
+func NewNotifier(service *vmwarev1.VmwareV1, webhooks ...*Webhook) *Notifier {
+	return &Notifier{
+		Service:  service,
+		Webhooks: webhooks,
+	}
+}
+
+// currentTime returns the current time, from time.Now unless the clock of the notifier was replaced.
+func (notifier *Notifier) currentTime() time.Time {
+	if notifier.now == nil {
+		return time.Now()
+	}
+	return notifier.now()
+}
+
+// wait sleeps for "d", or until "ctx" is canceled.
+func (notifier *Notifier) wait(ctx context.Context, d time.Duration) error {
+	if notifier.sleep == nil {
+		return sleepContext(ctx, d)
+	}
+	return notifier.sleep(ctx, d)
+}
+
+// Watch adds resources to the watched resources.
+func (notifier *Notifier) Watch(resources ...Resource) {
+	notifier.mutex.Lock()
+	defer notifier.mutex.Unlock()
+	if notifier.statuses == nil {
+		notifier.statuses = map[string]string{}
+	}
+	for _, resource := range resources {
+		if _, watched := notifier.statuses[resource.key()]; watched {
+			continue
+		}
+		notifier.statuses[resource.key()] = ""
+		notifier.resources = append(notifier.resources, resource)
+	}
+}
+
+// Unwatch removes resources from the watched resources.
+func (notifier *Notifier) Unwatch(resources ...Resource) {
+	notifier.mutex.Lock()
+	defer notifier.mutex.Unlock()
+	for _, resource := range resources {
+		delete(notifier.statuses, resource.key())
+	}
+	kept := notifier.resources[:0]
+	for _, resource := range notifier.resources {
+		if _, watched := notifier.statuses[resource.key()]; watched {
+			kept = append(kept, resource)
+		}
+	}
+	notifier.resources = kept
+}
+
+// Run polls the watched resources once per poll interval until "ctx" is canceled. Errors of a poll are passed to
+// "onError", which may be nil; they do not stop the notifier.
+func (notifier *Notifier) Run(ctx context.Context, onError func(error)) {
+	interval := notifier.PollInterval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		_, err := notifier.Poll(ctx)
+		if err != nil && onError != nil {
+			onError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll gets every watched resource once and notifies the webhooks of the status transitions. It returns the events
+// of the transitions. A resource that cannot be read keeps its previous status; the errors of the reads and of the
+// deliveries are joined in the returned error.
+func (notifier *Notifier) Poll(ctx context.Context) (events []Event, err error) {
+	notifier.mutex.Lock()
+	resources := append([]Resource(nil), notifier.resources...)
+	notifier.mutex.Unlock()
+
+	var errs []error
+	for _, resource := range resources {
+		current, getErr := notifier.get(ctx, resource)
+		if getErr != nil {
+			errs = append(errs, getErr)
+			continue
+		}
+
+		notifier.mutex.Lock()
+		previous, watched := notifier.statuses[resource.key()]
+		if watched {
+			notifier.statuses[resource.key()] = current.status
+		}
+		var event *Event
+		if watched && previous != "" && previous != current.status {
+			notifier.sequence++
+			observedAt := notifier.currentTime().UTC()
+			event = &Event{
+				ID:             fmt.Sprintf("%s-%d-%d", resource.ID, observedAt.UnixNano(), notifier.sequence),
+				Resource:       resource,
+				Name:           current.name,
+				PreviousStatus: previous,
+				Status:         current.status,
+				Reasons:        current.reasons,
+				ObservedAt:     observedAt,
+			}
+		}
+		notifier.mutex.Unlock()
+
+		if event != nil {
+			events = append(events, *event)
+			errs = append(errs, notifier.Notify(ctx, *event))
+		}
+	}
+	err = errors.Join(errs...)
+	return
+}
+
+// get reads the status of "resource".
+func (notifier *Notifier) get(ctx context.Context, resource Resource) (current observation, err error) {
+	service := notifier.Service
+	var response *core.DetailedResponse
+	switch resource.Kind {
+	case Resource_Kind_DirectorSite:
+		var site *vmwarev1.DirectorSite
+		site, response, err = service.GetDirectorSiteWithContext(ctx, service.NewGetDirectorSiteOptions(resource.ID))
+		if err == nil {
+			current = observation{name: core.StringNilMapper(site.Name), status: core.StringNilMapper(site.Status)}
+		}
+	case Resource_Kind_Vdc:
+		var vdc *vmwarev1.VDC
+		vdc, response, err = service.GetVdcWithContext(ctx, service.NewGetVdcOptions(resource.ID))
+		if err == nil {
+			current = observation{name: core.StringNilMapper(vdc.Name), status: core.StringNilMapper(vdc.Status)}
+			for _, reason := range vdc.StatusReasons {
+				current.reasons = append(current.reasons, core.StringNilMapper(reason.Code))
+			}
+		}
+	case Resource_Kind_Cluster:
+		var cluster *vmwarev1.Cluster
+		cluster, response, err = service.GetDirectorInstancesPvdcsClusterWithContext(ctx,
+			service.NewGetDirectorInstancesPvdcsClusterOptions(resource.DirectorSiteID, resource.ID, resource.PvdcID))
+		if err == nil {
+			current = observation{name: core.StringNilMapper(cluster.Name), status: core.StringNilMapper(cluster.Status)}
+		}
+	case Resource_Kind_VcdaService:
+		var site *vmwarev1.DirectorSite
+		site, response, err = service.GetDirectorSiteWithContext(ctx, service.NewGetDirectorSiteOptions(resource.DirectorSiteID))
+		if err == nil {
+			current = observation{name: vmwarev1.Service_Name_Vcda, status: Status_NotFound}
+			for _, s := range site.Services {
+				if core.StringNilMapper(s.Name) == vmwarev1.Service_Name_Vcda {
+					current.status = core.StringNilMapper(s.Status)
+				}
+			}
+		}
+	default:
+		err = core.SDKErrorf(nil, fmt.Sprintf("unknown resource kind '%s'", resource.Kind), "unknown-resource-kind", common.GetComponentInfo())
+		return
+	}
+
+	if err != nil && response != nil && response.StatusCode == http.StatusNotFound {
+		return observation{status: Status_NotFound}, nil
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("failed to get %s '%s': %s", resource.Kind, resource.ID, err.Error()), "get-resource-error", common.GetComponentInfo())
+	}
+	return
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
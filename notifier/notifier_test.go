@@ -0,0 +1,294 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package notifier_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vmware-go-sdk/notifier"
+	"github.com/IBM/vmware-go-sdk/vmwarev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// receivedNotification is a request received by the test webhook.
+type receivedNotification struct {
+	body      []byte
+	signature string
+	eventID   string
+	token     string
+}
+
+var _ = Describe(`Notifier`, func() {
+	var (
+		mutex         sync.Mutex
+		statuses      map[string]string
+		apiServer     *httptest.Server
+		received      []receivedNotification
+		responseCodes []int
+		receiver      *httptest.Server
+		vmwareService *vmwarev1.VmwareV1
+		deadLetters   string
+		tempDir       string
+		setStatus     func(path string, status string)
+		receivedCount func() int
+	)
+
+	BeforeEach(func() {
+		statuses = map[string]string{
+			"/vdcs/vdc1":            "creating",
+			"/director_sites/site1": "ready_to_use",
+			"/director_sites/site1/pvdcs/pvdc1/clusters/c1": "creating",
+			"vcda": "creating",
+		}
+		apiServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			mutex.Lock()
+			defer mutex.Unlock()
+			res.Header().Set("Content-type", "application/json")
+			status, found := statuses[req.URL.EscapedPath()]
+			if !found {
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"errors": [{"code": "not_found", "message": "not found"}]}`)
+				return
+			}
+			res.WriteHeader(200)
+			switch req.URL.EscapedPath() {
+			case "/vdcs/vdc1":
+				fmt.Fprintf(res, `{"id": "vdc1", "name": "vdc \"one\"", "status": "%s", "status_reasons": [{"code": "insufficent_cpu", "message": "no cpu"}]}`, status)
+			case "/director_sites/site1":
+				fmt.Fprintf(res, `{"id": "site1", "name": "site", "status": "%s", "services": [{"name": "vcda", "status": "%s"}]}`, status, statuses["vcda"])
+			default:
+				fmt.Fprintf(res, `{"id": "c1", "name": "cluster", "status": "%s"}`, status)
+			}
+		}))
+
+		received = nil
+		responseCodes = nil
+		receiver = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			body, err := io.ReadAll(req.Body)
+			Expect(err).To(BeNil())
+			mutex.Lock()
+			defer mutex.Unlock()
+			received = append(received, receivedNotification{
+				body:      body,
+				signature: req.Header.Get(notifier.SignatureHeader),
+				eventID:   req.Header.Get(notifier.EventIDHeader),
+				token:     req.Header.Get("X-Token"),
+			})
+			code := 200
+			if len(responseCodes) > 0 {
+				code, responseCodes = responseCodes[0], responseCodes[1:]
+			}
+			res.WriteHeader(code)
+		}))
+
+		var serviceErr error
+		vmwareService, serviceErr = vmwarev1.NewVmwareV1(&vmwarev1.VmwareV1Options{
+			URL:           apiServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+
+		tempDir, serviceErr = os.MkdirTemp("", "notifier")
+		Expect(serviceErr).To(BeNil())
+		deadLetters = filepath.Join(tempDir, "dead-letters.jsonl")
+
+		setStatus = func(path string, status string) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			if status == "" {
+				delete(statuses, path)
+			} else {
+				statuses[path] = status
+			}
+		}
+		receivedCount = func() int {
+			mutex.Lock()
+			defer mutex.Unlock()
+			return len(received)
+		}
+	})
+	AfterEach(func() {
+		apiServer.Close()
+		receiver.Close()
+		os.RemoveAll(tempDir)
+	})
+
+	It(`Notifies signed status transitions`, func() {
+		webhook := notifier.NewWebhook("ops", receiver.URL).SetSecret("s3cret").SetHeaders(map[string]string{"X-Token": "abc"})
+		n := notifier.NewNotifier(vmwareService, webhook)
+		n.Watch(notifier.Vdc("vdc1"), notifier.DirectorSite("site1"), notifier.Vdc("vdc1"))
+
+		events, err := n.Poll(context.Background())
+		Expect(err).To(BeNil())
+		Expect(events).To(BeEmpty())
+
+		setStatus("/vdcs/vdc1", vmwarev1.VDC_Status_Failed)
+		events, err = n.Poll(context.Background())
+		Expect(err).To(BeNil())
+		Expect(events).To(HaveLen(1))
+		Expect(events[0].Resource).To(Equal(notifier.Vdc("vdc1")))
+		Expect(events[0].PreviousStatus).To(Equal("creating"))
+		Expect(events[0].Status).To(Equal("failed"))
+		Expect(events[0].Reasons).To(Equal([]string{"insufficent_cpu"}))
+
+		Expect(received).To(HaveLen(1))
+		notification := received[0]
+		Expect(notification.token).To(Equal("abc"))
+		Expect(notification.eventID).To(Equal(events[0].ID))
+		Expect(notifier.VerifySignature("s3cret", notification.signature, notification.body, time.Minute, time.Now())).To(Succeed())
+		Expect(notifier.VerifySignature("other", notification.signature, notification.body, 0, time.Now())).ToNot(Succeed())
+		Expect(notifier.VerifySignature("s3cret", notification.signature, notification.body, time.Minute, time.Now().Add(time.Hour))).ToNot(Succeed())
+
+		var event notifier.Event
+		Expect(json.Unmarshal(notification.body, &event)).To(Succeed())
+		Expect(event.Name).To(Equal(`vdc "one"`))
+		Expect(event.Status).To(Equal("failed"))
+
+		// No transition, no notification.
+		events, err = n.Poll(context.Background())
+		Expect(err).To(BeNil())
+		Expect(events).To(BeEmpty())
+		Expect(received).To(HaveLen(1))
+	})
+	It(`Renders templated payloads and filters statuses`, func() {
+		webhook, err := notifier.NewWebhook("slack", receiver.URL).
+			SetStatuses(vmwarev1.VDC_Status_ReadyToUse, notifier.Status_NotFound).
+			SetTemplate(`{"text": {{ printf "%s %s is now %s" .Resource.Kind .Name .Status | json }}}`)
+		Expect(err).To(BeNil())
+		n := notifier.NewNotifier(vmwareService, webhook)
+		n.Watch(notifier.Vdc("vdc1"), notifier.Cluster("site1", "pvdc1", "c1"), notifier.VcdaService("site1"))
+		_, err = n.Poll(context.Background())
+		Expect(err).To(BeNil())
+
+		setStatus("/vdcs/vdc1", vmwarev1.VDC_Status_ReadyToUse)
+		setStatus("/director_sites/site1/pvdcs/pvdc1/clusters/c1", "")
+		setStatus("vcda", vmwarev1.Service_Status_Updating)
+		events, err := n.Poll(context.Background())
+		Expect(err).To(BeNil())
+		Expect(events).To(HaveLen(3))
+		Expect(events[1].Status).To(Equal(notifier.Status_NotFound))
+		Expect(events[2].Resource.Kind).To(Equal(notifier.Resource_Kind_VcdaService))
+		Expect(events[2].Status).To(Equal("updating"))
+
+		Expect(received).To(HaveLen(2))
+		Expect(string(received[0].body)).To(Equal(`{"text": "vdc vdc \"one\" is now ready_to_use"}`))
+		Expect(string(received[1].body)).To(Equal(`{"text": "cluster  is now not_found"}`))
+		Expect(received[0].signature).To(BeEmpty())
+	})
+	It(`Retries failed deliveries`, func() {
+		responseCodes = []int{503, 429, 200}
+		n := notifier.NewNotifier(vmwareService, notifier.NewWebhook("ops", receiver.URL))
+		n.RetryDelay = time.Millisecond
+		n.DeadLetterPath = deadLetters
+		Expect(n.Notify(context.Background(), notifier.Event{ID: "e1", Status: "failed"})).To(Succeed())
+		Expect(receivedCount()).To(Equal(3))
+		Expect(received[0].eventID).To(Equal("e1"))
+		Expect(received[2].eventID).To(Equal("e1"))
+		_, err := os.Stat(deadLetters)
+		Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+	It(`Works without its constructor`, func() {
+		responseCodes = []int{503, 200}
+		n := &notifier.Notifier{
+			Service:    vmwareService,
+			Webhooks:   []*notifier.Webhook{notifier.NewWebhook("ops", receiver.URL)},
+			RetryDelay: time.Millisecond,
+		}
+		n.Watch(notifier.Vdc("vdc1"))
+		_, err := n.Poll(context.Background())
+		Expect(err).To(BeNil())
+		setStatus("/vdcs/vdc1", vmwarev1.VDC_Status_Failed)
+		events, err := n.Poll(context.Background())
+		Expect(err).To(BeNil())
+		Expect(events).To(HaveLen(1))
+		Expect(receivedCount()).To(Equal(2))
+	})
+	It(`Writes undeliverable notifications to the dead-letter file`, func() {
+		responseCodes = []int{500, 500, 400}
+		n := notifier.NewNotifier(vmwareService,
+			notifier.NewWebhook("ops", receiver.URL+"/secret-path"),
+			notifier.NewWebhook("closed", "http://127.0.0.1:1/hook"))
+		n.RetryDelay = time.Millisecond
+		n.MaxAttempts = 2
+		n.DeadLetterPath = deadLetters
+
+		err := n.Notify(context.Background(), notifier.Event{ID: "e1", Status: "failed"})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("failed to notify webhook 'ops'"))
+		Expect(err.Error()).To(ContainSubstring("failed to notify webhook 'closed'"))
+		Expect(receivedCount()).To(Equal(2))
+
+		err = n.Notify(context.Background(), notifier.Event{ID: "e2", Status: "failed"})
+		Expect(err).ToNot(BeNil())
+		// A 400 response is not retried.
+		Expect(receivedCount()).To(Equal(3))
+
+		info, statErr := os.Stat(deadLetters)
+		Expect(statErr).To(BeNil())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+		content, _ := os.ReadFile(deadLetters)
+		Expect(string(content)).ToNot(ContainSubstring("secret-path"))
+
+		letters, readErr := notifier.ReadDeadLetters(deadLetters)
+		Expect(readErr).To(BeNil())
+		Expect(letters).To(HaveLen(4))
+		Expect(letters[0].Webhook).To(Equal("ops"))
+		Expect(letters[0].Event.ID).To(Equal("e1"))
+		Expect(letters[0].Attempts).To(Equal(2))
+		Expect(letters[0].Error).To(ContainSubstring("500"))
+		Expect(string(letters[0].Payload)).To(ContainSubstring(`"id":"e1"`))
+		Expect(letters[1].Webhook).To(Equal("closed"))
+		Expect(letters[2].Attempts).To(Equal(1))
+	})
+	It(`Runs until its context is canceled`, func() {
+		n := notifier.NewNotifier(vmwareService, notifier.NewWebhook("ops", receiver.URL))
+		n.PollInterval = 10 * time.Millisecond
+		n.Watch(notifier.DirectorSite("site1"), notifier.Vdc("missing"))
+		setStatus("/vdcs/missing", "creating")
+		_, err := n.Poll(context.Background())
+		Expect(err).To(BeNil())
+		setStatus("/vdcs/missing", "")
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			n.Run(ctx, nil)
+		}()
+		Eventually(receivedCount).Should(Equal(1))
+		cancel()
+		Eventually(done).Should(BeClosed())
+
+		n.Unwatch(notifier.Vdc("missing"))
+		setStatus("/vdcs/missing", "creating")
+		events, err := n.Poll(context.Background())
+		Expect(err).To(BeNil())
+		Expect(events).To(BeEmpty())
+	})
+})
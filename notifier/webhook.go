@@ -0,0 +1,356 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vmware-go-sdk/common"
+)
+
+// The headers of a notification.
+const (
+	// The signature of the notification: "t=<unix timestamp>,v1=<hex HMAC-SHA256 of '<timestamp>.<body>'>".
+	SignatureHeader = "X-Vmware-Signature"
+
+	// The ID of the event, which is the same for every attempt.
+	EventIDHeader = "X-Vmware-Event-Id"
+)
+
+// DefaultMaxAttempts is the default number of attempts to deliver a notification.
+const DefaultMaxAttempts = 5
+
+// DefaultRetryDelay is the default delay before the second attempt to deliver a notification.
+const DefaultRetryDelay = time.Second
+
+// maxRetryDelay caps the exponential backoff between attempts.
+const maxRetryDelay = time.Minute
+
+// templateFuncs are the functions available to payload templates. "json" encodes a value as JSON, so that strings
+// are quoted and escaped.
+var templateFuncs = template.FuncMap{
+	"json": func(value interface{}) (string, error) {
+		buf, err := json.Marshal(value)
+		return string(buf), err
+	},
+}
+
+// Webhook : A receiver of notifications.
+type Webhook struct {
+	// A name for the webhook, recorded in the dead-letter file instead of its URL, which may hold a secret.
+	Name string
+
+	// The URL to which notifications are posted.
+	URL string
+
+	// The key with which notifications are signed. If empty, notifications are not signed.
+	Secret string
+
+	// The template of the payload, which must produce JSON. It is executed with the Event as data and may use the
+	// "json" function to encode values. If nil, the Event is posted as JSON.
+	Template *template.Template
+
+	// Additional headers sent with every notification.
+	Headers map[string]string
+
+	// The statuses whose transitions are notified. If empty, every transition is notified.
+	Statuses []string
+}
+
+// NewWebhook : Instantiate Webhook
+func NewWebhook(name string, url string) *Webhook {
+	return &Webhook{
+		Name: name,
+		URL:  url,
+	}
+}
+
+// SetSecret : Allow user to set Secret
+func (_webhook *Webhook) SetSecret(secret string) *Webhook {
+	_webhook.Secret = secret
+	return _webhook
+}
+
+// SetTemplate parses "text" as the payload template of the webhook. For example, a Slack payload:
+//
+//	{"text": {{ printf "%s %s is now %s" .Resource.Kind .Name .Status | json }}}
+func (_webhook *Webhook) SetTemplate(text string) (*Webhook, error) {
+	parsed, err := template.New(_webhook.Name).Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return _webhook, core.SDKErrorf(err, "", "template-parse-error", common.GetComponentInfo())
+	}
+	_webhook.Template = parsed
+	return _webhook, nil
+}
+
+// SetHeaders : Allow user to set Headers
+func (_webhook *Webhook) SetHeaders(headers map[string]string) *Webhook {
+	_webhook.Headers = headers
+	return _webhook
+}
+
+// SetStatuses : Allow user to set Statuses
+func (_webhook *Webhook) SetStatuses(statuses ...string) *Webhook {
+	_webhook.Statuses = statuses
+	return _webhook
+}
+
+// accepts reports whether the webhook is notified of "event".
+func (webhook *Webhook) accepts(event Event) bool {
+	if len(webhook.Statuses) == 0 {
+		return true
+	}
+	for _, status := range webhook.Statuses {
+		if status == event.Status {
+			return true
+		}
+	}
+	return false
+}
+
+// payload builds the body of the notification of "event".
+func (webhook *Webhook) payload(event Event) ([]byte, error) {
+	if webhook.Template == nil {
+		return json.Marshal(event)
+	}
+	var buf bytes.Buffer
+	err := webhook.Template.Execute(&buf, event)
+	if err != nil {
+		return nil, err
+	}
+	if !json.Valid(buf.Bytes()) {
+		return nil, fmt.Errorf("the template of webhook '%s' did not produce valid JSON", webhook.Name)
+	}
+	return buf.Bytes(), nil
+}
+
+// Sign returns the value of the SignatureHeader of a notification with "body" sent at "timestamp".
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	unix := strconv.FormatInt(timestamp.Unix(), 10)
+	return "t=" + unix + ",v1=" + signature(secret, unix, body)
+}
+
+func signature(secret string, unix string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unix))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature checks the SignatureHeader "header" of a notification with "body". Receivers should reject
+// notifications signed more than "tolerance" before "now", to prevent replays; a zero tolerance skips the check.
+func VerifySignature(secret string, header string, body []byte, tolerance time.Duration, now time.Time) error {
+	var unix, received string
+	for _, part := range strings.Split(header, ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch name {
+		case "t":
+			unix = value
+		case "v1":
+			received = value
+		}
+	}
+	seconds, err := strconv.ParseInt(unix, 10, 64)
+	if err != nil || received == "" {
+		return core.SDKErrorf(nil, "the signature header is malformed", "signature-malformed", common.GetComponentInfo())
+	}
+	if !hmac.Equal([]byte(received), []byte(signature(secret, unix, body))) {
+		return core.SDKErrorf(nil, "the signature does not match", "signature-mismatch", common.GetComponentInfo())
+	}
+	if tolerance > 0 && now.Sub(time.Unix(seconds, 0)) > tolerance {
+		return core.SDKErrorf(nil, "the signature has expired", "signature-expired", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// DeadLetter : A notification that could not be delivered, as recorded in the dead-letter file.
+type DeadLetter struct {
+	// The name of the webhook.
+	Webhook string `json:"webhook"`
+
+	// The event of the notification.
+	Event Event `json:"event"`
+
+	// The payload that was posted, if it could be built.
+	Payload json.RawMessage `json:"payload,omitempty"`
+
+	// The number of attempts that were made.
+	Attempts int `json:"attempts"`
+
+	// The error of the last attempt.
+	Error string `json:"error"`
+
+	// When the notification was given up.
+	FailedAt time.Time `json:"failed_at"`
+}
+
+// deadLetterMutex serializes the appends to dead-letter files.
+var deadLetterMutex sync.Mutex
+
+// ReadDeadLetters reads the dead-letter file at "path".
+func ReadDeadLetters(path string) (deadLetters []DeadLetter, err error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "dead-letter-read-error", common.GetComponentInfo())
+		return
+	}
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	for decoder.More() {
+		var deadLetter DeadLetter
+		err = decoder.Decode(&deadLetter)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "dead-letter-decode-error", common.GetComponentInfo())
+			return
+		}
+		deadLetters = append(deadLetters, deadLetter)
+	}
+	return
+}
+
+// Notify posts the notification of "event" to every webhook that accepts it. A notification that cannot be delivered
+// after the configured attempts is appended to the dead-letter file, and its error is returned.
+func (notifier *Notifier) Notify(ctx context.Context, event Event) error {
+	var errs []error
+	for _, webhook := range notifier.Webhooks {
+		if !webhook.accepts(event) {
+			continue
+		}
+		payload, attempts, err := notifier.deliver(ctx, webhook, event)
+		if err == nil {
+			continue
+		}
+		errs = append(errs, core.SDKErrorf(err, fmt.Sprintf("failed to notify webhook '%s': %s", webhook.Name, err.Error()),
+			"notify-error", common.GetComponentInfo()))
+		deadLetterErr := notifier.deadLetter(DeadLetter{
+			Webhook:  webhook.Name,
+			Event:    event,
+			Payload:  payload,
+			Attempts: attempts,
+			Error:    err.Error(),
+			FailedAt: notifier.currentTime().UTC(),
+		})
+		if deadLetterErr != nil {
+			errs = append(errs, deadLetterErr)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// deliver posts the notification of "event" to "webhook", retrying on network errors, 429 and 5xx responses.
+func (notifier *Notifier) deliver(ctx context.Context, webhook *Webhook, event Event) (payload []byte, attempts int, err error) {
+	payload, err = webhook.payload(event)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	maxAttempts := notifier.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultMaxAttempts
+	}
+	delay := notifier.RetryDelay
+	if delay <= 0 {
+		delay = DefaultRetryDelay
+	}
+	for attempts = 1; ; attempts++ {
+		var retryable bool
+		retryable, err = notifier.post(ctx, webhook, event, payload)
+		if err == nil || !retryable || attempts >= maxAttempts {
+			return
+		}
+		if sleepErr := notifier.wait(ctx, delay); sleepErr != nil {
+			return
+		}
+		delay *= 2
+		if delay > maxRetryDelay {
+			delay = maxRetryDelay
+		}
+	}
+}
+
+// post makes one attempt to post "payload" to "webhook".
+func (notifier *Notifier) post(ctx context.Context, webhook *Webhook, event Event, payload []byte) (retryable bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(payload))
+	if err != nil {
+		return false, err
+	}
+	for name, value := range webhook.Headers {
+		req.Header.Set(name, value)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventIDHeader, event.ID)
+	if webhook.Secret != "" {
+		req.Header.Set(SignatureHeader, Sign(webhook.Secret, notifier.currentTime(), payload))
+	}
+
+	client := notifier.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return ctx.Err() == nil, err
+	}
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 64*1024))
+	res.Body.Close()
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return false, nil
+	}
+	err = fmt.Errorf("the webhook responded with status %d", res.StatusCode)
+	return res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= 500, err
+}
+
+// deadLetter appends "deadLetter" to the dead-letter file.
+func (notifier *Notifier) deadLetter(deadLetter DeadLetter) error {
+	if notifier.DeadLetterPath == "" {
+		return nil
+	}
+	line, err := json.Marshal(deadLetter)
+	if err != nil {
+		return core.SDKErrorf(err, "", "dead-letter-encode-error", common.GetComponentInfo())
+	}
+
+	deadLetterMutex.Lock()
+	defer deadLetterMutex.Unlock()
+	file, err := os.OpenFile(notifier.DeadLetterPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return core.SDKErrorf(err, "", "dead-letter-write-error", common.GetComponentInfo())
+	}
+	_, err = file.Write(append(line, '\n'))
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return core.SDKErrorf(err, "", "dead-letter-write-error", common.GetComponentInfo())
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Clock : The source of time of a Scheduler.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// Sleep waits for "d" to elapse, or for "ctx" to be done, in which case it returns the error of "ctx".
+	Sleep(ctx context.Context, d time.Duration) error
+}
+
+// SystemClock : The Clock of the system.
+type SystemClock struct{}
+
+// Now returns time.Now().
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// Sleep waits for "d" to elapse with a timer.
+func (SystemClock) Sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// FakeClock : A Clock whose time only changes when it is advanced, for tests.
+type FakeClock struct {
+	mutex    sync.Mutex
+	now      time.Time
+	sleepers []*fakeSleeper
+}
+
+type fakeSleeper struct {
+	until time.Time
+	woken chan struct{}
+}
+
+// NewFakeClock : Instantiate FakeClock
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+// Now returns the time of the clock.
+func (clock *FakeClock) Now() time.Time {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+	return clock.now
+}
+
+// Sleep waits until the clock is advanced by "d", or for "ctx" to be done.
+func (clock *FakeClock) Sleep(ctx context.Context, d time.Duration) error {
+	clock.mutex.Lock()
+	if d <= 0 {
+		clock.mutex.Unlock()
+		return ctx.Err()
+	}
+	sleeper := &fakeSleeper{until: clock.now.Add(d), woken: make(chan struct{})}
+	clock.sleepers = append(clock.sleepers, sleeper)
+	clock.mutex.Unlock()
+
+	select {
+	case <-sleeper.woken:
+		return nil
+	case <-ctx.Done():
+		clock.mutex.Lock()
+		defer clock.mutex.Unlock()
+		for i, other := range clock.sleepers {
+			if other == sleeper {
+				clock.sleepers = append(clock.sleepers[:i], clock.sleepers[i+1:]...)
+				break
+			}
+		}
+		return ctx.Err()
+	}
+}
+
+// Advance moves the clock forward by "d" and wakes the sleepers whose time has come.
+func (clock *FakeClock) Advance(d time.Duration) {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+	clock.now = clock.now.Add(d)
+	kept := clock.sleepers[:0]
+	for _, sleeper := range clock.sleepers {
+		if sleeper.until.After(clock.now) {
+			kept = append(kept, sleeper)
+		} else {
+			close(sleeper.woken)
+		}
+	}
+	clock.sleepers = kept
+}
+
+// Sleepers returns the number of calls to Sleep that are waiting, so that a test can advance the clock once the code
+// under test sleeps.
+func (clock *FakeClock) Sleepers() int {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+	return len(clock.sleepers)
+}
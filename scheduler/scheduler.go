@@ -0,0 +1,615 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package scheduler runs changes of VMware resources inside maintenance windows. Changes, such as scaling a cluster,
+// resizing a VDC, swapping the network locations of a high availability edge or deleting a Cloud Director site, are
+// queued with the window in which they may run, and the queue is persisted to a local JSON file.
+//
+// Inside its window, each change is sent and then tracked with a vmwarev1.Operation until it completes. A change that
+// has not started when its window ends is canceled, and so is the tracking of a change that has not completed: the
+// request cannot be recalled, so the task reports that the API may still complete it. A scheduler that is stopped
+// while a change is running resumes tracking it from the queue file.
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vmware-go-sdk/common"
+	"github.com/IBM/vmware-go-sdk/vmwarev1"
+)
+
+// Constants associated with the Change.Kind property.
+// The kind of a change.
+const (
+	Change_Kind_ScaleCluster       = "scale_cluster"
+	Change_Kind_ResizeVdc          = "resize_vdc"
+	Change_Kind_SwapHaEdge         = "swap_ha_edge"
+	Change_Kind_DeleteDirectorSite = "delete_director_site"
+)
+
+// Constants associated with the Task.Status property.
+// The status of a task.
+const (
+	Task_Status_Queued    = "queued"
+	Task_Status_Running   = "running"
+	Task_Status_Succeeded = "succeeded"
+	Task_Status_Failed    = "failed"
+	Task_Status_Canceled  = "canceled"
+)
+
+// DefaultIdleInterval is the interval at which Run checks the queue when no task waits for a window.
+const DefaultIdleInterval = time.Hour
+
+// Change : A change of a resource that must run in a maintenance window.
+type Change struct {
+	// The kind of the change.
+	Kind string `json:"kind"`
+
+	// The IDs of the changed resources.
+	SiteID    string `json:"site_id,omitempty"`
+	PvdcID    string `json:"pvdc_id,omitempty"`
+	ClusterID string `json:"cluster_id,omitempty"`
+	VdcID     string `json:"vdc_id,omitempty"`
+	EdgeID    string `json:"edge_id,omitempty"`
+
+	// The new host count of a cluster.
+	HostCount *int64 `json:"host_count,omitempty"`
+
+	// The new vCPU and RAM limits of a VDC, in GB for RAM. A nil limit is not changed.
+	Cpu *int64 `json:"cpu,omitempty"`
+	Ram *int64 `json:"ram,omitempty"`
+}
+
+// ScaleCluster returns the Change that sets the host count of a cluster.
+func ScaleCluster(siteID string, pvdcID string, clusterID string, hostCount int64) Change {
+	return Change{Kind: Change_Kind_ScaleCluster, SiteID: siteID, PvdcID: pvdcID, ClusterID: clusterID, HostCount: core.Int64Ptr(hostCount)}
+}
+
+// ResizeVdc returns the Change that sets the vCPU and RAM limits of a VDC. A limit of zero is not changed.
+func ResizeVdc(vdcID string, cpu int64, ram int64) Change {
+	change := Change{Kind: Change_Kind_ResizeVdc, VdcID: vdcID}
+	if cpu != 0 {
+		change.Cpu = core.Int64Ptr(cpu)
+	}
+	if ram != 0 {
+		change.Ram = core.Int64Ptr(ram)
+	}
+	return change
+}
+
+// SwapHaEdge returns the Change that swaps the primary and secondary network locations of a high availability edge.
+func SwapHaEdge(vdcID string, edgeID string) Change {
+	return Change{Kind: Change_Kind_SwapHaEdge, VdcID: vdcID, EdgeID: edgeID}
+}
+
+// DeleteDirectorSite returns the Change that deletes a Cloud Director site.
+func DeleteDirectorSite(siteID string) Change {
+	return Change{Kind: Change_Kind_DeleteDirectorSite, SiteID: siteID}
+}
+
+// validate checks that the change has what its kind requires.
+func (change Change) validate() error {
+	var missing string
+	switch change.Kind {
+	case Change_Kind_ScaleCluster:
+		switch {
+		case change.SiteID == "":
+			missing = "site_id"
+		case change.PvdcID == "":
+			missing = "pvdc_id"
+		case change.ClusterID == "":
+			missing = "cluster_id"
+		case change.HostCount == nil:
+			missing = "host_count"
+		}
+	case Change_Kind_ResizeVdc:
+		switch {
+		case change.VdcID == "":
+			missing = "vdc_id"
+		case change.Cpu == nil && change.Ram == nil:
+			missing = "cpu or ram"
+		}
+	case Change_Kind_SwapHaEdge:
+		switch {
+		case change.VdcID == "":
+			missing = "vdc_id"
+		case change.EdgeID == "":
+			missing = "edge_id"
+		}
+	case Change_Kind_DeleteDirectorSite:
+		if change.SiteID == "" {
+			missing = "site_id"
+		}
+	default:
+		return core.SDKErrorf(nil, fmt.Sprintf("unknown change kind '%s'", change.Kind), "unknown-change-kind", common.GetComponentInfo())
+	}
+	if missing != "" {
+		return core.SDKErrorf(nil, fmt.Sprintf("a %s change requires %s", change.Kind, missing), "invalid-change", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// Task : A queued change and its outcome.
+type Task struct {
+	// The ID of the task, unique within its queue.
+	ID string `json:"id"`
+
+	// The change.
+	Change Change `json:"change"`
+
+	// The spec of the maintenance window of the change. See ParseWindow.
+	Window string `json:"window"`
+
+	// The status of the task.
+	Status string `json:"status"`
+
+	// Explains why the task failed or was canceled.
+	Message string `json:"message,omitempty"`
+
+	// When the task was queued.
+	QueuedAt time.Time `json:"queued_at"`
+
+	// The occurrence of the window in which the task ran, once it opened.
+	WindowStart *time.Time `json:"window_start,omitempty"`
+	WindowEnd   *time.Time `json:"window_end,omitempty"`
+
+	// When the change was sent, and when the task ended.
+	StartedAt  *time.Time `json:"started_at,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+
+	// The operation that tracks the change, once it is sent.
+	Operation *vmwarev1.Operation `json:"operation,omitempty"`
+}
+
+// queueFile is the format of the queue file.
+type queueFile struct {
+	Sequence int     `json:"sequence"`
+	Tasks    []*Task `json:"tasks"`
+}
+
+// Scheduler : Runs queued changes inside their maintenance windows.
+type Scheduler struct {
+	// The service client used to send and track the changes.
+	Service *vmwarev1.VmwareV1
+
+	// The file that the queue is persisted to. If empty, the queue is only kept in memory.
+	QueuePath string
+
+	// The source of time. If nil, the SystemClock is used.
+	Clock Clock
+
+	// Controls how the changes are polled until they complete. The wait always ends with the window.
+	WaitOptions *vmwarev1.WaitOptions
+
+	// Called with a copy of a task whenever its status changes. It may be nil.
+	OnUpdate func(task Task)
+
+	mutex    sync.Mutex
+	tasks    []*Task
+	sequence int
+	wake     chan struct{}
+}
+
+// NewScheduler : Instantiate Scheduler
+// The tasks of the queue file at "queuePath" are loaded, if it exists.
+func NewScheduler(service *vmwarev1.VmwareV1, queuePath string) (*Scheduler, error) {
+	scheduler := &Scheduler{
+		Service:   service,
+		QueuePath: queuePath,
+		wake:      make(chan struct{}, 1),
+	}
+	if queuePath == "" {
+		return scheduler, nil
+	}
+	data, err := os.ReadFile(queuePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return scheduler, nil
+	}
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "queue-read-error", common.GetComponentInfo())
+	}
+	queue := queueFile{}
+	err = json.Unmarshal(data, &queue)
+	if err != nil {
+		return nil, core.SDKErrorf(err, fmt.Sprintf("invalid queue file '%s': %s", queuePath, err.Error()), "queue-decode-error", common.GetComponentInfo())
+	}
+	scheduler.tasks = queue.Tasks
+	scheduler.sequence = queue.Sequence
+	return scheduler, nil
+}
+
+func (scheduler *Scheduler) clock() Clock {
+	if scheduler.Clock == nil {
+		return SystemClock{}
+	}
+	return scheduler.Clock
+}
+
+// Enqueue queues "change" to run in "window" and returns its task. The window must open in the future or be open.
+func (scheduler *Scheduler) Enqueue(change Change, window string) (*Task, error) {
+	err := change.validate()
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := ParseWindow(window)
+	if err != nil {
+		return nil, err
+	}
+	now := scheduler.clock().Now()
+	if _, _, ok := parsed.Next(now); !ok {
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("window '%s' never opens after %s", window, now.UTC().Format(time.RFC3339)), "window-closed", common.GetComponentInfo())
+	}
+
+	scheduler.mutex.Lock()
+	scheduler.sequence++
+	task := &Task{
+		ID:       "task-" + strconv.Itoa(scheduler.sequence),
+		Change:   change,
+		Window:   parsed.String(),
+		Status:   Task_Status_Queued,
+		QueuedAt: now.UTC(),
+	}
+	scheduler.tasks = append(scheduler.tasks, task)
+	copied := *task
+	err = scheduler.save()
+	scheduler.mutex.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case scheduler.wake <- struct{}{}:
+	default:
+	}
+	scheduler.report(copied)
+	return &copied, nil
+}
+
+// Cancel cancels a task that is still queued.
+func (scheduler *Scheduler) Cancel(taskID string) error {
+	scheduler.mutex.Lock()
+	var task *Task
+	for _, t := range scheduler.tasks {
+		if t.ID == taskID {
+			task = t
+		}
+	}
+	scheduler.mutex.Unlock()
+	if task == nil {
+		return core.SDKErrorf(nil, fmt.Sprintf("there is no task '%s'", taskID), "task-not-found", common.GetComponentInfo())
+	}
+
+	status := ""
+	err := scheduler.update(task, func(task *Task) {
+		status = task.Status
+		if status == Task_Status_Queued {
+			scheduler.finish(task, Task_Status_Canceled, "canceled before it ran")
+		}
+	})
+	if err == nil && status != Task_Status_Queued {
+		err = core.SDKErrorf(nil, fmt.Sprintf("task '%s' is %s and can no longer be canceled", taskID, status), "task-not-queued", common.GetComponentInfo())
+	}
+	return err
+}
+
+// Tasks returns copies of the tasks of the queue, in order.
+func (scheduler *Scheduler) Tasks() []Task {
+	scheduler.mutex.Lock()
+	defer scheduler.mutex.Unlock()
+	tasks := make([]Task, 0, len(scheduler.tasks))
+	for _, task := range scheduler.tasks {
+		tasks = append(tasks, *task)
+	}
+	return tasks
+}
+
+// Run runs the tasks whose windows are open, then sleeps until the next window opens, until "ctx" is canceled.
+// Errors are passed to "onError", which may be nil; they do not stop the scheduler.
+func (scheduler *Scheduler) Run(ctx context.Context, onError func(error)) {
+	for {
+		err := scheduler.RunPending(ctx)
+		if err != nil && onError != nil {
+			onError(err)
+		}
+		if ctx.Err() != nil {
+			return
+		}
+
+		sleepCtx, stop := context.WithCancel(ctx)
+		go func() {
+			select {
+			case <-scheduler.wake:
+				stop()
+			case <-sleepCtx.Done():
+			}
+		}()
+		_ = scheduler.clock().Sleep(sleepCtx, scheduler.untilNextWindow())
+		stop()
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// untilNextWindow returns the time until the window of a queued task opens.
+func (scheduler *Scheduler) untilNextWindow() time.Duration {
+	now := scheduler.clock().Now()
+	next := DefaultIdleInterval
+	scheduler.mutex.Lock()
+	defer scheduler.mutex.Unlock()
+	for _, task := range scheduler.tasks {
+		if task.Status != Task_Status_Queued {
+			continue
+		}
+		window, err := ParseWindow(task.Window)
+		if err != nil {
+			return 0
+		}
+		start, _, ok := window.Next(now)
+		if !ok || !start.After(now) {
+			return 0
+		}
+		if start.Sub(now) < next {
+			next = start.Sub(now)
+		}
+	}
+	return next
+}
+
+// RunPending runs, in order, the queued tasks whose windows are open and resumes the tracking of the running tasks.
+// It returns once they have ended, or when "ctx" is canceled, which leaves the tasks to run later. Tasks whose windows
+// will not open again are canceled. The returned error joins the failures of the tasks and of the queue file.
+func (scheduler *Scheduler) RunPending(ctx context.Context) error {
+	now := scheduler.clock().Now()
+	var due []*Task
+	var errs []error
+
+	scheduler.mutex.Lock()
+	tasks := append([]*Task(nil), scheduler.tasks...)
+	statuses := make([]string, len(tasks))
+	for i, task := range tasks {
+		statuses[i] = task.Status
+	}
+	scheduler.mutex.Unlock()
+	for i, task := range tasks {
+		var err error
+		switch statuses[i] {
+		case Task_Status_Running:
+			due = append(due, task)
+		case Task_Status_Queued:
+			window, parseErr := ParseWindow(task.Window)
+			if parseErr != nil {
+				err = scheduler.update(task, func(task *Task) {
+					scheduler.finish(task, Task_Status_Failed, parseErr.Error())
+				})
+				break
+			}
+			start, end, ok := window.Next(now)
+			switch {
+			case !ok:
+				err = scheduler.update(task, func(task *Task) {
+					scheduler.finish(task, Task_Status_Canceled, "the window closed before the task ran")
+				})
+			case !start.After(now):
+				err = scheduler.update(task, func(task *Task) {
+					task.WindowStart, task.WindowEnd = timePtr(start), timePtr(end)
+				})
+				due = append(due, task)
+			}
+		}
+		errs = append(errs, err)
+	}
+
+	for _, task := range due {
+		if ctx.Err() != nil {
+			break
+		}
+		errs = append(errs, scheduler.run(ctx, task))
+	}
+	return errors.Join(errs...)
+}
+
+// run runs "task" in the occurrence of its window that is open, or resumes tracking its change.
+func (scheduler *Scheduler) run(ctx context.Context, task *Task) error {
+	clock := scheduler.clock()
+	scheduler.mutex.Lock()
+	change := task.Change
+	operation := task.Operation
+	windowEnd := task.WindowEnd
+	scheduler.mutex.Unlock()
+
+	now := clock.Now()
+	if windowEnd == nil || !now.Before(*windowEnd) {
+		message := "the window ended before the task started"
+		if operation != nil {
+			message = "the window ended before the change completed; the API may still complete it"
+		}
+		return scheduler.update(task, func(task *Task) {
+			scheduler.finish(task, Task_Status_Canceled, message)
+		})
+	}
+
+	// The window end cancels the context of the change.
+	windowCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var windowEnded atomic.Bool
+	go func() {
+		if clock.Sleep(windowCtx, windowEnd.Sub(now)) == nil {
+			windowEnded.Store(true)
+			cancel()
+		}
+	}()
+
+	var err error
+	if operation != nil {
+		operation, err = scheduler.resume(operation)
+		if err == nil {
+			scheduler.mutex.Lock()
+			task.Operation = operation
+			scheduler.mutex.Unlock()
+		}
+	} else {
+		operation, err = scheduler.start(windowCtx, change)
+		if err == nil {
+			err = scheduler.update(task, func(task *Task) {
+				task.Status = Task_Status_Running
+				task.StartedAt = timePtr(clock.Now().UTC())
+				task.Operation = operation
+			})
+		}
+	}
+	if err == nil {
+		waitOptions := &vmwarev1.WaitOptions{Timeout: windowEnd.Sub(now)}
+		if scheduler.WaitOptions != nil {
+			waitOptions.PollInterval = scheduler.WaitOptions.PollInterval
+		}
+		operation.WaitOptions = waitOptions
+		err = operation.Wait(windowCtx)
+	}
+
+	switch {
+	case windowEnded.Load():
+		message := "the window ended before the task started"
+		if operation != nil {
+			message = "the window ended before the change completed; the API may still complete it"
+		}
+		return scheduler.update(task, func(task *Task) {
+			scheduler.finish(task, Task_Status_Canceled, message)
+		})
+	case ctx.Err() != nil:
+		// The scheduler is stopping: the task runs again, or is tracked again, when it restarts.
+		return nil
+	case err != nil:
+		failure := core.SDKErrorf(err, fmt.Sprintf("task '%s' failed: %s", task.ID, err.Error()), "task-failed", common.GetComponentInfo())
+		updateErr := scheduler.update(task, func(task *Task) {
+			scheduler.finish(task, Task_Status_Failed, err.Error())
+		})
+		return errors.Join(failure, updateErr)
+	}
+	return scheduler.update(task, func(task *Task) {
+		scheduler.finish(task, Task_Status_Succeeded, "")
+	})
+}
+
+// start sends "change" and returns the operation that tracks it.
+func (scheduler *Scheduler) start(ctx context.Context, change Change) (operation *vmwarev1.Operation, err error) {
+	service := scheduler.Service
+	switch change.Kind {
+	case Change_Kind_ScaleCluster:
+		var patch map[string]interface{}
+		patch, err = (&vmwarev1.ClusterPatch{HostCount: change.HostCount}).AsPatch()
+		if err == nil {
+			options := service.NewUpdateDirectorSitesPvdcsClusterOptions(change.SiteID, change.ClusterID, change.PvdcID, patch)
+			operation, _, err = service.StartUpdateDirectorSitesPvdcsClusterWithContext(ctx, options)
+		}
+	case Change_Kind_ResizeVdc:
+		var patch map[string]interface{}
+		patch, err = (&vmwarev1.VDCPatch{Cpu: change.Cpu, Ram: change.Ram}).AsPatch()
+		if err == nil {
+			operation, _, err = service.StartUpdateVdcWithContext(ctx, service.NewUpdateVdcOptions(change.VdcID, patch))
+		}
+	case Change_Kind_SwapHaEdge:
+		operation, _, err = service.StartSwapHaEdgeSitesWithContext(ctx, service.NewSwapHaEdgeSitesOptions(change.VdcID, change.EdgeID))
+	case Change_Kind_DeleteDirectorSite:
+		operation, _, err = service.StartDeleteDirectorSiteWithContext(ctx, service.NewDeleteDirectorSiteOptions(change.SiteID))
+	default:
+		err = change.validate()
+	}
+	return
+}
+
+// resume attaches an operation loaded from the queue file to the service.
+func (scheduler *Scheduler) resume(operation *vmwarev1.Operation) (*vmwarev1.Operation, error) {
+	data, err := json.Marshal(operation)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "operation-encode-error", common.GetComponentInfo())
+	}
+	return scheduler.Service.ResumeOperation(data)
+}
+
+// update changes "task" with "change", persists the queue and reports the task.
+func (scheduler *Scheduler) update(task *Task, change func(task *Task)) error {
+	scheduler.mutex.Lock()
+	change(task)
+	copied := *task
+	err := scheduler.save()
+	scheduler.mutex.Unlock()
+	scheduler.report(copied)
+	return err
+}
+
+// finish ends "task" with "status". The mutex must be held.
+func (scheduler *Scheduler) finish(task *Task, status string, message string) {
+	task.Status = status
+	task.Message = message
+	task.FinishedAt = timePtr(scheduler.clock().Now().UTC())
+}
+
+func (scheduler *Scheduler) report(task Task) {
+	if scheduler.OnUpdate != nil {
+		scheduler.OnUpdate(task)
+	}
+}
+
+// save replaces the queue file atomically. The mutex must be held.
+func (scheduler *Scheduler) save() (err error) {
+	if scheduler.QueuePath == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(queueFile{Sequence: scheduler.sequence, Tasks: scheduler.tasks}, "", "  ")
+	if err != nil {
+		return core.SDKErrorf(err, "", "queue-encode-error", common.GetComponentInfo())
+	}
+	file, err := os.CreateTemp(filepath.Dir(scheduler.QueuePath), "."+filepath.Base(scheduler.QueuePath)+".*")
+	if err != nil {
+		return core.SDKErrorf(err, "", "queue-write-error", common.GetComponentInfo())
+	}
+	defer func() {
+		if err != nil {
+			_ = os.Remove(file.Name())
+		}
+	}()
+
+	err = file.Chmod(0600)
+	if err == nil {
+		_, err = file.Write(data)
+	}
+	closeErr := file.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), scheduler.QueuePath)
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "queue-write-error", common.GetComponentInfo())
+	}
+	return
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
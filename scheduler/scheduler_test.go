@@ -0,0 +1,324 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package scheduler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vmware-go-sdk/scheduler"
+	"github.com/IBM/vmware-go-sdk/vmwarev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Scheduler`, func() {
+	var (
+		mutex         sync.Mutex
+		apiServer     *httptest.Server
+		vmwareService *vmwarev1.VmwareV1
+		clock         *scheduler.FakeClock
+		tempDir       string
+		queuePath     string
+
+		// The status that the resources report after they are changed, by path, and the changes received. The first read
+		// after a change reports the resource as changing.
+		settled  map[string]string
+		changing map[string]string
+		statuses map[string]string
+		patches  map[string]map[string]interface{}
+		received []string
+		updates  []scheduler.Task
+	)
+
+	newScheduler := func() *scheduler.Scheduler {
+		s, err := scheduler.NewScheduler(vmwareService, queuePath)
+		Expect(err).To(BeNil())
+		s.Clock = clock
+		s.WaitOptions = vmwareService.NewWaitOptions(time.Millisecond, 0)
+		s.OnUpdate = func(task scheduler.Task) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			updates = append(updates, task)
+		}
+		return s
+	}
+	setStatus := func(path string, status string) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		statuses[path] = status
+	}
+	receivedChanges := func() []string {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return append([]string(nil), received...)
+	}
+	taskStatus := func(s *scheduler.Scheduler, id string) func() string {
+		return func() string {
+			for _, task := range s.Tasks() {
+				if task.ID == id {
+					return task.Status
+				}
+			}
+			return ""
+		}
+	}
+
+	BeforeEach(func() {
+		settled = map[string]string{
+			"/vdcs/vdc1": "ready_to_use",
+			"/vdcs/vdc2": "ready_to_use",
+			"/director_sites/site1/pvdcs/pvdc1/clusters/cluster1": "ready_to_use",
+		}
+		changing = map[string]string{}
+		statuses = map[string]string{}
+		patches = map[string]map[string]interface{}{}
+		received = nil
+		updates = nil
+		apiServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			mutex.Lock()
+			defer mutex.Unlock()
+			res.Header().Set("Content-type", "application/json")
+			path := req.URL.Path
+			if req.Method != http.MethodGet {
+				received = append(received, req.Method+" "+path)
+			}
+			switch {
+			case strings.HasSuffix(path, "/swap_primary_and_secondary_network_locations"):
+				statuses["/vdcs/vdc2"] = settled["/vdcs/vdc2"]
+				changing["/vdcs/vdc2"] = "modifying"
+				res.WriteHeader(202)
+				fmt.Fprint(res, `{"message": "The swap was accepted."}`)
+			case strings.HasPrefix(path, "/vdcs/"):
+				if req.Method == http.MethodPatch {
+					statuses[path] = settled[path]
+					changing[path] = "modifying"
+					var patch map[string]interface{}
+					Expect(json.NewDecoder(req.Body).Decode(&patch)).To(Succeed())
+					patches[path] = patch
+				}
+				status, ok := statuses[path]
+				if !ok {
+					status = "ready_to_use"
+				}
+				if req.Method == http.MethodGet && changing[path] != "" {
+					status = changing[path]
+					delete(changing, path)
+				}
+				vdc := map[string]interface{}{
+					"id":     strings.TrimPrefix(path, "/vdcs/"),
+					"status": status,
+					"edges":  []map[string]string{{"id": "edge1", "status": "ready_to_use", "type": "performance"}},
+				}
+				for name, value := range patches[path] {
+					vdc[name] = value
+				}
+				res.WriteHeader(200)
+				Expect(json.NewEncoder(res).Encode(vdc)).To(Succeed())
+			case strings.Contains(path, "/clusters/"):
+				if req.Method == http.MethodPatch {
+					statuses[path] = settled[path]
+					changing[path] = "updating"
+				}
+				status := statuses[path]
+				if req.Method == http.MethodGet && changing[path] != "" {
+					status = changing[path]
+					delete(changing, path)
+				}
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"id": "cluster1", "name": "cluster1", "host_count": 6, "status": "%s"}`, status)
+			case strings.HasPrefix(path, "/director_sites/"):
+				if req.Method == http.MethodDelete {
+					statuses[path] = "deleted"
+					res.WriteHeader(202)
+				} else {
+					res.WriteHeader(200)
+				}
+				fmt.Fprintf(res, `{"id": "site1", "name": "site1", "status": "%s"}`, statuses[path])
+			default:
+				Fail(fmt.Sprintf("unexpected request %s %s", req.Method, path))
+			}
+		}))
+		var serviceErr error
+		vmwareService, serviceErr = vmwarev1.NewVmwareV1(&vmwarev1.VmwareV1Options{
+			URL:           apiServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+
+		// 2026-10-21 is a Wednesday.
+		clock = scheduler.NewFakeClock(time.Date(2026, 10, 21, 1, 0, 0, 0, time.UTC))
+		var err error
+		tempDir, err = os.MkdirTemp("", "scheduler")
+		Expect(err).To(BeNil())
+		queuePath = filepath.Join(tempDir, "queue.json")
+	})
+	AfterEach(func() {
+		apiServer.Close()
+		os.RemoveAll(tempDir)
+	})
+
+	It(`Runs each change when its window opens`, func() {
+		s := newScheduler()
+		resize, err := s.Enqueue(scheduler.ResizeVdc("vdc1", 8, 0), "0 2 * * * 1h")
+		Expect(err).To(BeNil())
+		scale, err := s.Enqueue(scheduler.ScaleCluster("site1", "pvdc1", "cluster1", 6), "0 2 * * * 1h")
+		Expect(err).To(BeNil())
+		swap, err := s.Enqueue(scheduler.SwapHaEdge("vdc2", "edge1"), "2026-10-21T03:00:00Z/30m")
+		Expect(err).To(BeNil())
+		remove, err := s.Enqueue(scheduler.DeleteDirectorSite("site1"), "0 4 * * * 1h")
+		Expect(err).To(BeNil())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		stopped := make(chan struct{})
+		go func() {
+			defer close(stopped)
+			s.Run(ctx, nil)
+		}()
+		defer func() {
+			cancel()
+			<-stopped
+		}()
+
+		Eventually(clock.Sleepers).Should(Equal(1))
+		Expect(receivedChanges()).To(BeEmpty())
+		clock.Advance(time.Hour)
+		Eventually(taskStatus(s, scale.ID)).Should(Equal(scheduler.Task_Status_Succeeded))
+		Expect(taskStatus(s, resize.ID)()).To(Equal(scheduler.Task_Status_Succeeded))
+		Expect(receivedChanges()).To(Equal([]string{"PATCH /vdcs/vdc1", "PATCH /director_sites/site1/pvdcs/pvdc1/clusters/cluster1"}))
+
+		Eventually(clock.Sleepers).Should(Equal(1))
+		clock.Advance(time.Hour)
+		Eventually(taskStatus(s, swap.ID)).Should(Equal(scheduler.Task_Status_Succeeded))
+		Eventually(clock.Sleepers).Should(Equal(1))
+		clock.Advance(time.Hour)
+		Eventually(taskStatus(s, remove.ID)).Should(Equal(scheduler.Task_Status_Succeeded))
+		Expect(receivedChanges()).To(HaveLen(4))
+		Expect(receivedChanges()[3]).To(Equal("DELETE /director_sites/site1"))
+
+		tasks := s.Tasks()
+		Expect(tasks[0].Operation.Type).To(Equal(vmwarev1.Operation_Type_UpdateVdc))
+		Expect(tasks[0].WindowStart.Format(time.RFC3339)).To(Equal("2026-10-21T02:00:00Z"))
+		Expect(tasks[2].Operation.Type).To(Equal(vmwarev1.Operation_Type_SwapHaEdgeSites))
+		Expect(tasks[2].Operation.Message).To(Equal("The swap was accepted."))
+		mutex.Lock()
+		Expect(len(updates)).To(BeNumerically(">=", 12))
+		mutex.Unlock()
+	})
+
+	It(`Cancels what is not done when the window ends`, func() {
+		settled["/vdcs/vdc1"] = "modifying"
+		s := newScheduler()
+		resize, err := s.Enqueue(scheduler.ResizeVdc("vdc1", 8, 64), "0 1 * * * 1h")
+		Expect(err).To(BeNil())
+		scale, err := s.Enqueue(scheduler.ScaleCluster("site1", "pvdc1", "cluster1", 6), "0 1 * * * 1h")
+		Expect(err).To(BeNil())
+
+		done := make(chan error)
+		go func() {
+			done <- s.RunPending(context.Background())
+		}()
+		Eventually(taskStatus(s, resize.ID)).Should(Equal(scheduler.Task_Status_Running))
+		// The end of the window.
+		Eventually(clock.Sleepers).Should(Equal(1))
+		clock.Advance(time.Hour)
+		Eventually(done).Should(Receive(BeNil()))
+
+		tasks := s.Tasks()
+		Expect(tasks[0].Status).To(Equal(scheduler.Task_Status_Canceled))
+		Expect(tasks[0].Message).To(ContainSubstring("may still complete it"))
+		Expect(tasks[1].ID).To(Equal(scale.ID))
+		Expect(tasks[1].Status).To(Equal(scheduler.Task_Status_Canceled))
+		Expect(tasks[1].Message).To(Equal("the window ended before the task started"))
+		Expect(receivedChanges()).To(Equal([]string{"PATCH /vdcs/vdc1"}))
+	})
+
+	It(`Persists the queue and resumes the changes that were running`, func() {
+		settled["/vdcs/vdc1"] = "modifying"
+		s := newScheduler()
+		resize, err := s.Enqueue(scheduler.ResizeVdc("vdc1", 8, 0), "2026-10-21T00:30:00Z/2h")
+		Expect(err).To(BeNil())
+		_, err = s.Enqueue(scheduler.DeleteDirectorSite("site1"), "2026-10-21T02:00:00Z/1h")
+		Expect(err).To(BeNil())
+		queued, err := s.Enqueue(scheduler.DeleteDirectorSite("site1"), "0 3 * * * 1h")
+		Expect(err).To(BeNil())
+		Expect(s.Cancel(queued.ID)).To(Succeed())
+		Expect(s.Cancel(queued.ID)).ToNot(Succeed())
+		Expect(s.Cancel("task-42")).ToNot(Succeed())
+
+		// The scheduler stops while the VDC is modifying.
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error)
+		go func() {
+			done <- s.RunPending(ctx)
+		}()
+		Eventually(taskStatus(s, resize.ID)).Should(Equal(scheduler.Task_Status_Running))
+		cancel()
+		Eventually(done).Should(Receive(BeNil()))
+		Expect(taskStatus(s, resize.ID)()).To(Equal(scheduler.Task_Status_Running))
+
+		// Another scheduler loads the queue and tracks the change until it completes, without sending it again.
+		setStatus("/vdcs/vdc1", "ready_to_use")
+		clock.Advance(time.Hour)
+		resumed := newScheduler()
+		tasks := resumed.Tasks()
+		Expect(tasks).To(HaveLen(3))
+		Expect(tasks[2].Status).To(Equal(scheduler.Task_Status_Canceled))
+		Expect(resumed.RunPending(context.Background())).To(Succeed())
+		tasks = resumed.Tasks()
+		Expect(tasks[0].Status).To(Equal(scheduler.Task_Status_Succeeded))
+		Expect(tasks[0].Operation.Status()).To(Equal(vmwarev1.Operation_Status_Succeeded))
+		Expect(tasks[1].Status).To(Equal(scheduler.Task_Status_Succeeded))
+		Expect(receivedChanges()).To(Equal([]string{"PATCH /vdcs/vdc1", "DELETE /director_sites/site1"}))
+
+		next, err := resumed.Enqueue(scheduler.ResizeVdc("vdc1", 4, 0), "0 9 * * * 1h")
+		Expect(err).To(BeNil())
+		Expect(next.ID).To(Equal("task-4"))
+
+		data, err := os.ReadFile(queuePath)
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(ContainSubstring(`"status": "succeeded"`))
+	})
+
+	It(`Rejects invalid changes and windows that never open`, func() {
+		s := newScheduler()
+		_, err := s.Enqueue(scheduler.ScaleCluster("site1", "", "cluster1", 6), "0 2 * * * 1h")
+		Expect(err).ToNot(BeNil())
+		_, err = s.Enqueue(scheduler.ResizeVdc("vdc1", 0, 0), "0 2 * * * 1h")
+		Expect(err).ToNot(BeNil())
+		_, err = s.Enqueue(scheduler.Change{Kind: "reboot"}, "0 2 * * * 1h")
+		Expect(err).ToNot(BeNil())
+		_, err = s.Enqueue(scheduler.SwapHaEdge("vdc1", "edge1"), "2026-10-20T02:00:00Z/1h")
+		Expect(err).ToNot(BeNil())
+		_, err = s.Enqueue(scheduler.SwapHaEdge("vdc1", "edge1"), "every night")
+		Expect(err).ToNot(BeNil())
+		Expect(s.Tasks()).To(BeEmpty())
+
+		Expect(os.WriteFile(queuePath, []byte(`{"tasks": [`), 0600)).To(Succeed())
+		_, err = scheduler.NewScheduler(vmwareService, queuePath)
+		Expect(err).ToNot(BeNil())
+	})
+})
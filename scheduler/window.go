@@ -0,0 +1,258 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package scheduler
+
+// A maintenance window is written in one of two formats:
+//
+//   - A calendar window is a single interval, "<start>/<end>" or "<start>/<duration>", where the times are RFC 3339
+//     and the duration is a Go duration: "2026-11-07T02:00:00Z/2026-11-07T06:00:00Z" or "2026-11-07T02:00:00Z/4h".
+//   - A recurring window is a cron expression for its start, with the minute, hour, day of month, month and day of
+//     week fields, followed by its duration: "0 2 * * sat 4h" opens every Saturday at 02:00 for four hours. Fields
+//     accept "*", values, ranges, lists and steps, and month and day names. The expression is evaluated in UTC,
+//     unless it is prefixed with the location of the IANA time zone database: "TZ=Europe/Berlin 0 2 * * sat 4h".
+//     As in cron, a day matches if either the day of month or the day of week matches when both are restricted.
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vmware-go-sdk/common"
+)
+
+// cronSearchLimit bounds the search of the next start of a recurring window, such as "0 0 30 2 * 1h", that never
+// opens.
+const cronSearchLimit = 5 * 366 * 24 * time.Hour
+
+// Window : A maintenance window, calendar or recurring.
+type Window struct {
+	spec string
+
+	// The interval of a calendar window.
+	start time.Time
+	end   time.Time
+
+	// The schedule of a recurring window.
+	cron     *cronSchedule
+	duration time.Duration
+	location *time.Location
+}
+
+// cronSchedule has the values that each field of a cron expression matches, as bit sets.
+type cronSchedule struct {
+	minutes     uint64
+	hours       uint64
+	daysOfMonth uint64
+	months      uint64
+	daysOfWeek  uint64
+
+	anyDayOfMonth bool
+	anyDayOfWeek  bool
+}
+
+var monthNames = map[string]int{
+	"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6, "jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+}
+
+var dayNames = map[string]int{
+	"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+}
+
+// ParseWindow returns the window written in "spec".
+func ParseWindow(spec string) (*Window, error) {
+	window, err := parseWindow(strings.TrimSpace(spec))
+	if err != nil {
+		return nil, core.SDKErrorf(err, fmt.Sprintf("invalid window '%s': %s", spec, err.Error()), "invalid-window", common.GetComponentInfo())
+	}
+	window.spec = strings.TrimSpace(spec)
+	return window, nil
+}
+
+func parseWindow(spec string) (*Window, error) {
+	if first, last, isCalendar := strings.Cut(spec, "/"); isCalendar && !strings.ContainsAny(spec, " \t") {
+		start, err := time.Parse(time.RFC3339, first)
+		if err != nil {
+			return nil, err
+		}
+		end, err := time.Parse(time.RFC3339, last)
+		if err != nil {
+			duration, durationErr := time.ParseDuration(last)
+			if durationErr != nil {
+				return nil, fmt.Errorf("the end '%s' is neither a time nor a duration", last)
+			}
+			end = start.Add(duration)
+		}
+		if !end.After(start) {
+			return nil, fmt.Errorf("the window ends before it starts")
+		}
+		return &Window{start: start, end: end}, nil
+	}
+
+	fields := strings.Fields(spec)
+	location := time.UTC
+	if len(fields) > 0 {
+		if name, ok := strings.CutPrefix(fields[0], "TZ="); ok {
+			var err error
+			location, err = time.LoadLocation(name)
+			if err != nil {
+				return nil, err
+			}
+			fields = fields[1:]
+		}
+	}
+	if len(fields) != 6 {
+		return nil, fmt.Errorf("expected a calendar interval, or 5 cron fields and a duration")
+	}
+	duration, err := time.ParseDuration(fields[5])
+	if err != nil {
+		return nil, err
+	}
+	if duration <= 0 {
+		return nil, fmt.Errorf("the duration must be positive")
+	}
+
+	schedule := &cronSchedule{}
+	targets := []struct {
+		bits     *uint64
+		min, max int
+		names    map[string]int
+	}{
+		{&schedule.minutes, 0, 59, nil},
+		{&schedule.hours, 0, 23, nil},
+		{&schedule.daysOfMonth, 1, 31, nil},
+		{&schedule.months, 1, 12, monthNames},
+		{&schedule.daysOfWeek, 0, 7, dayNames},
+	}
+	for i, target := range targets {
+		*target.bits, err = parseCronField(fields[i], target.min, target.max, target.names)
+		if err != nil {
+			return nil, fmt.Errorf("field %d '%s': %s", i+1, fields[i], err.Error())
+		}
+	}
+	// Sunday is 0 or 7.
+	if schedule.daysOfWeek&(1<<7) != 0 {
+		schedule.daysOfWeek |= 1
+	}
+	schedule.anyDayOfMonth = strings.HasPrefix(fields[2], "*")
+	schedule.anyDayOfWeek = strings.HasPrefix(fields[4], "*")
+	return &Window{cron: schedule, duration: duration, location: location}, nil
+}
+
+// parseCronField returns the values matched by a field of a cron expression as a bit set.
+func parseCronField(field string, min int, max int, names map[string]int) (bits uint64, err error) {
+	for _, part := range strings.Split(field, ",") {
+		valueRange, stepText, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			step, err = strconv.Atoi(stepText)
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step '%s'", stepText)
+			}
+		}
+
+		low, high := min, max
+		if valueRange != "*" {
+			lowText, highText, isRange := strings.Cut(valueRange, "-")
+			low, err = parseCronValue(lowText, min, max, names)
+			if err != nil {
+				return 0, err
+			}
+			high = low
+			if isRange {
+				high, err = parseCronValue(highText, min, max, names)
+				if err != nil {
+					return 0, err
+				}
+			} else if hasStep {
+				high = max
+			}
+			if high < low {
+				return 0, fmt.Errorf("invalid range '%s'", valueRange)
+			}
+		}
+		for value := low; value <= high; value += step {
+			bits |= 1 << uint(value)
+		}
+	}
+	return bits, nil
+}
+
+func parseCronValue(text string, min int, max int, names map[string]int) (int, error) {
+	if value, ok := names[strings.ToLower(text)]; ok {
+		return value, nil
+	}
+	value, err := strconv.Atoi(text)
+	if err != nil || value < min || value > max {
+		return 0, fmt.Errorf("invalid value '%s'", text)
+	}
+	return value, nil
+}
+
+// String returns the spec of the window.
+func (window *Window) String() string {
+	return window.spec
+}
+
+// Next returns the first occurrence of the window that ends after "after": the occurrence that is open at "after", if
+// any, or the next one. It returns false if the window never opens after "after".
+func (window *Window) Next(after time.Time) (start time.Time, end time.Time, ok bool) {
+	if window.cron == nil {
+		if !window.end.After(after) {
+			return time.Time{}, time.Time{}, false
+		}
+		return window.start, window.end, true
+	}
+
+	// The first minute at which an occurrence that ends after "after" can start.
+	t := after.Add(-window.duration).In(window.location).Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(cronSearchLimit)
+	schedule := window.cron
+	for t.Before(limit) {
+		year, month, day := t.Date()
+		hour, minute := t.Hour(), t.Minute()
+		var next time.Time
+		switch {
+		case schedule.months&(1<<uint(month)) == 0:
+			next = time.Date(year, month+1, 1, 0, 0, 0, 0, window.location)
+		case !schedule.matchesDay(t):
+			next = time.Date(year, month, day+1, 0, 0, 0, 0, window.location)
+		case schedule.hours&(1<<uint(hour)) == 0:
+			next = time.Date(year, month, day, hour+1, 0, 0, 0, window.location)
+		case schedule.minutes&(1<<uint(minute)) == 0:
+			next = time.Date(year, month, day, hour, minute+1, 0, 0, window.location)
+		default:
+			return t, t.Add(window.duration), true
+		}
+		// Around daylight saving time transitions, the wall clock can map to an earlier instant.
+		if !next.After(t) {
+			next = t.Add(time.Minute)
+		}
+		t = next
+	}
+	return time.Time{}, time.Time{}, false
+}
+
+func (schedule *cronSchedule) matchesDay(t time.Time) bool {
+	dayOfMonth := schedule.daysOfMonth&(1<<uint(t.Day())) != 0
+	dayOfWeek := schedule.daysOfWeek&(1<<uint(t.Weekday())) != 0
+	if schedule.anyDayOfMonth || schedule.anyDayOfWeek {
+		return dayOfMonth && dayOfWeek
+	}
+	return dayOfMonth || dayOfWeek
+}
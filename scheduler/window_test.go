@@ -0,0 +1,119 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package scheduler_test
+
+import (
+	"time"
+
+	"github.com/IBM/vmware-go-sdk/scheduler"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Window`, func() {
+	at := func(value string) time.Time {
+		t, err := time.Parse(time.RFC3339, value)
+		Expect(err).To(BeNil())
+		return t
+	}
+	next := func(window *scheduler.Window, after string) (string, string) {
+		start, end, ok := window.Next(at(after))
+		if !ok {
+			return "", ""
+		}
+		return start.UTC().Format(time.RFC3339), end.UTC().Format(time.RFC3339)
+	}
+
+	It(`Parses calendar windows`, func() {
+		window, err := scheduler.ParseWindow("2026-11-07T02:00:00Z/2026-11-07T06:00:00Z")
+		Expect(err).To(BeNil())
+		start, end := next(window, "2026-11-01T00:00:00Z")
+		Expect(start).To(Equal("2026-11-07T02:00:00Z"))
+		Expect(end).To(Equal("2026-11-07T06:00:00Z"))
+		start, _ = next(window, "2026-11-07T05:59:00Z")
+		Expect(start).To(Equal("2026-11-07T02:00:00Z"))
+		start, _ = next(window, "2026-11-07T06:00:00Z")
+		Expect(start).To(BeEmpty())
+
+		window, err = scheduler.ParseWindow("2026-11-07T02:00:00+01:00/90m")
+		Expect(err).To(BeNil())
+		Expect(window.String()).To(Equal("2026-11-07T02:00:00+01:00/90m"))
+		start, end = next(window, "2026-11-01T00:00:00Z")
+		Expect(start).To(Equal("2026-11-07T01:00:00Z"))
+		Expect(end).To(Equal("2026-11-07T02:30:00Z"))
+	})
+
+	It(`Parses recurring windows`, func() {
+		// 2026-10-21 is a Wednesday.
+		window, err := scheduler.ParseWindow("0 2 * * sat 4h")
+		Expect(err).To(BeNil())
+		start, end := next(window, "2026-10-21T12:00:00Z")
+		Expect(start).To(Equal("2026-10-24T02:00:00Z"))
+		Expect(end).To(Equal("2026-10-24T06:00:00Z"))
+		// The occurrence that is open is returned.
+		start, _ = next(window, "2026-10-24T05:00:00Z")
+		Expect(start).To(Equal("2026-10-24T02:00:00Z"))
+		start, _ = next(window, "2026-10-24T06:00:00Z")
+		Expect(start).To(Equal("2026-10-31T02:00:00Z"))
+
+		window, err = scheduler.ParseWindow("*/20 22-23 * * mon-fri 10m")
+		Expect(err).To(BeNil())
+		start, _ = next(window, "2026-10-23T22:35:00Z")
+		Expect(start).To(Equal("2026-10-23T22:40:00Z"))
+		start, _ = next(window, "2026-10-23T23:50:00Z")
+		Expect(start).To(Equal("2026-10-26T22:00:00Z"))
+
+		// When both days are restricted, either matches.
+		window, err = scheduler.ParseWindow("30 1 1,15 jan,jul 0 1h")
+		Expect(err).To(BeNil())
+		start, _ = next(window, "2026-10-21T00:00:00Z")
+		Expect(start).To(Equal("2027-01-01T01:30:00Z"))
+		start, _ = next(window, "2027-01-02T00:00:00Z")
+		Expect(start).To(Equal("2027-01-03T01:30:00Z"))
+
+		window, err = scheduler.ParseWindow("TZ=Europe/Berlin 0 9 * * 7 2h")
+		Expect(err).To(BeNil())
+		start, _ = next(window, "2026-10-17T00:00:00Z")
+		Expect(start).To(Equal("2026-10-18T07:00:00Z"))
+		// Summer time ends on 2026-10-25.
+		start, _ = next(window, "2026-10-21T00:00:00Z")
+		Expect(start).To(Equal("2026-10-25T08:00:00Z"))
+
+		window, err = scheduler.ParseWindow("0 0 30 2 * 1h")
+		Expect(err).To(BeNil())
+		start, _ = next(window, "2026-10-21T00:00:00Z")
+		Expect(start).To(BeEmpty())
+	})
+
+	It(`Rejects invalid windows`, func() {
+		for _, spec := range []string{
+			"",
+			"0 2 * * sat",
+			"0 2 * * sat -1h",
+			"60 2 * * * 1h",
+			"0 2 * * fun 1h",
+			"0 5-2 * * * 1h",
+			"*/0 * * * * 1h",
+			"TZ=Nowhere/Else 0 2 * * * 1h",
+			"2026-11-07T02:00:00Z/2026-11-07T01:00:00Z",
+			"2026-11-07/4h",
+			"2026-11-07T02:00:00Z/soon",
+		} {
+			_, err := scheduler.ParseWindow(spec)
+			Expect(err).ToNot(BeNil(), spec)
+		}
+	})
+})
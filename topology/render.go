@@ -0,0 +1,182 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package topology
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vmware-go-sdk/common"
+)
+
+// The shapes of the nodes in Graphviz.
+var dotShapes = map[string]string{
+	Node_Kind_ResourceGroup:  "folder",
+	Node_Kind_DirectorSite:   "box3d",
+	Node_Kind_Pvdc:           "box",
+	Node_Kind_Cluster:        "component",
+	Node_Kind_Vdc:            "box",
+	Node_Kind_Edge:           "hexagon",
+	Node_Kind_TransitGateway: "ellipse",
+	Node_Kind_VcdaConnection: "cds",
+	Node_Kind_C2cConnection:  "cds",
+	Node_Kind_C2cPeer:        "doubleoctagon",
+}
+
+// The titles of the node kinds in labels.
+var kindTitles = map[string]string{
+	Node_Kind_ResourceGroup:  "Resource group",
+	Node_Kind_DirectorSite:   "Director site",
+	Node_Kind_Pvdc:           "PVDC",
+	Node_Kind_Cluster:        "Cluster",
+	Node_Kind_Vdc:            "VDC",
+	Node_Kind_Edge:           "Edge",
+	Node_Kind_TransitGateway: "Transit Gateway",
+	Node_Kind_VcdaConnection: "VCDA connection",
+	Node_Kind_C2cConnection:  "C2C connection",
+	Node_Kind_C2cPeer:        "C2C peer",
+}
+
+// labelLines returns the lines of the label of "node": its kind, its name and its status.
+func labelLines(node Node) []string {
+	lines := []string{}
+	if title, ok := kindTitles[node.Kind]; ok {
+		lines = append(lines, title)
+	}
+	lines = append(lines, node.Label)
+	if node.Status != "" {
+		lines = append(lines, "("+node.Status+")")
+	}
+	return lines
+}
+
+// DOT returns the graph in the Graphviz DOT language, with the nodes filled with the color of their status.
+func (graph *Graph) DOT() string {
+	var builder strings.Builder
+	builder.WriteString("digraph topology {\n")
+	builder.WriteString("  rankdir=LR;\n")
+	builder.WriteString("  node [style=filled, fontcolor=white, fontname=\"Helvetica\"];\n")
+	for _, node := range graph.Nodes {
+		shape := dotShapes[node.Kind]
+		if shape == "" {
+			shape = "box"
+		}
+		escaped := []string{}
+		for _, line := range labelLines(node) {
+			escaped = append(escaped, dotEscape(line))
+		}
+		fmt.Fprintf(&builder, "  %s [label=\"%s\", shape=%s, fillcolor=\"%s\"];\n",
+			dotQuote(node.ID), strings.Join(escaped, `\n`), shape, node.Color)
+	}
+	for _, edge := range graph.Edges {
+		if edge.Label != "" {
+			fmt.Fprintf(&builder, "  %s -> %s [label=\"%s\"];\n", dotQuote(edge.From), dotQuote(edge.To), dotEscape(edge.Label))
+		} else {
+			fmt.Fprintf(&builder, "  %s -> %s;\n", dotQuote(edge.From), dotQuote(edge.To))
+		}
+	}
+	builder.WriteString("}\n")
+	return builder.String()
+}
+
+func dotEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s)
+}
+
+func dotQuote(s string) string {
+	return `"` + dotEscape(s) + `"`
+}
+
+// Mermaid returns the graph as a Mermaid flowchart, with the nodes filled with the color of their status. Mermaid
+// does not accept arbitrary node IDs, so the nodes are numbered in the order of the graph.
+func (graph *Graph) Mermaid() string {
+	var builder strings.Builder
+	builder.WriteString("flowchart LR\n")
+
+	ids := map[string]string{}
+	classes := map[string]string{}
+	classOrder := []string{}
+	for i, node := range graph.Nodes {
+		id := fmt.Sprintf("n%d", i)
+		ids[node.ID] = id
+		escaped := []string{}
+		for _, line := range labelLines(node) {
+			escaped = append(escaped, mermaidEscape(line))
+		}
+		fmt.Fprintf(&builder, "  %s[\"%s\"]\n", id, strings.Join(escaped, "<br/>"))
+
+		class := "status" + strings.TrimPrefix(node.Color, "#")
+		if _, ok := classes[class]; !ok {
+			classes[class] = node.Color
+			classOrder = append(classOrder, class)
+		}
+	}
+	for _, edge := range graph.Edges {
+		from, to := ids[edge.From], ids[edge.To]
+		if from == "" || to == "" {
+			continue
+		}
+		if edge.Label != "" {
+			fmt.Fprintf(&builder, "  %s -->|%s| %s\n", from, mermaidEscape(edge.Label), to)
+		} else {
+			fmt.Fprintf(&builder, "  %s --> %s\n", from, to)
+		}
+	}
+	for _, class := range classOrder {
+		fmt.Fprintf(&builder, "  classDef %s fill:%s,color:#fff\n", class, classes[class])
+	}
+	for i, node := range graph.Nodes {
+		fmt.Fprintf(&builder, "  class n%d status%s\n", i, strings.TrimPrefix(node.Color, "#"))
+	}
+	return builder.String()
+}
+
+func mermaidEscape(s string) string {
+	return strings.NewReplacer(`"`, "#quot;", "|", "#124;", "<", "#lt;", ">", "#gt;", "\n", " ").Replace(s)
+}
+
+// WriteDOT writes the graph in the Graphviz DOT language.
+func (graph *Graph) WriteDOT(writer io.Writer) error {
+	return writeString(writer, graph.DOT())
+}
+
+// WriteMermaid writes the graph as a Mermaid flowchart.
+func (graph *Graph) WriteMermaid(writer io.Writer) error {
+	return writeString(writer, graph.Mermaid())
+}
+
+// WriteJSON writes the graph as an indented JSON document with "nodes" and "edges" arrays.
+func (graph *Graph) WriteJSON(writer io.Writer) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	err := encoder.Encode(graph)
+	if err != nil {
+		return core.SDKErrorf(err, "", "topology-encode-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+func writeString(writer io.Writer, s string) error {
+	_, err := io.WriteString(writer, s)
+	if err != nil {
+		return core.SDKErrorf(err, "", "topology-write-error", common.GetComponentInfo())
+	}
+	return nil
+}
@@ -0,0 +1,469 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package topology builds a graph of the resources of a VMware account: resource groups, Cloud Director sites, their
+// provider virtual data centers (PVDCs) and clusters, the virtual data centers (VDCs) with their edges and IBM
+// Transit Gateways, and the VCDA connections of the sites, including cloud-to-cloud peers.
+//
+// The graph is built from a vmwarev1.Inventory, collected live or read from a JSON file, and rendered to Graphviz
+// DOT, Mermaid or a JSON node/edge document.
+package topology
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vmware-go-sdk/common"
+	"github.com/IBM/vmware-go-sdk/vmwarev1"
+)
+
+// Constants associated with the Node.Kind property.
+// The type of resource that a node represents.
+const (
+	Node_Kind_ResourceGroup  = "resource_group"
+	Node_Kind_DirectorSite   = "director_site"
+	Node_Kind_Pvdc           = "pvdc"
+	Node_Kind_Cluster        = "cluster"
+	Node_Kind_Vdc            = "vdc"
+	Node_Kind_Edge           = "edge"
+	Node_Kind_TransitGateway = "transit_gateway"
+	Node_Kind_VcdaConnection = "vcda_connection"
+	Node_Kind_C2cConnection  = "c2c_connection"
+	Node_Kind_C2cPeer        = "c2c_peer"
+)
+
+// Node : A resource of the topology.
+type Node struct {
+	// A unique ID for the node, made of its kind and the ID of the resource.
+	ID string `json:"id"`
+
+	// The type of resource.
+	Kind string `json:"kind"`
+
+	// A human-readable name for the resource.
+	Label string `json:"label"`
+
+	// The status of the resource, as reported by the API. Empty for resources without a status.
+	Status string `json:"status,omitempty"`
+
+	// The color that represents the status.
+	Color string `json:"color"`
+
+	// Additional properties of the resource, such as its data center or size.
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// Edge : A relation between two nodes, from the parent to the child.
+type Edge struct {
+	// The ID of the parent node.
+	From string `json:"from"`
+
+	// The ID of the child node.
+	To string `json:"to"`
+
+	// An optional description of the relation.
+	Label string `json:"label,omitempty"`
+}
+
+// Graph : The nodes of a topology and their relations. Nodes and edges are kept in the order in which they were
+// added, so that renderings are stable.
+type Graph struct {
+	Nodes []Node `json:"nodes"`
+
+	Edges []Edge `json:"edges"`
+
+	nodeIndex map[string]int
+	edgeIndex map[Edge]bool
+}
+
+// Filter : Restricts a topology to part of the account.
+type Filter struct {
+	// Keep only the Cloud Director site with this ID and its resources.
+	DirectorSiteID string
+
+	// Keep only the virtual data center with this ID, its edges and Transit Gateways, and the resources that lead
+	// to it.
+	VdcID string
+}
+
+// NewGraph returns an empty graph.
+func NewGraph() *Graph {
+	return &Graph{
+		Nodes: []Node{},
+		Edges: []Edge{},
+	}
+}
+
+// GetNode returns the node identified by "id", or nil.
+func (graph *Graph) GetNode(id string) *Node {
+	graph.index()
+	if i, ok := graph.nodeIndex[id]; ok {
+		return &graph.Nodes[i]
+	}
+	return nil
+}
+
+// AddNode adds "node" to the graph, unless a node with the same ID already exists. The color of the node is set from
+// its status when it is empty.
+func (graph *Graph) AddNode(node Node) {
+	graph.index()
+	if _, ok := graph.nodeIndex[node.ID]; ok {
+		return
+	}
+	if node.Color == "" {
+		node.Color = StatusColor(node.Status)
+	}
+	graph.nodeIndex[node.ID] = len(graph.Nodes)
+	graph.Nodes = append(graph.Nodes, node)
+}
+
+// AddEdge adds a relation from the node "from" to the node "to", unless it already exists.
+func (graph *Graph) AddEdge(from string, to string, label string) {
+	graph.index()
+	edge := Edge{From: from, To: to, Label: label}
+	if graph.edgeIndex[edge] {
+		return
+	}
+	graph.edgeIndex[edge] = true
+	graph.Edges = append(graph.Edges, edge)
+}
+
+// index builds the lookup tables of a graph that was created literally or decoded from JSON.
+func (graph *Graph) index() {
+	if graph.nodeIndex != nil {
+		return
+	}
+	graph.nodeIndex = map[string]int{}
+	graph.edgeIndex = map[Edge]bool{}
+	for i, node := range graph.Nodes {
+		graph.nodeIndex[node.ID] = i
+	}
+	for _, edge := range graph.Edges {
+		graph.edgeIndex[edge] = true
+	}
+}
+
+// Constants returned by StatusColor.
+const (
+	Color_Ready   = "#2e7d32"
+	Color_Pending = "#f9a825"
+	Color_Failed  = "#c62828"
+	Color_Deleted = "#757575"
+	Color_Unknown = "#90a4ae"
+)
+
+// StatusColor returns the color that represents a resource status: green when the resource is ready, yellow while it
+// changes, red when it failed or is detached, grey when it is deleted, and a light grey otherwise.
+func StatusColor(status string) string {
+	switch status {
+	case "ready_to_use", "active":
+		return Color_Ready
+	case "creating", "updating", "modifying", "deleting", "pending":
+		return Color_Pending
+	case "failed", "detached", "error":
+		return Color_Failed
+	case "deleted":
+		return Color_Deleted
+	default:
+		return Color_Unknown
+	}
+}
+
+// Collect collects the Cloud Director sites and virtual data centers of the account with "service" and builds their
+// topology. The VCDA cloud-to-cloud connections cannot be listed with the API, so they only appear in graphs built
+// from an inventory to which they were added.
+func Collect(ctx context.Context, service *vmwarev1.VmwareV1, filter *Filter) (*Graph, error) {
+	if service == nil {
+		return nil, core.SDKErrorf(nil, "a service client is required to collect the topology", "missing-service", common.GetComponentInfo())
+	}
+	inventory, err := service.CollectInventoryWithContext(ctx, service.NewCollectInventoryOptions().SetSkipCatalog(true))
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "collect-inventory-error")
+	}
+	return Build(inventory, filter), nil
+}
+
+// Load reads the inventory in the file at "path" and builds its topology.
+func Load(path string, filter *Filter) (*Graph, error) {
+	inventory, err := vmwarev1.ReadInventoryFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Build(inventory, filter), nil
+}
+
+// Build builds the topology of "inventory", restricted by "filter" when it is not nil.
+func Build(inventory *vmwarev1.Inventory, filter *Filter) *Graph {
+	graph := NewGraph()
+	if inventory == nil {
+		return graph
+	}
+	if filter == nil {
+		filter = &Filter{}
+	}
+
+	// A VDC filter implies the site of the VDC.
+	siteID := filter.DirectorSiteID
+	var onlyVdc *vmwarev1.VDC
+	if filter.VdcID != "" {
+		onlyVdc = inventory.GetVdc(filter.VdcID)
+		if onlyVdc == nil {
+			return graph
+		}
+		if onlyVdc.DirectorSite != nil && onlyVdc.DirectorSite.ID != nil {
+			if siteID != "" && siteID != *onlyVdc.DirectorSite.ID {
+				return graph
+			}
+			siteID = *onlyVdc.DirectorSite.ID
+		}
+	}
+
+	for _, directorSite := range inventory.DirectorSites {
+		if siteID != "" && value(directorSite.ID) != siteID {
+			continue
+		}
+		siteNode := addDirectorSite(graph, directorSite)
+
+		for _, pvdc := range directorSite.Pvdcs {
+			if onlyVdc != nil && vdcPvdcID(*onlyVdc) != value(pvdc.ID) {
+				continue
+			}
+			pvdcNode := nodeID(Node_Kind_Pvdc, value(pvdc.ID))
+			graph.AddNode(Node{
+				ID:         pvdcNode,
+				Kind:       Node_Kind_Pvdc,
+				Label:      labelOf(pvdc.Name, pvdc.ID),
+				Status:     value(pvdc.Status),
+				Attributes: attributes("data_center", value(pvdc.DataCenterName)),
+			})
+			graph.AddEdge(siteNode, pvdcNode, "")
+			if onlyVdc != nil {
+				continue
+			}
+			for _, cluster := range pvdc.Clusters {
+				clusterNode := nodeID(Node_Kind_Cluster, value(cluster.ID))
+				graph.AddNode(Node{
+					ID:     clusterNode,
+					Kind:   Node_Kind_Cluster,
+					Label:  labelOf(cluster.Name, cluster.ID),
+					Status: value(cluster.Status),
+					Attributes: attributes(
+						"data_center", value(cluster.DataCenterName),
+						"host_count", intValue(cluster.HostCount),
+						"host_profile", value(cluster.HostProfile),
+					),
+				})
+				graph.AddEdge(pvdcNode, clusterNode, "")
+			}
+		}
+
+		if onlyVdc == nil {
+			addVcdaConnections(graph, siteNode, directorSite, inventory)
+		}
+	}
+
+	for _, vdc := range inventory.Vdcs {
+		if onlyVdc != nil && value(vdc.ID) != filter.VdcID {
+			continue
+		}
+		vdcSiteID := ""
+		if vdc.DirectorSite != nil {
+			vdcSiteID = value(vdc.DirectorSite.ID)
+		}
+		if siteID != "" && vdcSiteID != siteID {
+			continue
+		}
+		addVdc(graph, vdc, vdcSiteID)
+	}
+	return graph
+}
+
+func addDirectorSite(graph *Graph, directorSite vmwarev1.DirectorSite) string {
+	siteNode := nodeID(Node_Kind_DirectorSite, value(directorSite.ID))
+	graph.AddNode(Node{
+		ID:         siteNode,
+		Kind:       Node_Kind_DirectorSite,
+		Label:      labelOf(directorSite.Name, directorSite.ID),
+		Status:     value(directorSite.Status),
+		Attributes: attributes("type", value(directorSite.Type)),
+	})
+	if directorSite.ResourceGroup != nil {
+		resourceGroupNode := nodeID(Node_Kind_ResourceGroup, value(directorSite.ResourceGroup.ID))
+		graph.AddNode(Node{
+			ID:    resourceGroupNode,
+			Kind:  Node_Kind_ResourceGroup,
+			Label: labelOf(directorSite.ResourceGroup.Name, directorSite.ResourceGroup.ID),
+		})
+		graph.AddEdge(resourceGroupNode, siteNode, "")
+	}
+	return siteNode
+}
+
+func addVcdaConnections(graph *Graph, siteNode string, directorSite vmwarev1.DirectorSite, inventory *vmwarev1.Inventory) {
+	for _, service := range directorSite.Services {
+		if value(service.Name) != vmwarev1.Service_Name_Vcda {
+			continue
+		}
+		for _, connection := range service.Connections {
+			connectionNode := nodeID(Node_Kind_VcdaConnection, value(connection.ID))
+			graph.AddNode(Node{
+				ID:     connectionNode,
+				Kind:   Node_Kind_VcdaConnection,
+				Label:  fmt.Sprintf("%s VCDA connection (%s)", value(connection.Type), value(connection.DataCenterName)),
+				Status: value(connection.Status),
+				Attributes: attributes(
+					"data_center", value(connection.DataCenterName),
+					"speed", value(connection.Speed),
+				),
+			})
+			graph.AddEdge(siteNode, connectionNode, "")
+		}
+	}
+
+	for _, c2c := range inventory.C2cConnections {
+		if c2c.DirectorSiteID != value(directorSite.ID) {
+			continue
+		}
+		connection := c2c.Connection
+		connectionNode := nodeID(Node_Kind_C2cConnection, value(connection.ID))
+		graph.AddNode(Node{
+			ID:     connectionNode,
+			Kind:   Node_Kind_C2cConnection,
+			Label:  fmt.Sprintf("C2C %s", value(connection.LocalSiteName)),
+			Status: value(connection.Status),
+			Attributes: attributes(
+				"data_center", value(connection.LocalDataCenterName),
+				"note", value(connection.Note),
+			),
+		})
+		graph.AddEdge(siteNode, connectionNode, "")
+
+		peerNode := nodeID(Node_Kind_C2cPeer, value(connection.PeerRegion)+"/"+value(connection.PeerSiteName))
+		graph.AddNode(Node{
+			ID:    peerNode,
+			Kind:  Node_Kind_C2cPeer,
+			Label: fmt.Sprintf("%s (%s)", value(connection.PeerSiteName), value(connection.PeerRegion)),
+			Attributes: attributes(
+				"offering", value(connection.PeerOffering),
+				"region", value(connection.PeerRegion),
+			),
+		})
+		graph.AddEdge(connectionNode, peerNode, "peer")
+	}
+}
+
+func addVdc(graph *Graph, vdc vmwarev1.VDC, siteID string) {
+	vdcNode := nodeID(Node_Kind_Vdc, value(vdc.ID))
+	graph.AddNode(Node{
+		ID:     vdcNode,
+		Kind:   Node_Kind_Vdc,
+		Label:  labelOf(vdc.Name, vdc.ID),
+		Status: value(vdc.Status),
+		Attributes: attributes(
+			"type", value(vdc.Type),
+			"ha", value(vdc.Ha),
+			"cpu", intValue(vdc.Cpu),
+			"ram", intValue(vdc.Ram),
+		),
+	})
+
+	// Single-tenant VDCs hang from the PVDC they were created in; the others from their site, when it is known.
+	parent := nodeID(Node_Kind_Pvdc, vdcPvdcID(vdc))
+	if graph.GetNode(parent) == nil {
+		parent = nodeID(Node_Kind_DirectorSite, siteID)
+	}
+	if graph.GetNode(parent) != nil {
+		graph.AddEdge(parent, vdcNode, "")
+	}
+
+	for _, edge := range vdc.Edges {
+		edgeNode := nodeID(Node_Kind_Edge, value(edge.ID))
+		graph.AddNode(Node{
+			ID:     edgeNode,
+			Kind:   Node_Kind_Edge,
+			Label:  fmt.Sprintf("%s edge %s", value(edge.Type), value(edge.ID)),
+			Status: value(edge.Status),
+			Attributes: attributes(
+				"size", value(edge.Size),
+				"version", value(edge.Version),
+				"primary_data_center", value(edge.PrimaryDataCenterName),
+				"secondary_data_center", value(edge.SecondaryDataCenterName),
+			),
+		})
+		graph.AddEdge(vdcNode, edgeNode, "")
+
+		for _, transitGateway := range edge.TransitGateways {
+			transitGatewayNode := nodeID(Node_Kind_TransitGateway, value(transitGateway.ID))
+			graph.AddNode(Node{
+				ID:         transitGatewayNode,
+				Kind:       Node_Kind_TransitGateway,
+				Label:      "Transit Gateway " + value(transitGateway.ID),
+				Status:     value(transitGateway.Status),
+				Attributes: attributes("region", value(transitGateway.Region)),
+			})
+			graph.AddEdge(edgeNode, transitGatewayNode, fmt.Sprintf("%d connections", len(transitGateway.Connections)))
+		}
+	}
+}
+
+func vdcPvdcID(vdc vmwarev1.VDC) string {
+	if vdc.DirectorSite != nil && vdc.DirectorSite.Pvdc != nil {
+		return value(vdc.DirectorSite.Pvdc.ID)
+	}
+	return ""
+}
+
+func nodeID(kind string, id string) string {
+	return kind + ":" + id
+}
+
+func labelOf(name *string, id *string) string {
+	if name != nil && *name != "" {
+		return *name
+	}
+	return value(id)
+}
+
+// attributes builds a map from name/value pairs, leaving out empty values. It returns nil when all the values are
+// empty.
+func attributes(pairs ...string) map[string]string {
+	var result map[string]string
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if pairs[i+1] == "" {
+			continue
+		}
+		if result == nil {
+			result = map[string]string{}
+		}
+		result[pairs[i]] = pairs[i+1]
+	}
+	return result
+}
+
+func value(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func intValue(i *int64) string {
+	if i == nil {
+		return ""
+	}
+	return fmt.Sprint(*i)
+}
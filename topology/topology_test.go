@@ -0,0 +1,186 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package topology_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vmware-go-sdk/topology"
+	"github.com/IBM/vmware-go-sdk/vmwarev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const directorSitesJSON = `{"director_sites": [
+	{"id": "site1", "name": "Site \"one\"", "status": "ready_to_use", "type": "single_tenant",
+	 "resource_group": {"id": "rg1", "name": "Default", "crn": "Crn"},
+	 "pvdcs": [{"id": "pvdc1", "name": "pvdc-dal10", "data_center_name": "dal10", "status": "ready_to_use",
+	            "clusters": [{"id": "cluster1", "name": "cl1", "host_count": 3, "host_profile": "BM_2S_20_CORES_192_GB", "data_center_name": "dal10", "status": "updating"}]}],
+	 "services": [{"name": "vcda", "id": "vcda_id", "status": "ready_to_use",
+	               "connections": [{"id": "conn1", "status": "ready_to_use", "type": "private", "speed": "1g", "data_center_name": "dal10", "allow_list": []}]}]},
+	{"id": "site2", "name": "Site two", "status": "failed", "type": "single_tenant",
+	 "resource_group": {"id": "rg1", "name": "Default", "crn": "Crn"}, "pvdcs": [], "services": []}]}`
+
+const vdcsJSON = `{"vdcs": [
+	{"id": "vdc1", "name": "vdc-one", "status": "ready_to_use", "type": "single_tenant",
+	 "director_site": {"id": "site1", "pvdc": {"id": "pvdc1"}, "url": "URL"},
+	 "edges": [{"id": "edge1", "size": "medium", "status": "ready_to_use", "type": "performance", "version": "4.1",
+	            "transit_gateways": [{"id": "tgw1", "region": "us-south", "status": "pending", "connections": [{"name": "c", "status": "detached"}]}]}]},
+	{"id": "vdc2", "name": "vdc-two", "status": "creating", "type": "single_tenant",
+	 "director_site": {"id": "site2", "pvdc": {"id": "pvdc9"}, "url": "URL"}, "edges": []}]}`
+
+func testInventory() *vmwarev1.Inventory {
+	inventory := &vmwarev1.Inventory{}
+	sites := &vmwarev1.DirectorSiteCollection{}
+	Expect(json.Unmarshal([]byte(directorSitesJSON), sites)).To(Succeed())
+	vdcs := &vmwarev1.VDCCollection{}
+	Expect(json.Unmarshal([]byte(vdcsJSON), vdcs)).To(Succeed())
+	inventory.DirectorSites = sites.DirectorSites
+	inventory.Vdcs = vdcs.Vdcs
+	inventory.AddC2cConnection("site1", vmwarev1.VcdaC2c{
+		ID:            core.StringPtr("c2c1"),
+		Status:        core.StringPtr("ready_to_use"),
+		LocalSiteName: core.StringPtr("local"),
+		PeerSiteName:  core.StringPtr("peer-site"),
+		PeerRegion:    core.StringPtr("eu-de"),
+		PeerOffering:  core.StringPtr("dedicated"),
+	})
+	return inventory
+}
+
+func nodeIDs(graph *topology.Graph) []string {
+	ids := []string{}
+	for _, node := range graph.Nodes {
+		ids = append(ids, node.ID)
+	}
+	return ids
+}
+
+var _ = Describe(`Topology`, func() {
+	It(`Builds the graph of an inventory`, func() {
+		graph := topology.Build(testInventory(), nil)
+		Expect(nodeIDs(graph)).To(Equal([]string{
+			"director_site:site1", "resource_group:rg1", "pvdc:pvdc1", "cluster:cluster1",
+			"vcda_connection:conn1", "c2c_connection:c2c1", "c2c_peer:eu-de/peer-site",
+			"director_site:site2",
+			"vdc:vdc1", "edge:edge1", "transit_gateway:tgw1",
+			"vdc:vdc2",
+		}))
+		Expect(graph.Edges).To(ContainElement(topology.Edge{From: "resource_group:rg1", To: "director_site:site2"}))
+		Expect(graph.Edges).To(ContainElement(topology.Edge{From: "pvdc:pvdc1", To: "vdc:vdc1"}))
+		Expect(graph.Edges).To(ContainElement(topology.Edge{From: "director_site:site2", To: "vdc:vdc2"}))
+		Expect(graph.Edges).To(ContainElement(topology.Edge{From: "edge:edge1", To: "transit_gateway:tgw1", Label: "1 connections"}))
+		Expect(graph.Edges).To(ContainElement(topology.Edge{From: "c2c_connection:c2c1", To: "c2c_peer:eu-de/peer-site", Label: "peer"}))
+
+		Expect(graph.GetNode("cluster:cluster1").Attributes).To(Equal(map[string]string{
+			"data_center": "dal10", "host_count": "3", "host_profile": "BM_2S_20_CORES_192_GB",
+		}))
+		Expect(graph.GetNode("director_site:site1").Color).To(Equal(topology.Color_Ready))
+		Expect(graph.GetNode("cluster:cluster1").Color).To(Equal(topology.Color_Pending))
+		Expect(graph.GetNode("director_site:site2").Color).To(Equal(topology.Color_Failed))
+		Expect(graph.GetNode("resource_group:rg1").Color).To(Equal(topology.Color_Unknown))
+	})
+	It(`Filters the graph by site or VDC`, func() {
+		graph := topology.Build(testInventory(), &topology.Filter{DirectorSiteID: "site2"})
+		Expect(nodeIDs(graph)).To(Equal([]string{"director_site:site2", "resource_group:rg1", "vdc:vdc2"}))
+
+		graph = topology.Build(testInventory(), &topology.Filter{VdcID: "vdc1"})
+		Expect(nodeIDs(graph)).To(Equal([]string{
+			"director_site:site1", "resource_group:rg1", "pvdc:pvdc1", "vdc:vdc1", "edge:edge1", "transit_gateway:tgw1",
+		}))
+
+		Expect(topology.Build(testInventory(), &topology.Filter{VdcID: "missing"}).Nodes).To(BeEmpty())
+		Expect(topology.Build(testInventory(), &topology.Filter{DirectorSiteID: "site2", VdcID: "vdc1"}).Nodes).To(BeEmpty())
+	})
+	It(`Renders the graph to DOT, Mermaid and JSON`, func() {
+		graph := topology.Build(testInventory(), &topology.Filter{DirectorSiteID: "site1"})
+
+		dot := graph.DOT()
+		Expect(dot).To(HavePrefix("digraph topology {\n"))
+		Expect(dot).To(ContainSubstring(`"director_site:site1" [label="Director site\nSite \"one\"\n(ready_to_use)", shape=box3d, fillcolor="#2e7d32"];`))
+		Expect(dot).To(ContainSubstring(`"edge:edge1" -> "transit_gateway:tgw1" [label="1 connections"];`))
+		Expect(dot).To(ContainSubstring(`"resource_group:rg1" -> "director_site:site1";`))
+		Expect(dot).To(HaveSuffix("}\n"))
+
+		mermaid := graph.Mermaid()
+		Expect(mermaid).To(HavePrefix("flowchart LR\n"))
+		Expect(mermaid).To(ContainSubstring(`n0["Director site<br/>Site #quot;one#quot;<br/>(ready_to_use)"]`))
+		Expect(mermaid).To(ContainSubstring("n1 --> n0\n"))
+		Expect(mermaid).To(ContainSubstring("classDef status2e7d32 fill:#2e7d32,color:#fff\n"))
+		Expect(mermaid).To(ContainSubstring("class n0 status2e7d32\n"))
+
+		var buffer bytes.Buffer
+		Expect(graph.WriteJSON(&buffer)).To(Succeed())
+		decoded := &topology.Graph{}
+		Expect(json.Unmarshal(buffer.Bytes(), decoded)).To(Succeed())
+		Expect(decoded.Nodes).To(Equal(graph.Nodes))
+		Expect(decoded.Edges).To(Equal(graph.Edges))
+		Expect(decoded.GetNode("vdc:vdc1")).ToNot(BeNil())
+	})
+	It(`Builds the graph from an inventory file`, func() {
+		dir, err := os.MkdirTemp("", "topology")
+		Expect(err).To(BeNil())
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "inventory.json")
+		Expect(testInventory().WriteFile(path)).To(Succeed())
+
+		graph, err := topology.Load(path, &topology.Filter{VdcID: "vdc2"})
+		Expect(err).To(BeNil())
+		Expect(nodeIDs(graph)).To(Equal([]string{"director_site:site2", "resource_group:rg1", "vdc:vdc2"}))
+
+		_, err = topology.Load(filepath.Join(dir, "missing.json"), nil)
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Collects the graph with live calls`, func() {
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			switch req.URL.EscapedPath() {
+			case "/director_sites":
+				fmt.Fprint(res, directorSitesJSON)
+			case "/vdcs":
+				fmt.Fprint(res, vdcsJSON)
+			default:
+				Fail("unexpected request " + req.URL.EscapedPath())
+			}
+		}))
+		defer testServer.Close()
+		vmwareService, serviceErr := vmwarev1.NewVmwareV1(&vmwarev1.VmwareV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+
+		graph, err := topology.Collect(context.Background(), vmwareService, nil)
+		Expect(err).To(BeNil())
+		Expect(strings.Join(nodeIDs(graph), ",")).To(ContainSubstring("vdc:vdc1,edge:edge1,transit_gateway:tgw1"))
+
+		_, err = topology.Collect(context.Background(), nil, nil)
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,301 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package vmwarev1
+
+// The audit trail records every request of the service that changes resources: the operation, the sanitized request
+// body, the IDs of the target resources, the transaction ID, the identity of the caller, the status of the response
+// and the duration of the call. It is installed with EnableAudit as a Middleware, so every attempt of a retried call is
+// recorded.
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vmware-go-sdk/common"
+)
+
+// AuditEntry : A record of a request that changes resources.
+type AuditEntry struct {
+	// When the request was sent.
+	Time time.Time `json:"time"`
+
+	// The name of the operation, such as "CreateVdc".
+	Operation string `json:"operation"`
+
+	// The HTTP method of the request.
+	Method string `json:"method"`
+
+	// The URL of the request, without its query.
+	URL string `json:"url"`
+
+	// The IDs of the target resources, by path parameter, such as "site_id".
+	ResourceIDs map[string]string `json:"resource_ids,omitempty"`
+
+	// The body of the request, with its secrets redacted. Bodies that are not JSON are replaced by a string.
+	RequestBody json.RawMessage `json:"request_body,omitempty"`
+
+	// The X-Global-Transaction-ID of the request, or of the response when the request did not set one.
+	TransactionID string `json:"transaction_id,omitempty"`
+
+	// The identity of the caller, as found in the credentials of the request.
+	Caller *AuditCaller `json:"caller,omitempty"`
+
+	// The status code of the response, or 0 when no response was received.
+	StatusCode int `json:"status_code"`
+
+	// The error of the request, when no response was received.
+	Error string `json:"error,omitempty"`
+
+	// The duration of the request, in milliseconds.
+	DurationMs int64 `json:"duration_ms"`
+}
+
+// AuditCaller : The identity of the caller of a request.
+type AuditCaller struct {
+	// The IAM ID of the caller, from an IAM access token.
+	IamID string `json:"iam_id,omitempty"`
+
+	// The subject of the access token, such as the email of a user or the name of a service ID, or the user name of
+	// basic authentication.
+	Subject string `json:"subject,omitempty"`
+
+	// The account of the access token.
+	AccountID string `json:"account_id,omitempty"`
+}
+
+// AuditSink : A destination of audit entries. Sinks must be safe for concurrent use.
+type AuditSink interface {
+	WriteAuditEntry(entry *AuditEntry) error
+}
+
+// AuditSinkFunc : An AuditSink implemented by a function.
+type AuditSinkFunc func(entry *AuditEntry) error
+
+// WriteAuditEntry calls the function.
+func (f AuditSinkFunc) WriteAuditEntry(entry *AuditEntry) error {
+	return f(entry)
+}
+
+// AuditWriterSink : Writes audit entries to a writer, one JSON object per line.
+type AuditWriterSink struct {
+	mutex  sync.Mutex
+	writer io.Writer
+}
+
+// NewAuditWriterSink : Instantiate AuditWriterSink
+func NewAuditWriterSink(writer io.Writer) *AuditWriterSink {
+	return &AuditWriterSink{writer: writer}
+}
+
+// WriteAuditEntry writes "entry" as a line of JSON.
+func (sink *AuditWriterSink) WriteAuditEntry(entry *AuditEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return core.SDKErrorf(err, "", "audit-encode-error", common.GetComponentInfo())
+	}
+	sink.mutex.Lock()
+	defer sink.mutex.Unlock()
+	_, err = sink.writer.Write(append(line, '\n'))
+	if err != nil {
+		return core.SDKErrorf(err, "", "audit-write-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// AuditFileSink : Appends audit entries to a file, one JSON object per line. The file is opened in append-only mode
+// and created with 0600 permissions.
+type AuditFileSink struct {
+	*AuditWriterSink
+	file *os.File
+}
+
+// NewAuditFileSink opens the file at "path" for appending audit entries.
+func NewAuditFileSink(path string) (*AuditFileSink, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "audit-open-error", common.GetComponentInfo())
+	}
+	return &AuditFileSink{AuditWriterSink: NewAuditWriterSink(file), file: file}, nil
+}
+
+// Close closes the file.
+func (sink *AuditFileSink) Close() error {
+	return sink.file.Close()
+}
+
+// AuditOptions : The options of the audit trail.
+type AuditOptions struct {
+	// The sinks to which every entry is written.
+	Sinks []AuditSink
+
+	// Called with the errors of the sinks. If nil, they are logged with the logger of the SDK. A failing sink does not
+	// fail the request.
+	OnError func(err error)
+}
+
+// EnableAudit installs the audit trail on the service.
+func (vmware *VmwareV1) EnableAudit(options *AuditOptions) {
+	vmware.Use(AuditMiddleware(options))
+}
+
+// AuditMiddleware returns the Middleware that records the requests that change resources.
+func AuditMiddleware(options *AuditOptions) Middleware {
+	if options == nil {
+		options = &AuditOptions{}
+	}
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			operation, ok := OperationForRequest(req)
+			if !ok {
+				if req.Method == http.MethodGet || req.Method == http.MethodHead {
+					return next.RoundTrip(req)
+				}
+				operation = RequestOperation{Name: "Unknown", Method: req.Method, Mutating: true}
+			}
+			if !operation.Mutating {
+				return next.RoundTrip(req)
+			}
+
+			entry := &AuditEntry{
+				Operation:     operation.Name,
+				Method:        req.Method,
+				URL:           auditURL(req),
+				ResourceIDs:   operation.PathParams,
+				TransactionID: headerValue(req.Header, "X-Global-Transaction-ID"),
+				Caller:        auditCaller(req),
+			}
+			if len(entry.ResourceIDs) == 0 {
+				entry.ResourceIDs = nil
+			}
+			var err error
+			entry.RequestBody, err = auditRequestBody(req)
+			if err != nil {
+				return nil, err
+			}
+
+			start := time.Now()
+			entry.Time = start.UTC()
+			res, err := next.RoundTrip(req)
+			entry.DurationMs = time.Since(start).Milliseconds()
+			if err != nil {
+				entry.Error = err.Error()
+			} else {
+				entry.StatusCode = res.StatusCode
+				if entry.TransactionID == "" {
+					entry.TransactionID = headerValue(res.Header, "X-Global-Transaction-ID")
+				}
+			}
+
+			for _, sink := range options.Sinks {
+				sinkErr := sink.WriteAuditEntry(entry)
+				if sinkErr == nil {
+					continue
+				}
+				if options.OnError != nil {
+					options.OnError(sinkErr)
+				} else {
+					core.GetLogger().Error("failed to write audit entry: %s", sinkErr.Error())
+				}
+			}
+			return res, err
+		})
+	}
+}
+
+// headerValue returns the value of the header "name". The request builder of the core does not canonicalize the
+// header names, so they are matched without regard to case.
+func headerValue(header http.Header, name string) string {
+	if value := header.Get(name); value != "" {
+		return value
+	}
+	for key, values := range header {
+		if strings.EqualFold(key, name) && len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
+
+// deleteHeader removes the header "name", matched without regard to case like headerValue.
+func deleteHeader(header http.Header, name string) {
+	for key := range header {
+		if strings.EqualFold(key, name) {
+			delete(header, key)
+		}
+	}
+}
+
+func auditURL(req *http.Request) string {
+	url := *req.URL
+	url.RawQuery = ""
+	url.User = nil
+	return url.String()
+}
+
+// auditRequestBody returns the sanitized body of "req", leaving the body of the request readable.
+func auditRequestBody(req *http.Request) (json.RawMessage, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "audit-read-body-error")
+	}
+	if len(body) == 0 {
+		return nil, nil
+	}
+
+	sanitized := core.RedactSecrets(redactSensitiveJSON(string(body)))
+	if json.Valid([]byte(sanitized)) {
+		return json.RawMessage(sanitized), nil
+	}
+	placeholder, _ := json.Marshal(fmt.Sprintf("[%d bytes of %s]", len(body), req.Header.Get("Content-Type")))
+	return placeholder, nil
+}
+
+// auditCaller returns the identity found in the Authorization header of "req". Access tokens are decoded without
+// being verified: the API verifies them.
+func auditCaller(req *http.Request) *AuditCaller {
+	authorization := req.Header.Get("Authorization")
+	if username, _, ok := req.BasicAuth(); ok {
+		return &AuditCaller{Subject: username}
+	}
+	token := strings.TrimSpace(strings.TrimPrefix(authorization, "Bearer "))
+	parts := strings.Split(token, ".")
+	if token == authorization || len(parts) != 3 {
+		return nil
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil
+	}
+	claims := struct {
+		IamID   string `json:"iam_id"`
+		Subject string `json:"sub"`
+		Account struct {
+			Bss string `json:"bss"`
+		} `json:"account"`
+	}{}
+	if json.Unmarshal(payload, &claims) != nil {
+		return nil
+	}
+	return &AuditCaller{IamID: claims.IamID, Subject: claims.Subject, AccountID: claims.Account.Bss}
+}
@@ -0,0 +1,175 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package vmwarev1_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vmware-go-sdk/vmwarev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Audit`, func() {
+	var (
+		testServer    *httptest.Server
+		vmwareService *vmwarev1.VmwareV1
+		receivedBody  string
+		output        bytes.Buffer
+		readEntries   func() []vmwarev1.AuditEntry
+	)
+
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			body, _ := io.ReadAll(req.Body)
+			receivedBody = string(body)
+			res.Header().Set("Content-type", "application/json")
+			res.Header().Set("X-Global-Transaction-ID", "server-txn")
+			switch {
+			case req.Method == http.MethodDelete:
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"errors": [{"code": "not_found", "message": "not found"}]}`)
+			case req.Method == http.MethodGet:
+				res.WriteHeader(200)
+				fmt.Fprint(res, `{"vdcs": []}`)
+			default:
+				res.WriteHeader(202)
+				fmt.Fprint(res, `{"id": "vdc1"}`)
+			}
+		}))
+
+		claims := base64.RawURLEncoding.EncodeToString([]byte(`{"iam_id": "IBMid-123", "sub": "ops@example.com", "account": {"bss": "acct1"}}`))
+		var serviceErr error
+		vmwareService, serviceErr = vmwarev1.NewVmwareV1(&vmwarev1.VmwareV1Options{
+			URL:           testServer.URL + "/v1",
+			Authenticator: &core.BearerTokenAuthenticator{BearerToken: "header." + claims + ".signature"},
+		})
+		Expect(serviceErr).To(BeNil())
+
+		output.Reset()
+		vmwareService.EnableAudit(&vmwarev1.AuditOptions{
+			Sinks: []vmwarev1.AuditSink{vmwarev1.NewAuditWriterSink(&output)},
+		})
+		readEntries = func() (entries []vmwarev1.AuditEntry) {
+			for _, line := range strings.Split(strings.TrimSpace(output.String()), "\n") {
+				if line == "" {
+					continue
+				}
+				var entry vmwarev1.AuditEntry
+				Expect(json.Unmarshal([]byte(line), &entry)).To(Succeed())
+				entries = append(entries, entry)
+			}
+			return
+		}
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Records the calls that change resources`, func() {
+		createVdcOptions := vmwareService.NewCreateVdcOptions("vdc", &vmwarev1.VDCDirectorSitePrototype{
+			ID:   core.StringPtr("site1"),
+			Pvdc: &vmwarev1.DirectorSitePVDC{ID: core.StringPtr("pvdc1")},
+		})
+		createVdcOptions.SetHeaders(map[string]string{"X-Global-Transaction-ID": "client-txn"})
+		_, _, err := vmwareService.CreateVdc(createVdcOptions)
+		Expect(err).To(BeNil())
+
+		_, _, err = vmwareService.UpdateDirectorSitesPvdcsCluster(vmwareService.NewUpdateDirectorSitesPvdcsClusterOptions(
+			"site1", "cluster1", "pvdc1", map[string]interface{}{"host_count": 4, "rhel_vm_activation_key": "rhel-secret", "api_key": "key-secret"}))
+		Expect(err).To(BeNil())
+		// The request still carries the secrets.
+		Expect(receivedBody).To(ContainSubstring("rhel-secret"))
+
+		_, _, err = vmwareService.ListVdcs(vmwareService.NewListVdcsOptions())
+		Expect(err).To(BeNil())
+		_, _, err = vmwareService.DeleteDirectorSite(vmwareService.NewDeleteDirectorSiteOptions("site1"))
+		Expect(err).ToNot(BeNil())
+
+		Expect(output.String()).ToNot(ContainSubstring("secret"))
+		Expect(output.String()).ToNot(ContainSubstring("header."))
+		entries := readEntries()
+		Expect(entries).To(HaveLen(3))
+
+		Expect(entries[0].Operation).To(Equal("CreateVdc"))
+		Expect(entries[0].Method).To(Equal(http.MethodPost))
+		Expect(entries[0].URL).To(Equal(testServer.URL + "/v1/vdcs"))
+		Expect(entries[0].ResourceIDs).To(BeNil())
+		Expect(string(entries[0].RequestBody)).To(ContainSubstring(`"name":"vdc"`))
+		Expect(entries[0].TransactionID).To(Equal("client-txn"))
+		Expect(entries[0].Caller).To(Equal(&vmwarev1.AuditCaller{IamID: "IBMid-123", Subject: "ops@example.com", AccountID: "acct1"}))
+		Expect(entries[0].StatusCode).To(Equal(202))
+		Expect(entries[0].Time.IsZero()).To(BeFalse())
+
+		Expect(entries[1].Operation).To(Equal("UpdateDirectorSitesPvdcsCluster"))
+		Expect(entries[1].ResourceIDs).To(Equal(map[string]string{"site_id": "site1", "pvdc_id": "pvdc1", "id": "cluster1"}))
+		Expect(string(entries[1].RequestBody)).To(ContainSubstring(`"host_count":4`))
+		Expect(entries[1].TransactionID).To(Equal("server-txn"))
+
+		Expect(entries[2].Operation).To(Equal("DeleteDirectorSite"))
+		Expect(entries[2].StatusCode).To(Equal(404))
+		Expect(entries[2].RequestBody).To(BeNil())
+	})
+	It(`Appends to files and reports sink errors`, func() {
+		dir, err := os.MkdirTemp("", "audit")
+		Expect(err).To(BeNil())
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "audit.jsonl")
+
+		fileSink, err := vmwarev1.NewAuditFileSink(path)
+		Expect(err).To(BeNil())
+		var sinkErrors []error
+		vmwareService.EnableAudit(&vmwarev1.AuditOptions{
+			Sinks: []vmwarev1.AuditSink{
+				fileSink,
+				vmwarev1.AuditSinkFunc(func(entry *vmwarev1.AuditEntry) error { return errors.New("sink is down") }),
+			},
+			OnError: func(err error) { sinkErrors = append(sinkErrors, err) },
+		})
+
+		for i := 0; i < 2; i++ {
+			_, _, err = vmwareService.SwapHaEdgeSites(vmwareService.NewSwapHaEdgeSitesOptions("vdc1", "edge1"))
+			Expect(err).To(BeNil())
+		}
+		Expect(fileSink.Close()).To(Succeed())
+		Expect(sinkErrors).To(HaveLen(2))
+
+		info, err := os.Stat(path)
+		Expect(err).To(BeNil())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+		content, err := os.ReadFile(path)
+		Expect(err).To(BeNil())
+		lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+		Expect(lines).To(HaveLen(2))
+		Expect(lines[0]).To(ContainSubstring(`"operation":"SwapHaEdgeSites"`))
+		Expect(lines[0]).To(ContainSubstring(`"resource_ids":{"edge_id":"edge1","vdc_id":"vdc1"}`))
+
+		// The first audit trail records the calls too.
+		Expect(readEntries()).To(HaveLen(2))
+	})
+})
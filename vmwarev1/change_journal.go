@@ -0,0 +1,307 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vmwarev1
+
+// A ChangeJournal records the patches of VDCs and clusters so that they can be undone. Before an UpdateVdc or an
+// UpdateDirectorSitesPvdcsCluster request is sent, the resource is read, as GetVdc or GetDirectorInstancesPvdcsCluster
+// would, and once the patch is applied its pre-image is stored alongside the merge-patch that was sent.
+//
+// Rollback computes the inverse merge-patch of an entry, which restores the patched fields to their values in the
+// pre-image, and applies it. The rollback is journaled as well, but it cannot be rolled back itself.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vmware-go-sdk/common"
+)
+
+// ChangeJournalHeader is set on the responses of the journaled patches. Its value is the ID of the JournalEntry.
+const ChangeJournalHeader = "X-Vmware-Sdk-Journal-Entry"
+
+// journalRollbackHeader marks the requests sent by Rollback with the ID of the entry that they roll back. It is
+// removed before the request is sent.
+const journalRollbackHeader = "X-Vmware-Sdk-Journal-Rollback-Of"
+
+// JournalEntry : A patch applied to a VDC or a cluster.
+type JournalEntry struct {
+	// The ID of the entry, unique within a ChangeJournal.
+	ID string `json:"id"`
+
+	// When the patch was applied.
+	Time time.Time `json:"time"`
+
+	// The name of the operation: "UpdateVdc" or "UpdateDirectorSitesPvdcsCluster".
+	Operation string `json:"operation"`
+
+	// The IDs of the patched resource, by path parameter, such as "site_id".
+	ResourceIDs map[string]string `json:"resource_ids"`
+
+	// The resource as it was read before the patch.
+	PreImage map[string]interface{} `json:"pre_image"`
+
+	// The merge-patch that was applied.
+	Patch map[string]interface{} `json:"patch"`
+
+	// The ID of the entry that this entry rolls back, if it was applied by Rollback.
+	RollbackOf string `json:"rollback_of,omitempty"`
+
+	// The ID of the entry that rolled this entry back, if it was rolled back.
+	RolledBackBy string `json:"rolled_back_by,omitempty"`
+}
+
+// ChangeJournal : Records the patches of VDCs and clusters with the pre-image of the resource, and rolls them back.
+// Install it with VmwareV1.EnableChangeJournal.
+type ChangeJournal struct {
+	service *VmwareV1
+
+	mutex    sync.Mutex
+	entries  []*JournalEntry
+	sequence int
+}
+
+// NewChangeJournal : Instantiate ChangeJournal
+func NewChangeJournal() *ChangeJournal {
+	return &ChangeJournal{}
+}
+
+// EnableChangeJournal installs "journal" on the service. Its entries are rolled back with the service.
+func (vmware *VmwareV1) EnableChangeJournal(journal *ChangeJournal) {
+	journal.service = vmware
+	vmware.Use(journal.Middleware())
+}
+
+// Entries returns copies of the entries recorded so far, in order.
+func (journal *ChangeJournal) Entries() []JournalEntry {
+	journal.mutex.Lock()
+	defer journal.mutex.Unlock()
+	entries := make([]JournalEntry, 0, len(journal.entries))
+	for _, entry := range journal.entries {
+		entries = append(entries, copyJournalEntry(entry))
+	}
+	return entries
+}
+
+// Entry returns a copy of the entry with ID "id", or nil if there is none.
+func (journal *ChangeJournal) Entry(id string) *JournalEntry {
+	journal.mutex.Lock()
+	defer journal.mutex.Unlock()
+	entry := journal.find(id)
+	if entry == nil {
+		return nil
+	}
+	copied := copyJournalEntry(entry)
+	return &copied
+}
+
+// EntryOf returns the entry recorded for the call that returned "response", or nil if the call was not journaled.
+func (journal *ChangeJournal) EntryOf(response *core.DetailedResponse) *JournalEntry {
+	if response == nil {
+		return nil
+	}
+	id := headerValue(response.Headers, ChangeJournalHeader)
+	if id == "" {
+		return nil
+	}
+	return journal.Entry(id)
+}
+
+// Middleware returns the middleware that reads the pre-image of the patched VDCs and clusters and records the
+// patches that succeed.
+func (journal *ChangeJournal) Middleware() Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			rollbackOf := headerValue(req.Header, journalRollbackHeader)
+			if rollbackOf != "" {
+				req = req.Clone(req.Context())
+				deleteHeader(req.Header, journalRollbackHeader)
+			}
+			operation, ok := OperationForRequest(req)
+			if !ok || (operation.Name != "UpdateVdc" && operation.Name != "UpdateDirectorSitesPvdcsCluster") {
+				return next.RoundTrip(req)
+			}
+
+			body, err := readRequestBody(req)
+			if err != nil {
+				return nil, core.RepurposeSDKProblem(err, "journal-read-body-error")
+			}
+			var patch map[string]interface{}
+			if json.Unmarshal(body, &patch) != nil {
+				return next.RoundTrip(req)
+			}
+			preImage, err := fetchCurrentResource(next, req)
+			if err != nil {
+				return nil, err
+			}
+			if preImage == nil {
+				// The API reports that the resource does not exist.
+				return next.RoundTrip(req)
+			}
+
+			response, err := next.RoundTrip(req)
+			if err != nil || response.StatusCode >= 300 {
+				return response, err
+			}
+			entry := journal.record(operation, preImage, patch, rollbackOf)
+			response.Header.Set(ChangeJournalHeader, entry.ID)
+			return response, nil
+		})
+	}
+}
+
+// record appends the entry of a patch that was applied.
+func (journal *ChangeJournal) record(operation RequestOperation, preImage map[string]interface{}, patch map[string]interface{}, rollbackOf string) *JournalEntry {
+	journal.mutex.Lock()
+	defer journal.mutex.Unlock()
+	journal.sequence++
+	entry := &JournalEntry{
+		ID:          "journal-" + strconv.Itoa(journal.sequence),
+		Time:        time.Now().UTC(),
+		Operation:   operation.Name,
+		ResourceIDs: operation.PathParams,
+		PreImage:    preImage,
+		Patch:       patch,
+		RollbackOf:  rollbackOf,
+	}
+	if rolledBack := journal.find(rollbackOf); rolledBack != nil {
+		rolledBack.RolledBackBy = entry.ID
+	}
+	journal.entries = append(journal.entries, entry)
+	return entry
+}
+
+// Rollback applies the inverse merge-patch of the entry with ID "journalEntryID", and returns the entry of the
+// rollback. An entry cannot be rolled back twice, nor while a later patch of the same resource is still in effect:
+// the later patches must be rolled back first.
+func (journal *ChangeJournal) Rollback(ctx context.Context, journalEntryID string) (*JournalEntry, error) {
+	if journal.service == nil {
+		return nil, core.SDKErrorf(nil, "the change journal is not installed on a service", "journal-not-installed", common.GetComponentInfo())
+	}
+	entry, inversePatch, err := journal.prepareRollback(journalEntryID)
+	if err != nil {
+		return nil, err
+	}
+
+	headers := map[string]string{journalRollbackHeader: entry.ID}
+	var response *core.DetailedResponse
+	switch entry.Operation {
+	case "UpdateVdc":
+		options := journal.service.NewUpdateVdcOptions(entry.ResourceIDs["id"], inversePatch).SetHeaders(headers)
+		_, response, err = journal.service.UpdateVdcWithContext(ctx, options)
+	default:
+		options := journal.service.NewUpdateDirectorSitesPvdcsClusterOptions(entry.ResourceIDs["site_id"], entry.ResourceIDs["id"],
+			entry.ResourceIDs["pvdc_id"], inversePatch).SetHeaders(headers)
+		_, response, err = journal.service.UpdateDirectorSitesPvdcsClusterWithContext(ctx, options)
+	}
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "journal-rollback-error")
+	}
+	rollback := journal.EntryOf(response)
+	if rollback == nil {
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("the rollback of '%s' was not journaled", entry.ID), "journal-rollback-not-recorded", common.GetComponentInfo())
+	}
+	return rollback, nil
+}
+
+// prepareRollback checks that the entry with ID "id" can be rolled back and returns a copy of it with its inverse
+// merge-patch.
+func (journal *ChangeJournal) prepareRollback(id string) (*JournalEntry, map[string]interface{}, error) {
+	journal.mutex.Lock()
+	defer journal.mutex.Unlock()
+	entry := journal.find(id)
+	switch {
+	case entry == nil:
+		return nil, nil, core.SDKErrorf(nil, fmt.Sprintf("there is no journal entry '%s'", id), "journal-entry-not-found", common.GetComponentInfo())
+	case entry.RollbackOf != "":
+		return nil, nil, core.SDKErrorf(nil, fmt.Sprintf("journal entry '%s' is the rollback of '%s' and cannot be rolled back", id, entry.RollbackOf),
+			"journal-rollback-of-rollback", common.GetComponentInfo())
+	case entry.RolledBackBy != "":
+		return nil, nil, core.SDKErrorf(nil, fmt.Sprintf("journal entry '%s' was already rolled back by '%s'", id, entry.RolledBackBy),
+			"journal-already-rolled-back", common.GetComponentInfo())
+	}
+	later := false
+	for _, other := range journal.entries {
+		if other == entry {
+			later = true
+			continue
+		}
+		if later && other.RollbackOf == "" && other.RolledBackBy == "" && sameJournaledResource(entry, other) {
+			return nil, nil, core.SDKErrorf(nil, fmt.Sprintf("journal entry '%s' patches the same resource later and must be rolled back first", other.ID),
+				"journal-rollback-conflict", common.GetComponentInfo())
+		}
+	}
+	copied := copyJournalEntry(entry)
+	return &copied, inverseMergePatch(entry.PreImage, entry.Patch), nil
+}
+
+func (journal *ChangeJournal) find(id string) *JournalEntry {
+	for _, entry := range journal.entries {
+		if entry.ID == id {
+			return entry
+		}
+	}
+	return nil
+}
+
+func sameJournaledResource(entry *JournalEntry, other *JournalEntry) bool {
+	if entry.Operation != other.Operation || len(entry.ResourceIDs) != len(other.ResourceIDs) {
+		return false
+	}
+	for name, id := range entry.ResourceIDs {
+		if other.ResourceIDs[name] != id {
+			return false
+		}
+	}
+	return true
+}
+
+// inverseMergePatch returns the merge-patch that restores the fields of "patch" to their values in "preImage". Fields
+// that were absent from the pre-image are removed with null, and objects are restored field by field.
+func inverseMergePatch(preImage map[string]interface{}, patch map[string]interface{}) map[string]interface{} {
+	inverse := map[string]interface{}{}
+	for field, value := range patch {
+		previous, existed := preImage[field]
+		patchObject, isPatchObject := value.(map[string]interface{})
+		previousObject, isPreviousObject := previous.(map[string]interface{})
+		switch {
+		case !existed:
+			inverse[field] = nil
+		case isPatchObject && isPreviousObject:
+			inverse[field] = inverseMergePatch(previousObject, patchObject)
+		default:
+			inverse[field] = copyJSONValue(previous)
+		}
+	}
+	return inverse
+}
+
+func copyJournalEntry(entry *JournalEntry) JournalEntry {
+	copied := *entry
+	copied.ResourceIDs = map[string]string{}
+	for name, id := range entry.ResourceIDs {
+		copied.ResourceIDs[name] = id
+	}
+	copied.PreImage, _ = copyJSONValue(entry.PreImage).(map[string]interface{})
+	copied.Patch, _ = copyJSONValue(entry.Patch).(map[string]interface{})
+	return copied
+}
@@ -0,0 +1,199 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package vmwarev1_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vmware-go-sdk/vmwarev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ChangeJournal`, func() {
+	var (
+		testServer    *httptest.Server
+		vmwareService *vmwarev1.VmwareV1
+		journal       *vmwarev1.ChangeJournal
+		// The resources of the server, by path, and the merge-patches that it received.
+		resources map[string]map[string]interface{}
+		patches   []map[string]interface{}
+	)
+
+	var mergePatch func(target map[string]interface{}, patch map[string]interface{})
+	mergePatch = func(target map[string]interface{}, patch map[string]interface{}) {
+		for field, value := range patch {
+			switch value := value.(type) {
+			case nil:
+				delete(target, field)
+			case map[string]interface{}:
+				object, ok := target[field].(map[string]interface{})
+				if !ok {
+					object = map[string]interface{}{}
+					target[field] = object
+				}
+				mergePatch(object, value)
+			default:
+				target[field] = value
+			}
+		}
+	}
+
+	BeforeEach(func() {
+		resources = map[string]map[string]interface{}{
+			"/vdcs/vdc1": {"id": "vdc1", "name": "vdc1", "cpu": 16.0, "ram": 128.0, "fast_provisioning_enabled": false},
+			"/director_sites/site1/pvdcs/pvdc1/clusters/cluster1": {"id": "cluster1", "name": "cluster1", "host_count": 4.0,
+				"file_shares": map[string]interface{}{"STORAGE_TWO_IOPS_GB": 24000.0}},
+		}
+		patches = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			Expect(req.Header.Get("X-Vmware-Sdk-Journal-Rollback-Of")).To(BeEmpty())
+			res.Header().Set("Content-type", "application/json")
+			resource, ok := resources[req.URL.Path]
+			if !ok {
+				res.WriteHeader(404)
+				_, _ = res.Write([]byte(`{"errors": [{"code": "not_found", "message": "Not found"}]}`))
+				return
+			}
+			if req.Method == http.MethodPatch {
+				var patch map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&patch)).To(Succeed())
+				patches = append(patches, patch)
+				if patch["cpu"] == 999.0 {
+					res.WriteHeader(400)
+					_, _ = res.Write([]byte(`{"errors": [{"code": "bad_request", "message": "Too many CPUs."}]}`))
+					return
+				}
+				mergePatch(resource, patch)
+			}
+			res.WriteHeader(200)
+			Expect(json.NewEncoder(res).Encode(resource)).To(Succeed())
+		}))
+		var serviceErr error
+		vmwareService, serviceErr = vmwarev1.NewVmwareV1(&vmwarev1.VmwareV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		journal = vmwarev1.NewChangeJournal()
+		vmwareService.EnableChangeJournal(journal)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Records the pre-image of a VDC patch and rolls it back`, func() {
+		patch, err := (&vmwarev1.VDCPatch{Cpu: core.Int64Ptr(8), FastProvisioningEnabled: core.BoolPtr(true)}).AsPatch()
+		Expect(err).To(BeNil())
+		_, response, err := vmwareService.UpdateVdc(vmwareService.NewUpdateVdcOptions("vdc1", patch))
+		Expect(err).To(BeNil())
+
+		entry := journal.EntryOf(response)
+		Expect(entry).ToNot(BeNil())
+		Expect(entry.Operation).To(Equal("UpdateVdc"))
+		Expect(entry.ResourceIDs).To(Equal(map[string]string{"id": "vdc1"}))
+		Expect(entry.PreImage["cpu"]).To(Equal(16.0))
+		Expect(entry.PreImage["fast_provisioning_enabled"]).To(Equal(false))
+		Expect(entry.Patch).To(Equal(map[string]interface{}{"cpu": 8.0, "fast_provisioning_enabled": true}))
+		Expect(resources["/vdcs/vdc1"]["cpu"]).To(Equal(8.0))
+
+		rollback, err := journal.Rollback(context.Background(), entry.ID)
+		Expect(err).To(BeNil())
+		Expect(rollback.RollbackOf).To(Equal(entry.ID))
+		Expect(patches[1]).To(Equal(map[string]interface{}{"cpu": 16.0, "fast_provisioning_enabled": false}))
+		Expect(resources["/vdcs/vdc1"]["cpu"]).To(Equal(16.0))
+		Expect(resources["/vdcs/vdc1"]["fast_provisioning_enabled"]).To(Equal(false))
+		Expect(journal.Entry(entry.ID).RolledBackBy).To(Equal(rollback.ID))
+
+		// Neither the entry nor its rollback can be rolled back again.
+		_, err = journal.Rollback(context.Background(), entry.ID)
+		Expect(err).ToNot(BeNil())
+		_, err = journal.Rollback(context.Background(), rollback.ID)
+		Expect(err).ToNot(BeNil())
+		_, err = journal.Rollback(context.Background(), "journal-42")
+		Expect(err).ToNot(BeNil())
+		Expect(patches).To(HaveLen(2))
+
+		// The rollback marker is matched and removed without regard to the case of its name.
+		options := vmwareService.NewUpdateVdcOptions("vdc1", patch).SetHeaders(map[string]string{"x-vmware-sdk-journal-rollback-of": "journal-42"})
+		_, response, err = vmwareService.UpdateVdc(options)
+		Expect(err).To(BeNil())
+		Expect(journal.EntryOf(response).RollbackOf).To(Equal("journal-42"))
+	})
+
+	It(`Restores the host count and file shares of a cluster, removing the fields that were absent`, func() {
+		patch, err := (&vmwarev1.ClusterPatch{
+			HostCount:  core.Int64Ptr(6),
+			FileShares: &vmwarev1.FileSharesPrototype{STORAGETWOIOPSGB: core.Int64Ptr(48000), STORAGEFOURIOPSGB: core.Int64Ptr(2000)},
+		}).AsPatch()
+		Expect(err).To(BeNil())
+		options := vmwareService.NewUpdateDirectorSitesPvdcsClusterOptions("site1", "cluster1", "pvdc1", patch)
+		_, response, err := vmwareService.UpdateDirectorSitesPvdcsCluster(options)
+		Expect(err).To(BeNil())
+		entry := journal.EntryOf(response)
+		Expect(entry.ResourceIDs).To(Equal(map[string]string{"site_id": "site1", "pvdc_id": "pvdc1", "id": "cluster1"}))
+
+		_, err = journal.Rollback(context.Background(), entry.ID)
+		Expect(err).To(BeNil())
+		Expect(patches[1]).To(Equal(map[string]interface{}{
+			"host_count":  4.0,
+			"file_shares": map[string]interface{}{"STORAGE_TWO_IOPS_GB": 24000.0, "STORAGE_FOUR_IOPS_GB": nil},
+		}))
+		Expect(resources["/director_sites/site1/pvdcs/pvdc1/clusters/cluster1"]).To(Equal(map[string]interface{}{
+			"id": "cluster1", "name": "cluster1", "host_count": 4.0,
+			"file_shares": map[string]interface{}{"STORAGE_TWO_IOPS_GB": 24000.0},
+		}))
+	})
+
+	It(`Rolls back the later patches of a resource first and does not record failed patches`, func() {
+		first, err := (&vmwarev1.VDCPatch{Cpu: core.Int64Ptr(8)}).AsPatch()
+		Expect(err).To(BeNil())
+		_, response, err := vmwareService.UpdateVdc(vmwareService.NewUpdateVdcOptions("vdc1", first))
+		Expect(err).To(BeNil())
+		firstEntry := journal.EntryOf(response)
+		second, err := (&vmwarev1.VDCPatch{Ram: core.Int64Ptr(64)}).AsPatch()
+		Expect(err).To(BeNil())
+		_, response, err = vmwareService.UpdateVdc(vmwareService.NewUpdateVdcOptions("vdc1", second))
+		Expect(err).To(BeNil())
+		secondEntry := journal.EntryOf(response)
+
+		failed, err := (&vmwarev1.VDCPatch{Cpu: core.Int64Ptr(999)}).AsPatch()
+		Expect(err).To(BeNil())
+		_, _, err = vmwareService.UpdateVdc(vmwareService.NewUpdateVdcOptions("vdc1", failed))
+		Expect(err).ToNot(BeNil())
+		Expect(journal.Entries()).To(HaveLen(2))
+
+		_, err = journal.Rollback(context.Background(), firstEntry.ID)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring(secondEntry.ID))
+
+		_, err = journal.Rollback(context.Background(), secondEntry.ID)
+		Expect(err).To(BeNil())
+		_, err = journal.Rollback(context.Background(), firstEntry.ID)
+		Expect(err).To(BeNil())
+		Expect(resources["/vdcs/vdc1"]["cpu"]).To(Equal(16.0))
+		Expect(resources["/vdcs/vdc1"]["ram"]).To(Equal(128.0))
+		Expect(journal.Entries()).To(HaveLen(4))
+
+		_, err = vmwarev1.NewChangeJournal().Rollback(context.Background(), firstEntry.ID)
+		Expect(err).ToNot(BeNil())
+	})
+})
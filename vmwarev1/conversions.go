@@ -0,0 +1,184 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vmwarev1
+
+// The conversions turn the models returned by the API into the prototypes and options that create identical
+// resources, so that new environments can be templated from existing ones. They copy the configuration only: the IDs,
+// hrefs, statuses and timestamps assigned by the API are left out. The results share no memory with the models, so
+// they can be modified, for instance to set another name, before they are sent.
+
+import (
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ToPrototype returns the prototype of a file shares configuration identical to the FileShares.
+func (fileShares *FileShares) ToPrototype() *FileSharesPrototype {
+	if fileShares == nil {
+		return nil
+	}
+	return &FileSharesPrototype{
+		STORAGEPOINTTWOFIVEIOPSGB: copyPointer(fileShares.STORAGEPOINTTWOFIVEIOPSGB),
+		STORAGETWOIOPSGB:          copyPointer(fileShares.STORAGETWOIOPSGB),
+		STORAGEFOURIOPSGB:         copyPointer(fileShares.STORAGEFOURIOPSGB),
+		STORAGETENIOPSGB:          copyPointer(fileShares.STORAGETENIOPSGB),
+	}
+}
+
+// ToPrototype returns the prototype of a cluster with the name, host count, host profile and file shares of the
+// Cluster, to be used with CreateDirectorSitesPvdcsClustersOptions.
+func (cluster *Cluster) ToPrototype() *ClusterPrototype {
+	if cluster == nil {
+		return nil
+	}
+	return &ClusterPrototype{
+		Name:        copyPointer(cluster.Name),
+		HostCount:   copyPointer(cluster.HostCount),
+		HostProfile: copyPointer(cluster.HostProfile),
+		FileShares:  cluster.FileShares.ToPrototype(),
+	}
+}
+
+// ToPatch returns the patch that gives another cluster the host count and file shares of the Cluster.
+func (cluster *Cluster) ToPatch() *ClusterPatch {
+	if cluster == nil {
+		return nil
+	}
+	return &ClusterPatch{
+		HostCount:  copyPointer(cluster.HostCount),
+		FileShares: cluster.FileShares.ToPrototype(),
+	}
+}
+
+// ToPrototype returns the prototype of a cluster with the name, host count, host profile and file shares of the
+// ClusterSummary.
+func (clusterSummary *ClusterSummary) ToPrototype() *ClusterPrototype {
+	if clusterSummary == nil {
+		return nil
+	}
+	return &ClusterPrototype{
+		Name:        copyPointer(clusterSummary.Name),
+		HostCount:   copyPointer(clusterSummary.HostCount),
+		HostProfile: copyPointer(clusterSummary.HostProfile),
+		FileShares:  clusterSummary.FileShares.ToPrototype(),
+	}
+}
+
+// ToPrototype returns the prototype of a resource pool with the name, data center and clusters of the PVDC.
+func (pVDC *PVDC) ToPrototype() *PVDCPrototype {
+	if pVDC == nil {
+		return nil
+	}
+	prototype := &PVDCPrototype{
+		Name:           copyPointer(pVDC.Name),
+		DataCenterName: copyPointer(pVDC.DataCenterName),
+		Clusters:       make([]ClusterPrototype, 0, len(pVDC.Clusters)),
+	}
+	for i := range pVDC.Clusters {
+		prototype.Clusters = append(prototype.Clusters, *pVDC.Clusters[i].ToPrototype())
+	}
+	return prototype
+}
+
+// ToCreateOptions returns the options that create a Cloud Director site with the name, resource group, resource
+// pools, services, console connection type and IP allow list of the DirectorSite. The RHEL VM activation key is
+// assigned by the API and is not copied.
+func (directorSite *DirectorSite) ToCreateOptions() *CreateDirectorSitesOptions {
+	if directorSite == nil {
+		return nil
+	}
+	options := &CreateDirectorSitesOptions{
+		Name:                  copyPointer(directorSite.Name),
+		Pvdcs:                 make([]PVDCPrototype, 0, len(directorSite.Pvdcs)),
+		ConsoleConnectionType: copyPointer(directorSite.ConsoleConnectionType),
+		IpAllowList:           copyValues(directorSite.IpAllowList),
+	}
+	if directorSite.ResourceGroup != nil {
+		options.ResourceGroup = &ResourceGroupIdentity{ID: copyPointer(directorSite.ResourceGroup.ID)}
+	}
+	for i := range directorSite.Pvdcs {
+		options.Pvdcs = append(options.Pvdcs, *directorSite.Pvdcs[i].ToPrototype())
+	}
+	for _, service := range directorSite.Services {
+		options.Services = append(options.Services, ServiceIdentity{Name: copyPointer(service.Name)})
+	}
+	return options
+}
+
+// ToPrototype returns the prototype of an edge with the type, size and network HA configuration of the Edge. The
+// network HA variant is VDCEdgePrototypeNetworkHaNetworkHaOnNonStretched when the edge has a secondary resource pool,
+// and VDCEdgePrototypeNetworkHaNetworkHaOnStretched when it spans two data centers of the same resource pool.
+func (edge *Edge) ToPrototype() *VDCEdgePrototype {
+	if edge == nil {
+		return nil
+	}
+	prototype := &VDCEdgePrototype{
+		Type:        copyPointer(edge.Type),
+		PrivateOnly: copyPointer(edge.PrivateOnly),
+	}
+	// The size only applies to performance edges.
+	if stringValue(edge.Type) == Edge_Type_Performance {
+		prototype.Size = copyPointer(edge.Size)
+	}
+	switch {
+	case edge.SecondaryPvdcID != nil:
+		prototype.NetworkHa = &VDCEdgePrototypeNetworkHaNetworkHaOnNonStretched{
+			SecondaryPvdcID: copyPointer(edge.SecondaryPvdcID),
+		}
+	case edge.PrimaryDataCenterName != nil && edge.SecondaryDataCenterName != nil:
+		prototype.NetworkHa = &VDCEdgePrototypeNetworkHaNetworkHaOnStretched{
+			PrimaryDataCenterName:   copyPointer(edge.PrimaryDataCenterName),
+			SecondaryDataCenterName: copyPointer(edge.SecondaryDataCenterName),
+		}
+	}
+	return prototype
+}
+
+// ToCreateOptions returns the options that create a VDC with the name, Cloud Director site, resource pool, edge,
+// reservations and licensing of the VDC. A VDC has at most one edge when it is created, so only the first edge is
+// copied. Compute HA is enabled when the HA of the VDC includes compute. The VDC model does not report its resource
+// group: set it on the options if it is not the default one.
+func (vDC *VDC) ToCreateOptions() *CreateVdcOptions {
+	if vDC == nil {
+		return nil
+	}
+	options := &CreateVdcOptions{
+		Name:                    copyPointer(vDC.Name),
+		FastProvisioningEnabled: copyPointer(vDC.FastProvisioningEnabled),
+		Cpu:                     copyPointer(vDC.Cpu),
+		Ram:                     copyPointer(vDC.Ram),
+		RhelByol:                copyPointer(vDC.RhelByol),
+		WindowsByol:             copyPointer(vDC.WindowsByol),
+	}
+	if vDC.DirectorSite != nil {
+		options.DirectorSite = &VDCDirectorSitePrototype{ID: copyPointer(vDC.DirectorSite.ID)}
+		if pvdc := vDC.DirectorSite.Pvdc; pvdc != nil {
+			options.DirectorSite.Pvdc = &DirectorSitePVDC{
+				ID:           copyPointer(pvdc.ID),
+				ProviderType: pvdc.ProviderType.DeepCopy(),
+			}
+			if vDC.Ha != nil && strings.Contains(*vDC.Ha, "compute") {
+				options.DirectorSite.Pvdc.ComputeHaEnabled = core.BoolPtr(true)
+			}
+		}
+	}
+	if len(vDC.Edges) > 0 {
+		options.Edge = vDC.Edges[0].ToPrototype()
+	}
+	return options
+}
@@ -0,0 +1,215 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package vmwarev1_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vmware-go-sdk/vmwarev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Conversions`, func() {
+	var (
+		testServer    *httptest.Server
+		vmwareService *vmwarev1.VmwareV1
+	)
+
+	// The fields that the API assigns, which cannot round-trip.
+	assigned := vmwarev1.NewDiffOptions().SetIgnoreVolatile(true).SetIgnoreFields(
+		"id", "crn", "status", "status_reasons", "org_href", "org_name", "type", "director_site.url",
+		"edges.id", "edges.public_ips", "edges.private_ips", "edges.transit_gateways", "edges.version",
+		"rhel_vm_activation_key", "console_connection_status", "services.connections", "services.sobrs",
+		"pvdcs.provider_types", "pvdcs.clusters.data_center_name")
+
+	unmarshal := func(body string, result interface{}, unmarshaller core.ModelUnmarshaller) {
+		var m map[string]json.RawMessage
+		Expect(json.Unmarshal([]byte(body), &m)).To(Succeed())
+		Expect(core.UnmarshalModel(m, "", result, unmarshaller)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		// The server creates the resources with the configuration of the requests, as the API does.
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			var body map[string]interface{}
+			Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+			body["id"] = "new"
+			body["status"] = "creating"
+			switch {
+			case req.URL.Path == "/vdcs":
+				directorSite := body["director_site"].(map[string]interface{})
+				pvdc := directorSite["pvdc"].(map[string]interface{})
+				if pvdc["compute_ha_enabled"] == true {
+					body["ha"] = "compute"
+				}
+				delete(pvdc, "compute_ha_enabled")
+				if edge, ok := body["edge"].(map[string]interface{}); ok {
+					if networkHa, ok := edge["network_ha"].(map[string]interface{}); ok {
+						for key, value := range networkHa {
+							edge[key] = value
+						}
+						delete(edge, "network_ha")
+					}
+					body["edges"] = []interface{}{edge}
+					delete(body, "edge")
+				}
+			case req.URL.Path == "/director_sites":
+				for _, service := range body["services"].([]interface{}) {
+					service.(map[string]interface{})["id"] = "new"
+				}
+			case strings.HasSuffix(req.URL.Path, "/clusters"):
+			}
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(201)
+			Expect(json.NewEncoder(res).Encode(body)).To(Succeed())
+		}))
+		var serviceErr error
+		vmwareService, serviceErr = vmwarev1.NewVmwareV1(&vmwarev1.VmwareV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Round-trips a VDC with a non-stretched network HA edge`, func() {
+		var vdc *vmwarev1.VDC
+		unmarshal(`{
+			"id": "vdc1", "href": "https://api/vdcs/vdc1", "crn": "crn:vdc1", "name": "prod", "status": "ready_to_use",
+			"type": "single_tenant", "cpu": 16, "ram": 128, "ha": "compute", "ordered_at": "2026-01-02T03:04:05.000Z",
+			"fast_provisioning_enabled": true, "rhel_byol": false, "windows_byol": true,
+			"org_href": "https://vcd/org", "org_name": "org1", "status_reasons": [],
+			"director_site": {"id": "site1", "url": "https://vcd", "pvdc": {"id": "pvdc1", "provider_type": {"name": "reserved"}}},
+			"edges": [{
+				"id": "edge1", "type": "performance", "size": "large", "private_only": true, "status": "ready_to_use",
+				"version": "4.1", "public_ips": [], "private_ips": ["10.0.0.1"], "transit_gateways": [],
+				"secondary_pvdc_id": "pvdc2"
+			}]
+		}`, &vdc, vmwarev1.UnmarshalVDC)
+
+		options := vdc.ToCreateOptions()
+		Expect(*options.DirectorSite.Pvdc.ComputeHaEnabled).To(BeTrue())
+		Expect(*options.Edge.Size).To(Equal("large"))
+		Expect(options.Edge.NetworkHa).To(Equal(&vmwarev1.VDCEdgePrototypeNetworkHaNetworkHaOnNonStretched{
+			SecondaryPvdcID: core.StringPtr("pvdc2"),
+		}))
+
+		created, _, err := vmwareService.CreateVdc(options)
+		Expect(err).To(BeNil())
+		Expect(vdc.Diff(created, assigned)).To(BeEmpty())
+		Expect(*created.DirectorSite.ID).To(Equal("site1"))
+		Expect(*created.DirectorSite.Pvdc.ID).To(Equal("pvdc1"))
+
+		// The options share no memory with the VDC.
+		*options.Name = "staging"
+		*options.Edge.Type = vmwarev1.VDCEdgePrototype_Type_Efficiency
+		Expect(*vdc.Name).To(Equal("prod"))
+		Expect(*vdc.Edges[0].Type).To(Equal(vmwarev1.Edge_Type_Performance))
+	})
+
+	It(`Round-trips a VDC with a stretched network HA edge`, func() {
+		var vdc *vmwarev1.VDC
+		unmarshal(`{
+			"id": "vdc1", "name": "prod", "cpu": 0, "ram": 0, "status": "ready_to_use", "type": "multitenant",
+			"fast_provisioning_enabled": false, "rhel_byol": false, "windows_byol": false, "status_reasons": [],
+			"director_site": {"id": "site1", "url": "https://vcd", "pvdc": {"id": "pvdc1"}},
+			"edges": [{
+				"id": "edge1", "type": "efficiency", "size": "medium", "private_only": false,
+				"primary_data_center_name": "dal10", "secondary_data_center_name": "dal12"
+			}]
+		}`, &vdc, vmwarev1.UnmarshalVDC)
+
+		options := vdc.ToCreateOptions()
+		Expect(options.DirectorSite.Pvdc.ComputeHaEnabled).To(BeNil())
+		Expect(options.Edge.Size).To(BeNil())
+		Expect(options.Edge.NetworkHa).To(Equal(&vmwarev1.VDCEdgePrototypeNetworkHaNetworkHaOnStretched{
+			PrimaryDataCenterName:   core.StringPtr("dal10"),
+			SecondaryDataCenterName: core.StringPtr("dal12"),
+		}))
+
+		created, _, err := vmwareService.CreateVdc(options)
+		Expect(err).To(BeNil())
+		// Efficiency edges have a size that is not configurable.
+		Expect(vdc.Diff(created, assigned)).To(Equal([]vmwarev1.FieldChange{{Path: "edges[0].size", Old: "medium", New: nil}}))
+	})
+
+	It(`Round-trips a Cloud Director site with its resource pools and clusters`, func() {
+		var directorSite *vmwarev1.DirectorSite
+		unmarshal(`{
+			"id": "site1", "crn": "crn:site1", "href": "https://api/director_sites/site1", "name": "site1",
+			"status": "ready_to_use", "type": "single_tenant", "ordered_at": "2026-01-02T03:04:05.000Z",
+			"resource_group": {"id": "rg1"},
+			"pvdcs": [{
+				"id": "pvdc1", "name": "pvdc1", "data_center_name": "dal10", "status": "ready_to_use",
+				"clusters": [{
+					"id": "cluster1", "name": "cluster1", "host_count": 3, "host_profile": "BM_2S_20_CORES_192_GB",
+					"data_center_name": "dal10", "status": "ready_to_use",
+					"file_shares": {"STORAGE_TWO_IOPS_GB": 24000, "STORAGE_TEN_IOPS_GB": 1000}
+				}],
+				"provider_types": [{"name": "paygo"}]
+			}],
+			"services": [{"name": "veeam", "id": "svc1", "status": "ready_to_use"}],
+			"rhel_vm_activation_key": "key", "console_connection_type": "public",
+			"console_connection_status": "ready", "ip_allow_list": ["10.0.0.0/8"]
+		}`, &directorSite, vmwarev1.UnmarshalDirectorSite)
+
+		options := directorSite.ToCreateOptions()
+		Expect(core.ValidateStruct(options, "options")).To(Succeed())
+		Expect(options.Services).To(Equal([]vmwarev1.ServiceIdentity{{Name: core.StringPtr("veeam")}}))
+		Expect(options.ResourceGroup).To(Equal(&vmwarev1.ResourceGroupIdentity{ID: core.StringPtr("rg1")}))
+
+		created, _, err := vmwareService.CreateDirectorSites(options)
+		Expect(err).To(BeNil())
+		Expect(directorSite.Diff(created, assigned)).To(BeEmpty())
+
+		prototype := directorSite.Pvdcs[0].ToPrototype()
+		Expect(prototype).To(Equal(&options.Pvdcs[0]))
+		Expect(*prototype.Clusters[0].FileShares.STORAGETWOIOPSGB).To(Equal(int64(24000)))
+	})
+
+	It(`Round-trips a cluster`, func() {
+		var cluster *vmwarev1.Cluster
+		unmarshal(`{
+			"id": "cluster1", "name": "cluster1", "href": "https://api/cluster1", "host_count": 4,
+			"host_profile": "BM_2S_20_CORES_192_GB", "data_center_name": "dal10", "status": "ready_to_use",
+			"storage_type": "nfs", "billing_plan": "monthly", "ordered_at": "2026-01-02T03:04:05.000Z",
+			"director_site": {"id": "site1"},
+			"file_shares": {"STORAGE_FOUR_IOPS_GB": 2000}
+		}`, &cluster, vmwarev1.UnmarshalCluster)
+
+		prototype := cluster.ToPrototype()
+		Expect(core.ValidateStruct(prototype, "prototype")).To(Succeed())
+		created, _, err := vmwareService.CreateDirectorSitesPvdcsClusters(
+			vmwareService.NewCreateDirectorSitesPvdcsClustersOptions("site1", "pvdc1", *prototype.Name, *prototype.HostCount, *prototype.HostProfile, prototype.FileShares))
+		Expect(err).To(BeNil())
+		Expect(cluster.Diff(created, assigned, vmwarev1.NewDiffOptions().SetIgnoreFields("storage_type", "billing_plan", "data_center_name", "director_site"))).To(BeEmpty())
+
+		patch, err := cluster.ToPatch().AsPatch()
+		Expect(err).To(BeNil())
+		Expect(json.Marshal(patch)).To(MatchJSON(`{"host_count": 4, "file_shares": {"STORAGE_FOUR_IOPS_GB": 2000}}`))
+
+		var nilCluster *vmwarev1.Cluster
+		Expect(nilCluster.ToPrototype()).To(BeNil())
+	})
+})
@@ -0,0 +1,482 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vmwarev1
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vmware-go-sdk/common"
+)
+
+// Decommission : Tear down a Cloud Director site and everything that depends on it
+// Discover the resources that depend on the Cloud Director site and delete them in order, waiting for each step to
+// complete before the next one:
+//  1. the IBM Transit Gateways of the edges of each virtual data center of the site are detached, then the virtual
+//     data center is deleted;
+//  2. the VCDA cloud-to-cloud connections listed in the options and the VCDA connection endpoints are deleted;
+//  3. Veeam and VCDA are disabled;
+//  4. the clusters of each resource pool are deleted, except the first one, which is deleted with the site;
+//  5. the site is deleted.
+//
+// The API does not list the VCDA cloud-to-cloud connections, so they must be listed in the options.
+//
+// The plan of the teardown is returned with a confirmation token that identifies it. With DryRun set, nothing is
+// deleted. Otherwise, the teardown only starts if the confirmation token of the options is the one of the plan, so a
+// dry run must be done first, and the teardown is refused if the dependents of the site changed since. If a step
+// fails, the steps of the plan that completed are marked as such.
+func (vmware *VmwareV1) Decommission(ctx context.Context, siteID string, decommissionOptions *DecommissionOptions) (result *DecommissionPlan, err error) {
+	if decommissionOptions == nil {
+		decommissionOptions = vmware.NewDecommissionOptions()
+	}
+	if siteID == "" {
+		err = core.SDKErrorf(nil, "siteID cannot be empty", "unexpected-empty-param", common.GetComponentInfo())
+		return
+	}
+
+	result, err = vmware.planDecommission(ctx, siteID, decommissionOptions)
+	if err != nil || decommissionOptions.DryRun {
+		return
+	}
+	if decommissionOptions.ConfirmationToken == nil || *decommissionOptions.ConfirmationToken != result.ConfirmationToken {
+		err = core.SDKErrorf(nil, fmt.Sprintf("the decommission of Cloud Director site '%s' must be confirmed with the token of its plan: run it with DryRun first", siteID), "decommission-confirmation-required", common.GetComponentInfo())
+		return
+	}
+
+	for i := range result.Steps {
+		step := &result.Steps[i]
+		err = vmware.executeDecommissionStep(ctx, siteID, step, decommissionOptions)
+		if err != nil {
+			return
+		}
+		step.Status = DecommissionStep_Status_Completed
+	}
+	return
+}
+
+// planDecommission lists the steps that tear down the Cloud Director site "siteID".
+func (vmware *VmwareV1) planDecommission(ctx context.Context, siteID string, options *DecommissionOptions) (plan *DecommissionPlan, err error) {
+	directorSite, err := vmware.getDirectorSiteForDecommission(ctx, siteID, options)
+	if err != nil {
+		return
+	}
+	listVdcsOptions := vmware.NewListVdcsOptions()
+	listVdcsOptions.AcceptLanguage = options.AcceptLanguage
+	listVdcsOptions.Headers = options.Headers
+	vdcs, _, err := vmware.ListVdcsWithContext(ctx, listVdcsOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-vdcs-error")
+		return
+	}
+
+	plan = &DecommissionPlan{SiteID: siteID}
+	addStep := func(step DecommissionStep) {
+		step.Status = DecommissionStep_Status_Planned
+		plan.Steps = append(plan.Steps, step)
+	}
+
+	for _, vdc := range vdcs.Vdcs {
+		if vdc.DirectorSite == nil || stringValue(vdc.DirectorSite.ID) != siteID || stringValue(vdc.Status) == VDC_Status_Deleted {
+			continue
+		}
+		vdcID := stringValue(vdc.ID)
+		for _, edge := range vdc.Edges {
+			transitGatewayIDs := []string{}
+			for _, transitGateway := range edge.TransitGateways {
+				if stringValue(transitGateway.Status) != TransitGateway_Status_Deleting {
+					transitGatewayIDs = append(transitGatewayIDs, stringValue(transitGateway.ID))
+				}
+			}
+			if len(transitGatewayIDs) == 0 {
+				continue
+			}
+			addStep(DecommissionStep{
+				Type:              DecommissionStep_Type_DetachTransitGateways,
+				ResourceID:        stringValue(edge.ID),
+				VdcID:             vdcID,
+				TransitGatewayIDs: transitGatewayIDs,
+				Description:       fmt.Sprintf("detach IBM Transit Gateways %s from edge '%s' of virtual data center '%s'", strings.Join(transitGatewayIDs, ", "), stringValue(edge.ID), stringValue(vdc.Name)),
+			})
+		}
+		addStep(DecommissionStep{
+			Type:        DecommissionStep_Type_DeleteVdc,
+			ResourceID:  vdcID,
+			Description: fmt.Sprintf("delete virtual data center '%s' (%s)", stringValue(vdc.Name), vdcID),
+		})
+	}
+
+	vcda := findService(directorSite, Service_Name_Vcda)
+	if vcda != nil && stringValue(vcda.Status) != Service_Status_Deleted {
+		for _, c2cConnectionID := range options.C2cConnectionIDs {
+			addStep(DecommissionStep{
+				Type:        DecommissionStep_Type_DeleteVcdaC2cConnection,
+				ResourceID:  c2cConnectionID,
+				Description: fmt.Sprintf("delete VCDA cloud-to-cloud connection '%s'", c2cConnectionID),
+			})
+		}
+		for _, connection := range vcda.Connections {
+			switch stringValue(connection.Status) {
+			case VcdaConnection_Status_Deleted, VcdaConnection_Status_Deleting:
+				continue
+			}
+			addStep(DecommissionStep{
+				Type:        DecommissionStep_Type_DeleteVcdaConnectionEndpoint,
+				ResourceID:  stringValue(connection.ID),
+				Description: fmt.Sprintf("delete %s VCDA connection endpoint '%s' in %s", stringValue(connection.Type), stringValue(connection.ID), stringValue(connection.DataCenterName)),
+			})
+		}
+	}
+
+	veeam := findService(directorSite, Service_Name_Veeam)
+	if veeam != nil && stringValue(veeam.Status) != Service_Status_Deleted {
+		addStep(DecommissionStep{
+			Type:        DecommissionStep_Type_DisableVeeam,
+			ResourceID:  stringValue(veeam.ID),
+			Description: "disable Veeam",
+		})
+	}
+	if vcda != nil && stringValue(vcda.Status) != Service_Status_Deleted {
+		addStep(DecommissionStep{
+			Type:        DecommissionStep_Type_DisableVcda,
+			ResourceID:  stringValue(vcda.ID),
+			Description: "disable VCDA",
+		})
+	}
+
+	for _, pvdc := range directorSite.Pvdcs {
+		for i, cluster := range pvdc.Clusters {
+			if i == 0 {
+				continue
+			}
+			addStep(DecommissionStep{
+				Type:        DecommissionStep_Type_DeleteCluster,
+				ResourceID:  stringValue(cluster.ID),
+				PvdcID:      stringValue(pvdc.ID),
+				Description: fmt.Sprintf("delete cluster '%s' of resource pool '%s'", stringValue(cluster.Name), stringValue(pvdc.Name)),
+			})
+		}
+	}
+
+	addStep(DecommissionStep{
+		Type:        DecommissionStep_Type_DeleteDirectorSite,
+		ResourceID:  siteID,
+		Description: fmt.Sprintf("delete Cloud Director site '%s' (%s)", stringValue(directorSite.Name), siteID),
+	})
+
+	plan.ConfirmationToken = decommissionConfirmationToken(plan)
+	return
+}
+
+// decommissionConfirmationToken returns a token that changes with the steps of the plan.
+func decommissionConfirmationToken(plan *DecommissionPlan) string {
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%s\n", plan.SiteID)
+	for _, step := range plan.Steps {
+		fmt.Fprintf(hash, "%s %s %s %s %s\n", step.Type, step.ResourceID, step.VdcID, step.PvdcID, strings.Join(step.TransitGatewayIDs, ","))
+	}
+	return "decommission-" + hex.EncodeToString(hash.Sum(nil))[:16]
+}
+
+// executeDecommissionStep sends the requests of "step" and waits until the step is complete.
+func (vmware *VmwareV1) executeDecommissionStep(ctx context.Context, siteID string, step *DecommissionStep, options *DecommissionOptions) (err error) {
+	switch step.Type {
+	case DecommissionStep_Type_DetachTransitGateways:
+		reconcileOptions := vmware.NewReconcileTransitGatewayConnectionsOptions(step.VdcID).
+			SetEdge(step.ResourceID, []TransitGatewayTarget{}).
+			SetWaitOptions(options.WaitOptions)
+		reconcileOptions.AcceptLanguage = options.AcceptLanguage
+		reconcileOptions.Headers = options.Headers
+		_, err = vmware.ReconcileTransitGatewayConnectionsWithContext(ctx, reconcileOptions)
+
+	case DecommissionStep_Type_DeleteVdc:
+		deleteVdcOptions := vmware.NewDeleteVdcOptions(step.ResourceID)
+		deleteVdcOptions.AcceptLanguage = options.AcceptLanguage
+		deleteVdcOptions.Headers = options.Headers
+		var operation *Operation
+		operation, _, err = vmware.StartDeleteVdcWithContext(ctx, deleteVdcOptions)
+		if err == nil {
+			operation.WaitOptions = options.WaitOptions
+			err = operation.Wait(ctx)
+		}
+
+	case DecommissionStep_Type_DeleteVcdaC2cConnection:
+		deleteC2cOptions := vmware.NewDeleteDirectorSitesVcdaC2cConnectionOptions(siteID, step.ResourceID)
+		deleteC2cOptions.AcceptLanguage = options.AcceptLanguage
+		deleteC2cOptions.Headers = options.Headers
+		_, _, err = vmware.DeleteDirectorSitesVcdaC2cConnectionWithContext(ctx, deleteC2cOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "delete-vcda-c2c-connection-error")
+			return
+		}
+		// The cloud-to-cloud connection has no GET of its own, so wait until
+		// the VCDA service no longer lists it and has finished updating.
+		description := fmt.Sprintf("VCDA cloud-to-cloud connection '%s' to be deleted", step.ResourceID)
+		err = waitUntil(ctx, options.WaitOptions, description, func(ctx context.Context) (bool, error) {
+			directorSite, err := vmware.getDirectorSiteForDecommission(ctx, siteID, options)
+			if err != nil {
+				return false, err
+			}
+			vcda := findService(directorSite, Service_Name_Vcda)
+			if vcda == nil || stringValue(vcda.Status) == Service_Status_Deleted {
+				return true, nil
+			}
+			for _, connection := range vcda.Connections {
+				if stringValue(connection.ID) == step.ResourceID && stringValue(connection.Status) != VcdaConnection_Status_Deleted {
+					return false, nil
+				}
+			}
+			status := stringValue(vcda.Status)
+			return status != Service_Status_Updating && status != Service_Status_Deleting, nil
+		})
+
+	case DecommissionStep_Type_DeleteVcdaConnectionEndpoint:
+		deleteEndpointOptions := vmware.NewDeleteDirectorSitesVcdaConnectionEndpointsOptions(siteID, step.ResourceID)
+		deleteEndpointOptions.AcceptLanguage = options.AcceptLanguage
+		deleteEndpointOptions.Headers = options.Headers
+		_, _, err = vmware.DeleteDirectorSitesVcdaConnectionEndpointsWithContext(ctx, deleteEndpointOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "delete-vcda-connection-endpoint-error")
+			return
+		}
+		description := fmt.Sprintf("VCDA connection endpoint '%s' to be deleted", step.ResourceID)
+		err = waitUntil(ctx, options.WaitOptions, description, func(ctx context.Context) (bool, error) {
+			directorSite, err := vmware.getDirectorSiteForDecommission(ctx, siteID, options)
+			if err != nil {
+				return false, err
+			}
+			vcda := findService(directorSite, Service_Name_Vcda)
+			if vcda == nil {
+				return true, nil
+			}
+			for _, connection := range vcda.Connections {
+				if stringValue(connection.ID) == step.ResourceID {
+					return stringValue(connection.Status) == VcdaConnection_Status_Deleted, nil
+				}
+			}
+			return true, nil
+		})
+
+	case DecommissionStep_Type_DisableVeeam:
+		disableVeeamOptions := vmware.NewEnableVeeamOnPvdcsListOptions(siteID, false)
+		disableVeeamOptions.AcceptLanguage = options.AcceptLanguage
+		disableVeeamOptions.Headers = options.Headers
+		_, _, err = vmware.EnableVeeamOnPvdcsListWithContext(ctx, disableVeeamOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "disable-veeam-error")
+			return
+		}
+		description := fmt.Sprintf("the Veeam service of Cloud Director site '%s' to be deleted", siteID)
+		err = waitUntil(ctx, options.WaitOptions, description, func(ctx context.Context) (bool, error) {
+			directorSite, err := vmware.getDirectorSiteForDecommission(ctx, siteID, options)
+			if err != nil {
+				return false, err
+			}
+			veeam := findService(directorSite, Service_Name_Veeam)
+			return veeam == nil || stringValue(veeam.Status) == Service_Status_Deleted, nil
+		})
+
+	case DecommissionStep_Type_DisableVcda:
+		disableVcdaOptions := vmware.NewEnableVcdaOnDataCenterOptions(siteID, false)
+		disableVcdaOptions.AcceptLanguage = options.AcceptLanguage
+		disableVcdaOptions.Headers = options.Headers
+		var operation *Operation
+		operation, _, err = vmware.StartEnableVcdaOnDataCenterWithContext(ctx, disableVcdaOptions)
+		if err == nil {
+			operation.WaitOptions = options.WaitOptions
+			err = operation.Wait(ctx)
+		}
+
+	case DecommissionStep_Type_DeleteCluster:
+		deleteClusterOptions := vmware.NewDeleteDirectorSitesPvdcsClusterOptions(siteID, step.ResourceID, step.PvdcID)
+		deleteClusterOptions.AcceptLanguage = options.AcceptLanguage
+		deleteClusterOptions.Headers = options.Headers
+		_, _, err = vmware.DeleteDirectorSitesPvdcsClusterWithContext(ctx, deleteClusterOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "delete-cluster-error")
+			return
+		}
+		description := fmt.Sprintf("cluster '%s' to be deleted", step.ResourceID)
+		err = waitUntil(ctx, options.WaitOptions, description, func(ctx context.Context) (bool, error) {
+			getClusterOptions := vmware.NewGetDirectorInstancesPvdcsClusterOptions(siteID, step.ResourceID, step.PvdcID)
+			getClusterOptions.AcceptLanguage = options.AcceptLanguage
+			getClusterOptions.Headers = options.Headers
+			cluster, response, err := vmware.GetDirectorInstancesPvdcsClusterWithContext(ctx, getClusterOptions)
+			if isNotFound(response) {
+				return true, nil
+			}
+			if err != nil {
+				return false, core.RepurposeSDKProblem(err, "get-cluster-error")
+			}
+			return stringValue(cluster.Status) == "deleted", nil
+		})
+
+	case DecommissionStep_Type_DeleteDirectorSite:
+		deleteDirectorSiteOptions := vmware.NewDeleteDirectorSiteOptions(siteID)
+		deleteDirectorSiteOptions.AcceptLanguage = options.AcceptLanguage
+		deleteDirectorSiteOptions.Headers = options.Headers
+		var operation *Operation
+		operation, _, err = vmware.StartDeleteDirectorSiteWithContext(ctx, deleteDirectorSiteOptions)
+		if err == nil {
+			operation.WaitOptions = options.WaitOptions
+			err = operation.Wait(ctx)
+		}
+	}
+	return
+}
+
+func (vmware *VmwareV1) getDirectorSiteForDecommission(ctx context.Context, siteID string, options *DecommissionOptions) (directorSite *DirectorSite, err error) {
+	getDirectorSiteOptions := vmware.NewGetDirectorSiteOptions(siteID)
+	getDirectorSiteOptions.AcceptLanguage = options.AcceptLanguage
+	getDirectorSiteOptions.Headers = options.Headers
+	directorSite, _, err = vmware.GetDirectorSiteWithContext(ctx, getDirectorSiteOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-director-site-error")
+	}
+	return
+}
+
+// DecommissionOptions : The Decommission options.
+type DecommissionOptions struct {
+	// Whether the plan of the teardown is returned without deleting anything.
+	DryRun bool `json:"-"`
+
+	// The confirmation token of the plan returned by a dry run.
+	ConfirmationToken *string `json:"-"`
+
+	// The IDs of the VCDA cloud-to-cloud connections of the site, which the API does not list.
+	C2cConnectionIDs []string `json:"-"`
+
+	// Controls how long to wait for each step.
+	WaitOptions *WaitOptions `json:"-"`
+
+	// Language.
+	AcceptLanguage *string `json:"Accept-Language,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewDecommissionOptions : Instantiate DecommissionOptions
+func (*VmwareV1) NewDecommissionOptions() *DecommissionOptions {
+	return &DecommissionOptions{}
+}
+
+// SetDryRun : Allow user to set DryRun
+func (_options *DecommissionOptions) SetDryRun(dryRun bool) *DecommissionOptions {
+	_options.DryRun = dryRun
+	return _options
+}
+
+// SetConfirmationToken : Allow user to set ConfirmationToken
+func (_options *DecommissionOptions) SetConfirmationToken(confirmationToken string) *DecommissionOptions {
+	_options.ConfirmationToken = core.StringPtr(confirmationToken)
+	return _options
+}
+
+// SetC2cConnectionIDs : Allow user to set C2cConnectionIDs
+func (_options *DecommissionOptions) SetC2cConnectionIDs(c2cConnectionIDs []string) *DecommissionOptions {
+	_options.C2cConnectionIDs = c2cConnectionIDs
+	return _options
+}
+
+// SetWaitOptions : Allow user to set WaitOptions
+func (_options *DecommissionOptions) SetWaitOptions(waitOptions *WaitOptions) *DecommissionOptions {
+	_options.WaitOptions = waitOptions
+	return _options
+}
+
+// SetAcceptLanguage : Allow user to set AcceptLanguage
+func (_options *DecommissionOptions) SetAcceptLanguage(acceptLanguage string) *DecommissionOptions {
+	_options.AcceptLanguage = core.StringPtr(acceptLanguage)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *DecommissionOptions) SetHeaders(param map[string]string) *DecommissionOptions {
+	options.Headers = param
+	return options
+}
+
+// DecommissionPlan : The ordered steps that tear down a Cloud Director site.
+type DecommissionPlan struct {
+	// The ID of the Cloud Director site.
+	SiteID string `json:"site_id"`
+
+	// The steps, in the order in which they are executed.
+	Steps []DecommissionStep `json:"steps"`
+
+	// The token that confirms the plan, to be set on the DecommissionOptions.
+	ConfirmationToken string `json:"confirmation_token"`
+}
+
+// String returns the steps of the plan, one per line.
+func (plan *DecommissionPlan) String() string {
+	var builder strings.Builder
+	for i, step := range plan.Steps {
+		fmt.Fprintf(&builder, "%d. %s [%s]\n", i+1, step.Description, step.Status)
+	}
+	return builder.String()
+}
+
+// DecommissionStep : A step of the teardown of a Cloud Director site.
+type DecommissionStep struct {
+	// The type of the step.
+	Type string `json:"type"`
+
+	// The ID of the resource that the step deletes or changes: an edge for detach_transit_gateways, a service instance
+	// for disable_veeam and disable_vcda.
+	ResourceID string `json:"resource_id"`
+
+	// The ID of the virtual data center of the edge, for detach_transit_gateways.
+	VdcID string `json:"vdc_id,omitempty"`
+
+	// The ID of the resource pool of the cluster, for delete_cluster.
+	PvdcID string `json:"pvdc_id,omitempty"`
+
+	// The IDs of the IBM Transit Gateways, for detach_transit_gateways.
+	TransitGatewayIDs []string `json:"transit_gateway_ids,omitempty"`
+
+	// A description of the step.
+	Description string `json:"description"`
+
+	// The status of the step.
+	Status string `json:"status"`
+}
+
+// Constants associated with the DecommissionStep.Type property.
+// The type of the step.
+const (
+	DecommissionStep_Type_DetachTransitGateways        = "detach_transit_gateways"
+	DecommissionStep_Type_DeleteVdc                    = "delete_vdc"
+	DecommissionStep_Type_DeleteVcdaC2cConnection      = "delete_vcda_c2c_connection"
+	DecommissionStep_Type_DeleteVcdaConnectionEndpoint = "delete_vcda_connection_endpoint"
+	DecommissionStep_Type_DisableVeeam                 = "disable_veeam"
+	DecommissionStep_Type_DisableVcda                  = "disable_vcda"
+	DecommissionStep_Type_DeleteCluster                = "delete_cluster"
+	DecommissionStep_Type_DeleteDirectorSite           = "delete_director_site"
+)
+
+// Constants associated with the DecommissionStep.Status property.
+// The status of the step.
+const (
+	DecommissionStep_Status_Planned   = "planned"
+	DecommissionStep_Status_Completed = "completed"
+)
@@ -0,0 +1,284 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package vmwarev1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vmware-go-sdk/vmwarev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// fakeDirectorSite simulates a Cloud Director site and its dependents. Deleted resources disappear at once.
+type fakeDirectorSite struct {
+	sync.Mutex
+	deleted   bool
+	clusters  []string
+	veeam     bool
+	vcda      bool
+	endpoints []string
+	// The status of the VCDA service, which stays "updating" while a
+	// cloud-to-cloud connection is being deleted.
+	vcdaStatus string
+	// The IBM Transit Gateways of the edge of each VDC, keyed by VDC ID.
+	vdcs  map[string][]string
+	calls []string
+}
+
+func (site *fakeDirectorSite) siteJSON() string {
+	clusters := []string{}
+	for _, id := range site.clusters {
+		clusters = append(clusters, fmt.Sprintf(`{"id": "%s", "name": "%s", "host_count": 2, "host_profile": "BM_2S_20_CORES_192_GB", "data_center_name": "dal10", "status": "ready_to_use", "storage_type": "nfs", "billing_plan": "monthly", "file_shares": {}}`, id, id))
+	}
+	services := []string{}
+	if site.veeam {
+		services = append(services, `{"name": "veeam", "id": "veeam1", "ordered_at": "2019-01-01T12:00:00.000Z", "status": "ready_to_use", "connections": [], "sobrs": []}`)
+	}
+	if site.vcda {
+		connections := []string{}
+		for _, id := range site.endpoints {
+			connections = append(connections, fmt.Sprintf(`{"id": "%s", "status": "ready_to_use", "type": "public", "speed": "1 Gbps", "data_center_name": "dal10", "allow_list": []}`, id))
+		}
+		status := site.vcdaStatus
+		if status == "" {
+			status = "ready_to_use"
+		}
+		services = append(services, fmt.Sprintf(`{"name": "vcda", "id": "vcda1", "ordered_at": "2019-01-01T12:00:00.000Z", "status": "%s", "connections": [%s], "sobrs": []}`, status, strings.Join(connections, ",")))
+	}
+	return fmt.Sprintf(`{"crn": "Crn", "href": "Href", "id": "site1", "ordered_at": "2019-01-01T12:00:00.000Z", "name": "prod", "status": "ready_to_use", "resource_group": {"id": "rg_id"}, "pvdcs": [{"id": "pvdc1", "name": "pvdc1", "data_center_name": "dal10", "status": "ready_to_use", "clusters": [%s]}], "type": "single_tenant", "services": [%s], "console_connection_type": "private", "console_connection_status": "ready_to_use", "ip_allow_list": []}`,
+		strings.Join(clusters, ","), strings.Join(services, ","))
+}
+
+func (site *fakeDirectorSite) vdcJSON(id string) string {
+	siteID := "site1"
+	if id == "other" {
+		siteID = "site2"
+	}
+	transitGateways := []string{}
+	for _, transitGatewayID := range site.vdcs[id] {
+		transitGateways = append(transitGateways, fmt.Sprintf(`{"id": "%s", "region": "us-south", "status": "ready_to_use", "connections": []}`, transitGatewayID))
+	}
+	return fmt.Sprintf(`{"href": "Href", "id": "%s", "crn": "Crn", "director_site": {"id": "%s", "pvdc": {"id": "pvdc1"}, "url": "URL"}, "edges": [{"id": "edge1", "public_ips": [], "private_ips": [], "size": "medium", "status": "ready_to_use", "type": "performance", "version": "Version", "transit_gateways": [%s]}], "status_reasons": [], "name": "%s", "ordered_at": "2019-01-01T12:00:00.000Z", "org_href": "OrgHref", "org_name": "OrgName", "status": "ready_to_use", "type": "single_tenant", "fast_provisioning_enabled": false, "rhel_byol": false, "windows_byol": false}`,
+		id, siteID, strings.Join(transitGateways, ","), id)
+}
+
+func (site *fakeDirectorSite) handler() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		defer GinkgoRecover()
+		site.Lock()
+		defer site.Unlock()
+		res.Header().Set("Content-type", "application/json")
+		path := req.URL.EscapedPath()
+		notFound := func() {
+			res.WriteHeader(404)
+			fmt.Fprint(res, `{"errors": [{"code": "not_found", "message": "Not found"}]}`)
+		}
+		if req.Method != http.MethodGet {
+			site.calls = append(site.calls, req.Method+" "+path)
+		}
+
+		switch {
+		case req.Method == http.MethodGet && path == "/vdcs":
+			vdcs := []string{}
+			for id := range site.vdcs {
+				vdcs = append(vdcs, site.vdcJSON(id))
+			}
+			res.WriteHeader(200)
+			fmt.Fprintf(res, `{"vdcs": [%s]}`, strings.Join(vdcs, ","))
+		case strings.HasPrefix(path, "/vdcs/"):
+			parts := strings.Split(strings.TrimPrefix(path, "/vdcs/"), "/")
+			if _, ok := site.vdcs[parts[0]]; !ok {
+				notFound()
+				return
+			}
+			body := site.vdcJSON(parts[0])
+			switch {
+			case req.Method == http.MethodDelete && len(parts) == 1:
+				delete(site.vdcs, parts[0])
+			case req.Method == http.MethodDelete:
+				site.vdcs[parts[0]] = nil
+				body = fmt.Sprintf(`{"id": "%s", "connections": [], "status": "deleting", "region": "us-south"}`, parts[4])
+			}
+			res.WriteHeader(200)
+			fmt.Fprint(res, body)
+		case site.deleted:
+			notFound()
+		case req.Method == http.MethodGet && path == "/director_sites/site1":
+			res.WriteHeader(200)
+			fmt.Fprint(res, site.siteJSON())
+			// The deletion of a cloud-to-cloud connection finishes after one poll.
+			site.vcdaStatus = ""
+		case req.Method == http.MethodDelete && path == "/director_sites/site1":
+			res.WriteHeader(202)
+			fmt.Fprint(res, site.siteJSON())
+			site.deleted = true
+		case req.Method == http.MethodDelete && strings.HasPrefix(path, "/director_sites/site1/services/vcda/c2c_connections/"):
+			site.vcdaStatus = "updating"
+			res.WriteHeader(202)
+			fmt.Fprint(res, `{"id": "c2c1", "status": "deleting", "peer_offering": "dr2c", "local_data_center_name": "dal10", "local_site_name": "prod", "peer_site_name": "peer", "peer_region": "us-east", "note": ""}`)
+		case req.Method == http.MethodDelete && strings.HasPrefix(path, "/director_sites/site1/services/vcda/connection_endpoints/"):
+			if site.vcdaStatus != "" {
+				Fail("connection endpoint deleted while the VCDA service is " + site.vcdaStatus)
+			}
+			site.endpoints = nil
+			res.WriteHeader(202)
+			fmt.Fprint(res, site.siteJSON())
+		case req.Method == http.MethodPost && path == "/director_sites/site1/action/enable_veeam":
+			site.veeam = false
+			res.WriteHeader(202)
+			fmt.Fprint(res, `{"message": "Veeam is being disabled."}`)
+		case req.Method == http.MethodPost && path == "/director_sites/site1/action/enable_vcda":
+			site.vcda = false
+			res.WriteHeader(202)
+			fmt.Fprint(res, `{"message": "VCDA is being disabled."}`)
+		case strings.HasPrefix(path, "/director_sites/site1/pvdcs/pvdc1/clusters/"):
+			id := strings.TrimPrefix(path, "/director_sites/site1/pvdcs/pvdc1/clusters/")
+			remaining := []string{}
+			for _, cluster := range site.clusters {
+				if cluster != id {
+					remaining = append(remaining, cluster)
+				}
+			}
+			if len(remaining) == len(site.clusters) {
+				notFound()
+				return
+			}
+			if req.Method == http.MethodDelete {
+				site.clusters = remaining
+			}
+			res.WriteHeader(200)
+			fmt.Fprintf(res, `{"id": "%s", "name": "%s", "host_count": 2, "host_profile": "BM_2S_20_CORES_192_GB", "data_center_name": "dal10", "status": "deleting"}`, id, id)
+		default:
+			Fail(fmt.Sprintf("unexpected request %s %s", req.Method, path))
+		}
+	}
+}
+
+var _ = Describe(`Decommission`, func() {
+	var (
+		testServer    *httptest.Server
+		site          *fakeDirectorSite
+		vmwareService *vmwarev1.VmwareV1
+		waitOptions   *vmwarev1.WaitOptions
+	)
+
+	BeforeEach(func() {
+		site = &fakeDirectorSite{
+			clusters:  []string{"cluster1", "cluster2"},
+			veeam:     true,
+			vcda:      true,
+			endpoints: []string{"endpoint1"},
+			vdcs: map[string][]string{
+				"vdc1":  {"tgw1", "tgw2"},
+				"other": {"tgw3"},
+			},
+		}
+		testServer = httptest.NewServer(site.handler())
+		var serviceErr error
+		vmwareService, serviceErr = vmwarev1.NewVmwareV1(&vmwarev1.VmwareV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		waitOptions = vmwareService.NewWaitOptions(time.Millisecond, 5*time.Second)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Plans the teardown without deleting anything in a dry run`, func() {
+		options := vmwareService.NewDecommissionOptions().SetDryRun(true).SetC2cConnectionIDs([]string{"c2c1"})
+		plan, err := vmwareService.Decommission(context.Background(), "site1", options)
+		Expect(err).To(BeNil())
+		Expect(site.calls).To(BeEmpty())
+
+		types := []string{}
+		for _, step := range plan.Steps {
+			Expect(step.Status).To(Equal(vmwarev1.DecommissionStep_Status_Planned))
+			types = append(types, step.Type+" "+step.ResourceID)
+		}
+		Expect(types).To(Equal([]string{
+			"detach_transit_gateways edge1",
+			"delete_vdc vdc1",
+			"delete_vcda_c2c_connection c2c1",
+			"delete_vcda_connection_endpoint endpoint1",
+			"disable_veeam veeam1",
+			"disable_vcda vcda1",
+			"delete_cluster cluster2",
+			"delete_director_site site1",
+		}))
+		Expect(plan.Steps[0].TransitGatewayIDs).To(Equal([]string{"tgw1", "tgw2"}))
+		Expect(plan.ConfirmationToken).To(HavePrefix("decommission-"))
+		Expect(plan.String()).To(ContainSubstring("1. detach IBM Transit Gateways tgw1, tgw2 from edge 'edge1' of virtual data center 'vdc1' [planned]"))
+
+		// The same dependents give the same token.
+		again, err := vmwareService.Decommission(context.Background(), "site1", options)
+		Expect(err).To(BeNil())
+		Expect(again.ConfirmationToken).To(Equal(plan.ConfirmationToken))
+	})
+
+	It(`Refuses to tear down without the confirmation token of the current plan`, func() {
+		plan, err := vmwareService.Decommission(context.Background(), "site1", nil)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("must be confirmed"))
+		Expect(plan.Steps).To(HaveLen(7))
+
+		// A dependent appeared since the dry run.
+		dryRun, err := vmwareService.Decommission(context.Background(), "site1", vmwareService.NewDecommissionOptions().SetDryRun(true))
+		Expect(err).To(BeNil())
+		site.Lock()
+		site.clusters = append(site.clusters, "cluster3")
+		site.Unlock()
+		options := vmwareService.NewDecommissionOptions().SetConfirmationToken(dryRun.ConfirmationToken)
+		_, err = vmwareService.Decommission(context.Background(), "site1", options)
+		Expect(err).ToNot(BeNil())
+		Expect(site.calls).To(BeEmpty())
+	})
+
+	It(`Tears down the dependents in order and waits for each step`, func() {
+		options := vmwareService.NewDecommissionOptions().SetDryRun(true).SetC2cConnectionIDs([]string{"c2c1"}).SetWaitOptions(waitOptions)
+		plan, err := vmwareService.Decommission(context.Background(), "site1", options)
+		Expect(err).To(BeNil())
+
+		options.SetDryRun(false).SetConfirmationToken(plan.ConfirmationToken)
+		plan, err = vmwareService.Decommission(context.Background(), "site1", options)
+		Expect(err).To(BeNil())
+		for _, step := range plan.Steps {
+			Expect(step.Status).To(Equal(vmwarev1.DecommissionStep_Status_Completed))
+		}
+		Expect(site.calls).To(Equal([]string{
+			"DELETE /vdcs/vdc1/edges/edge1/transit_gateways/tgw1",
+			"DELETE /vdcs/vdc1/edges/edge1/transit_gateways/tgw2",
+			"DELETE /vdcs/vdc1",
+			"DELETE /director_sites/site1/services/vcda/c2c_connections/c2c1",
+			"DELETE /director_sites/site1/services/vcda/connection_endpoints/endpoint1",
+			"POST /director_sites/site1/action/enable_veeam",
+			"POST /director_sites/site1/action/enable_vcda",
+			"DELETE /director_sites/site1/pvdcs/pvdc1/clusters/cluster2",
+			"DELETE /director_sites/site1",
+		}))
+		Expect(site.vdcs).To(HaveKey("other"))
+		Expect(site.deleted).To(BeTrue())
+	})
+})
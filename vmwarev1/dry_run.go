@@ -0,0 +1,199 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package vmwarev1
+
+// In dry-run mode, the operations that change resources are built and validated as usual, merge-patch bodies
+// included, but their requests are recorded as PlannedRequests instead of being sent. The operation then returns a
+// synthetic result. Read operations are still sent, so that a plan can be computed from the actual state.
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DryRunHeader is set on the synthetic responses of dry-run mode. Its value is the ID of the PlannedRequest.
+const DryRunHeader = "X-Vmware-Sdk-Dry-Run"
+
+// PlannedRequest : A request that dry-run mode did not send.
+type PlannedRequest struct {
+	// The ID of the planned request, unique within a DryRun.
+	ID string `json:"id"`
+
+	// The name of the operation, such as "UpdateVdc", or "Unknown".
+	Operation string `json:"operation"`
+
+	// The HTTP method of the request.
+	Method string `json:"method"`
+
+	// The URL of the request.
+	URL string `json:"url"`
+
+	// The path parameters of the request, such as "id".
+	PathParams map[string]string `json:"path_params,omitempty"`
+
+	// The headers of the request. The Authorization header is redacted.
+	Headers http.Header `json:"headers"`
+
+	// The body of the request.
+	Body json.RawMessage `json:"body,omitempty"`
+}
+
+// DryRun : Records the requests that the operations that change resources would send. Install it with
+// VmwareV1.EnableDryRun.
+type DryRun struct {
+	enabled atomic.Bool
+
+	mutex    sync.Mutex
+	plans    []PlannedRequest
+	sequence int
+}
+
+// NewDryRun : Instantiate DryRun. It is enabled.
+func NewDryRun() *DryRun {
+	dryRun := &DryRun{}
+	dryRun.enabled.Store(true)
+	return dryRun
+}
+
+// EnableDryRun installs dry-run mode on the service and returns it. The mode can be switched off and on again with
+// SetEnabled. Requests are authenticated before they are recorded, so valid credentials are still needed.
+func (vmware *VmwareV1) EnableDryRun() *DryRun {
+	dryRun := NewDryRun()
+	vmware.Use(dryRun.Middleware())
+	return dryRun
+}
+
+// SetEnabled switches dry-run mode on or off.
+func (dryRun *DryRun) SetEnabled(enabled bool) {
+	dryRun.enabled.Store(enabled)
+}
+
+// IsEnabled returns whether dry-run mode is on.
+func (dryRun *DryRun) IsEnabled() bool {
+	return dryRun.enabled.Load()
+}
+
+// Plans returns the requests recorded so far, in order.
+func (dryRun *DryRun) Plans() []PlannedRequest {
+	dryRun.mutex.Lock()
+	defer dryRun.mutex.Unlock()
+	return append([]PlannedRequest(nil), dryRun.plans...)
+}
+
+// Reset forgets the recorded requests.
+func (dryRun *DryRun) Reset() {
+	dryRun.mutex.Lock()
+	defer dryRun.mutex.Unlock()
+	dryRun.plans = nil
+}
+
+// PlanOf returns the request recorded for the call that returned "response", or nil if the request was sent.
+func (dryRun *DryRun) PlanOf(response *core.DetailedResponse) *PlannedRequest {
+	if response == nil {
+		return nil
+	}
+	id := headerValue(response.Headers, DryRunHeader)
+	if id == "" {
+		return nil
+	}
+	dryRun.mutex.Lock()
+	defer dryRun.mutex.Unlock()
+	for i := range dryRun.plans {
+		if dryRun.plans[i].ID == id {
+			plan := dryRun.plans[i]
+			return &plan
+		}
+	}
+	return nil
+}
+
+// Middleware returns the middleware that records the requests that change resources instead of sending them.
+func (dryRun *DryRun) Middleware() Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if !dryRun.IsEnabled() || req.Method == http.MethodGet || req.Method == http.MethodHead {
+				return next.RoundTrip(req)
+			}
+			operation, ok := OperationForRequest(req)
+			if !ok {
+				operation = RequestOperation{Name: "Unknown", Method: req.Method}
+			}
+			plan, err := dryRun.record(req, operation)
+			if err != nil {
+				return nil, err
+			}
+			return dryRunResponse(req, plan), nil
+		})
+	}
+}
+
+// record builds the PlannedRequest of "req" and appends it to the plans.
+func (dryRun *DryRun) record(req *http.Request, operation RequestOperation) (*PlannedRequest, error) {
+	plan := PlannedRequest{
+		Operation:  operation.Name,
+		Method:     req.Method,
+		URL:        req.URL.String(),
+		PathParams: operation.PathParams,
+		Headers:    req.Header.Clone(),
+	}
+	for name := range plan.Headers {
+		if http.CanonicalHeaderKey(name) == "Authorization" {
+			plan.Headers[name] = []string{redactedValue}
+		}
+	}
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "dry-run-read-body-error")
+	}
+	if json.Valid(body) {
+		plan.Body = body
+	} else if len(body) > 0 {
+		plan.Body, _ = json.Marshal(string(body))
+	}
+
+	dryRun.mutex.Lock()
+	defer dryRun.mutex.Unlock()
+	dryRun.sequence++
+	plan.ID = "dry-run-" + strconv.Itoa(dryRun.sequence)
+	dryRun.plans = append(dryRun.plans, plan)
+	return &plan, nil
+}
+
+// dryRunResponse returns the synthetic response of "plan": a 202 response whose body is the request body, if it is a
+// JSON object, with the ID of the target resource. Results therefore echo what was requested.
+func dryRunResponse(req *http.Request, plan *PlannedRequest) *http.Response {
+	result := map[string]interface{}{}
+	if len(plan.Body) > 0 {
+		_ = json.Unmarshal(plan.Body, &result)
+		if result == nil {
+			result = map[string]interface{}{}
+		}
+	}
+	if id, ok := plan.PathParams["id"]; ok {
+		result["id"] = id
+	}
+	body, _ := json.Marshal(result)
+
+	header := http.Header{}
+	header.Set("Content-Type", "application/json")
+	header.Set(DryRunHeader, plan.ID)
+	return syntheticResponse(req, http.StatusAccepted, header, body)
+}
@@ -0,0 +1,116 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package vmwarev1_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vmware-go-sdk/vmwarev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`DryRun`, func() {
+	var (
+		testServer    *httptest.Server
+		vmwareService *vmwarev1.VmwareV1
+		received      []string
+	)
+
+	BeforeEach(func() {
+		received = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			received = append(received, req.Method+" "+req.URL.EscapedPath())
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprint(res, `{"id": "vdc1", "name": "live", "status": "ready_to_use", "cpu": 2}`)
+		}))
+		var serviceErr error
+		vmwareService, serviceErr = vmwarev1.NewVmwareV1(&vmwarev1.VmwareV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.BearerTokenAuthenticator{BearerToken: "token-secret"},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Records the requests that change resources instead of sending them`, func() {
+		dryRun := vmwareService.EnableDryRun()
+
+		vdc, _, err := vmwareService.GetVdc(vmwareService.NewGetVdcOptions("vdc1"))
+		Expect(err).To(BeNil())
+		Expect(*vdc.Name).To(Equal("live"))
+
+		patch, err := (&vmwarev1.VDCPatch{Cpu: core.Int64Ptr(4), Ram: core.Int64Ptr(64)}).AsPatch()
+		Expect(err).To(BeNil())
+		updateVdcOptions := vmwareService.NewUpdateVdcOptions("vdc1", patch)
+		updateVdcOptions.SetAcceptLanguage("en-us")
+		vdc, response, err := vmwareService.UpdateVdc(updateVdcOptions)
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(202))
+		Expect(*vdc.ID).To(Equal("vdc1"))
+		Expect(*vdc.Cpu).To(Equal(int64(4)))
+		Expect(vdc.Name).To(BeNil())
+
+		plan := dryRun.PlanOf(response)
+		Expect(plan).ToNot(BeNil())
+		Expect(plan.Operation).To(Equal("UpdateVdc"))
+		Expect(plan.Method).To(Equal(http.MethodPatch))
+		Expect(plan.URL).To(Equal(testServer.URL + "/vdcs/vdc1"))
+		Expect(plan.PathParams).To(Equal(map[string]string{"id": "vdc1"}))
+		Expect(plan.Headers.Get("Content-Type")).To(Equal("application/merge-patch+json"))
+		Expect(plan.Headers.Get("Accept-Language")).To(Equal("en-us"))
+		Expect(plan.Headers.Get("Authorization")).To(Equal("[redacted]"))
+		var body map[string]interface{}
+		Expect(json.Unmarshal(plan.Body, &body)).To(Succeed())
+		Expect(body).To(Equal(map[string]interface{}{"cpu": 4.0, "ram": 64.0}))
+
+		_, response, err = vmwareService.DeleteVdc(vmwareService.NewDeleteVdcOptions("vdc1"))
+		Expect(err).To(BeNil())
+		Expect(dryRun.PlanOf(response).Body).To(BeNil())
+
+		// Validation still runs.
+		_, _, err = vmwareService.UpdateVdc(vmwareService.NewUpdateVdcOptions("", patch))
+		Expect(err).ToNot(BeNil())
+
+		Expect(received).To(Equal([]string{"GET /vdcs/vdc1"}))
+		plans := dryRun.Plans()
+		Expect(plans).To(HaveLen(2))
+		Expect(plans[0].ID).To(Equal("dry-run-1"))
+		Expect(plans[1].Operation).To(Equal("DeleteVdc"))
+
+		dryRun.Reset()
+		Expect(dryRun.Plans()).To(BeEmpty())
+	})
+	It(`Sends the requests when it is disabled`, func() {
+		dryRun := vmwareService.EnableDryRun()
+		dryRun.SetEnabled(false)
+		Expect(dryRun.IsEnabled()).To(BeFalse())
+
+		_, response, err := vmwareService.DeleteVdc(vmwareService.NewDeleteVdcOptions("vdc1"))
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(200))
+		Expect(dryRun.PlanOf(response)).To(BeNil())
+		Expect(received).To(Equal([]string{"DELETE /vdcs/vdc1"}))
+	})
+})
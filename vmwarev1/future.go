@@ -0,0 +1,242 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vmwarev1
+
+// The Async methods send a request and wait for the resource to be ready in the background, so that many resources
+// can be provisioned in parallel. They return a Future that resolves to the resource once it is ready, or to the
+// error that stopped it. The futures of different resources can be awaited together with WaitAll and WaitAny.
+//
+// The background work stops when the context passed to the Async method is done, or when the timeout of the
+// WaitOptions elapses, and the future then resolves to the error of the context.
+
+import (
+	"context"
+	"errors"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vmware-go-sdk/common"
+)
+
+// Awaitable : The result of a background task, such as a Future.
+type Awaitable interface {
+	// Done returns a channel that is closed when the task is resolved.
+	Done() <-chan struct{}
+
+	// Err returns the error that the task resolved to, or nil.
+	Err() error
+}
+
+// Future : The result of a background task that resolves to a value of type T, or to an error.
+type Future[T any] struct {
+	done  chan struct{}
+	value T
+	err   error
+}
+
+func newFuture[T any]() *Future[T] {
+	return &Future[T]{done: make(chan struct{})}
+}
+
+// ResolvedFuture returns a future that is already resolved to "value" and "err".
+func ResolvedFuture[T any](value T, err error) *Future[T] {
+	future := newFuture[T]()
+	future.resolve(value, err)
+	return future
+}
+
+// resolve must be called exactly once.
+func (future *Future[T]) resolve(value T, err error) {
+	future.value = value
+	future.err = err
+	close(future.done)
+}
+
+// Done returns a channel that is closed when the future is resolved.
+func (future *Future[T]) Done() <-chan struct{} {
+	return future.done
+}
+
+// Get waits until the future is resolved and returns its value and error. The value may be set along with the error,
+// for instance to the VDC that failed to be created. If "ctx" is done first, Get returns its error and the future is
+// left unresolved.
+func (future *Future[T]) Get(ctx context.Context) (value T, err error) {
+	select {
+	case <-future.done:
+		return future.value, future.err
+	case <-ctx.Done():
+		err = core.SDKErrorf(ctx.Err(), "", "wait-canceled", common.GetComponentInfo())
+		return
+	}
+}
+
+// Err returns the error that the future resolved to, or nil if it succeeded or is not resolved yet.
+func (future *Future[T]) Err() error {
+	select {
+	case <-future.done:
+		return future.err
+	default:
+		return nil
+	}
+}
+
+// WaitAll waits until all the "futures" are resolved, and returns the errors they resolved to, joined. If "ctx" is
+// done first, WaitAll returns its error.
+func WaitAll(ctx context.Context, futures ...Awaitable) error {
+	var errs []error
+	for _, future := range futures {
+		select {
+		case <-future.Done():
+			if err := future.Err(); err != nil {
+				errs = append(errs, err)
+			}
+		case <-ctx.Done():
+			return core.SDKErrorf(ctx.Err(), "", "wait-canceled", common.GetComponentInfo())
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// WaitAny waits until one of the "futures" is resolved, and returns its index and the error it resolved to. If "ctx"
+// is done first, or there are no futures, WaitAny returns -1 and an error.
+func WaitAny(ctx context.Context, futures ...Awaitable) (int, error) {
+	if len(futures) == 0 {
+		return -1, core.SDKErrorf(nil, "no futures to wait for", "wait-any-empty", common.GetComponentInfo())
+	}
+
+	stop := make(chan struct{})
+	defer close(stop)
+	resolved := make(chan int, len(futures))
+	for i, future := range futures {
+		go func(i int, future Awaitable) {
+			select {
+			case <-future.Done():
+				resolved <- i
+			case <-stop:
+			}
+		}(i, future)
+	}
+
+	select {
+	case i := <-resolved:
+		return i, futures[i].Err()
+	case <-ctx.Done():
+		return -1, core.SDKErrorf(ctx.Err(), "", "wait-canceled", common.GetComponentInfo())
+	}
+}
+
+// operationFuture starts an operation and waits for it in the background, and returns a future that resolves to the
+// result of the operation.
+func operationFuture[T any](ctx context.Context, waitOptions *WaitOptions, start func(ctx context.Context) (*Operation, *core.DetailedResponse, error)) *Future[T] {
+	future := newFuture[T]()
+	go func() {
+		var value T
+		operation, _, err := start(ctx)
+		if err != nil {
+			future.resolve(value, err)
+			return
+		}
+		operation.WaitOptions = waitOptions
+		err = operation.Wait(ctx)
+		if result, ok := operation.Result().(T); ok {
+			value = result
+		}
+		future.resolve(value, err)
+	}()
+	return future
+}
+
+// CreateDirectorSitesAsync : Create a Cloud Director site in the background
+// Send a CreateDirectorSites request and return a future that resolves to the Cloud Director site once it is ready to
+// use.
+func (vmware *VmwareV1) CreateDirectorSitesAsync(ctx context.Context, createDirectorSitesOptions *CreateDirectorSitesOptions, waitOptions *WaitOptions) *Future[*DirectorSite] {
+	return operationFuture[*DirectorSite](ctx, waitOptions, func(ctx context.Context) (*Operation, *core.DetailedResponse, error) {
+		return vmware.StartCreateDirectorSitesWithContext(ctx, createDirectorSitesOptions)
+	})
+}
+
+// DeleteDirectorSiteAsync : Delete a Cloud Director site in the background
+// Send a DeleteDirectorSite request and return a future that resolves to the Cloud Director site as it was last
+// observed once it is deleted.
+func (vmware *VmwareV1) DeleteDirectorSiteAsync(ctx context.Context, deleteDirectorSiteOptions *DeleteDirectorSiteOptions, waitOptions *WaitOptions) *Future[*DirectorSite] {
+	return operationFuture[*DirectorSite](ctx, waitOptions, func(ctx context.Context) (*Operation, *core.DetailedResponse, error) {
+		return vmware.StartDeleteDirectorSiteWithContext(ctx, deleteDirectorSiteOptions)
+	})
+}
+
+// CreateDirectorSitesPvdcsClustersAsync : Create a cluster in the background
+// Send a CreateDirectorSitesPvdcsClusters request and return a future that resolves to the cluster once it is ready to
+// use.
+func (vmware *VmwareV1) CreateDirectorSitesPvdcsClustersAsync(ctx context.Context, createDirectorSitesPvdcsClustersOptions *CreateDirectorSitesPvdcsClustersOptions, waitOptions *WaitOptions) *Future[*Cluster] {
+	return operationFuture[*Cluster](ctx, waitOptions, func(ctx context.Context) (*Operation, *core.DetailedResponse, error) {
+		return vmware.StartCreateDirectorSitesPvdcsClustersWithContext(ctx, createDirectorSitesPvdcsClustersOptions)
+	})
+}
+
+// UpdateDirectorSitesPvdcsClusterAsync : Update a cluster in the background
+// Send an UpdateDirectorSitesPvdcsCluster request and return a future that resolves to the cluster once it is ready to
+// use again.
+func (vmware *VmwareV1) UpdateDirectorSitesPvdcsClusterAsync(ctx context.Context, updateDirectorSitesPvdcsClusterOptions *UpdateDirectorSitesPvdcsClusterOptions, waitOptions *WaitOptions) *Future[*Cluster] {
+	return operationFuture[*Cluster](ctx, waitOptions, func(ctx context.Context) (*Operation, *core.DetailedResponse, error) {
+		return vmware.StartUpdateDirectorSitesPvdcsClusterWithContext(ctx, updateDirectorSitesPvdcsClusterOptions)
+	})
+}
+
+// CreateVdcAsync : Create a virtual data center in the background
+// Send a CreateVdc request and return a future that resolves to the virtual data center once it is ready to use. If
+// the creation fails, the future resolves to the failed virtual data center along with the error.
+func (vmware *VmwareV1) CreateVdcAsync(ctx context.Context, createVdcOptions *CreateVdcOptions, waitOptions *WaitOptions) *Future[*VDC] {
+	return operationFuture[*VDC](ctx, waitOptions, func(ctx context.Context) (*Operation, *core.DetailedResponse, error) {
+		return vmware.StartCreateVdcWithContext(ctx, createVdcOptions)
+	})
+}
+
+// UpdateVdcAsync : Update a virtual data center in the background
+// Send an UpdateVdc request and return a future that resolves to the virtual data center once it is ready to use
+// again.
+func (vmware *VmwareV1) UpdateVdcAsync(ctx context.Context, updateVdcOptions *UpdateVdcOptions, waitOptions *WaitOptions) *Future[*VDC] {
+	return operationFuture[*VDC](ctx, waitOptions, func(ctx context.Context) (*Operation, *core.DetailedResponse, error) {
+		return vmware.StartUpdateVdcWithContext(ctx, updateVdcOptions)
+	})
+}
+
+// DeleteVdcAsync : Delete a virtual data center in the background
+// Send a DeleteVdc request and return a future that resolves to the virtual data center as it was last observed once
+// it is deleted.
+func (vmware *VmwareV1) DeleteVdcAsync(ctx context.Context, deleteVdcOptions *DeleteVdcOptions, waitOptions *WaitOptions) *Future[*VDC] {
+	return operationFuture[*VDC](ctx, waitOptions, func(ctx context.Context) (*Operation, *core.DetailedResponse, error) {
+		return vmware.StartDeleteVdcWithContext(ctx, deleteVdcOptions)
+	})
+}
+
+// EnableVcdaOnDataCenterAsync : Enable or disable VCDA on a Cloud Director site in the background
+// Send an EnableVcdaOnDataCenter request and return a future that resolves to the VCDA service instance once it is
+// ready to use. When VCDA is disabled, the future resolves once the service instance is deleted, to the service
+// instance as it was last observed.
+func (vmware *VmwareV1) EnableVcdaOnDataCenterAsync(ctx context.Context, enableVcdaOnDataCenterOptions *EnableVcdaOnDataCenterOptions, waitOptions *WaitOptions) *Future[*Service] {
+	return operationFuture[*Service](ctx, waitOptions, func(ctx context.Context) (*Operation, *core.DetailedResponse, error) {
+		return vmware.StartEnableVcdaOnDataCenterWithContext(ctx, enableVcdaOnDataCenterOptions)
+	})
+}
+
+// AddTransitGatewayConnectionsAsync : Connect a transit gateway to an edge in the background
+// Send an AddTransitGatewayConnections request and return a future that resolves to the transit gateway once its
+// connections are no longer creating.
+func (vmware *VmwareV1) AddTransitGatewayConnectionsAsync(ctx context.Context, addTransitGatewayConnectionsOptions *AddTransitGatewayConnectionsOptions, waitOptions *WaitOptions) *Future[*TransitGateway] {
+	return operationFuture[*TransitGateway](ctx, waitOptions, func(ctx context.Context) (*Operation, *core.DetailedResponse, error) {
+		return vmware.StartAddTransitGatewayConnectionsWithContext(ctx, addTransitGatewayConnectionsOptions)
+	})
+}
@@ -0,0 +1,192 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package vmwarev1_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vmware-go-sdk/vmwarev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Futures`, func() {
+	var (
+		testServer    *httptest.Server
+		vmwareService *vmwarev1.VmwareV1
+		waitOptions   *vmwarev1.WaitOptions
+
+		mutex sync.Mutex
+		// The status that each VDC, named after its ID, reports once it is created.
+		outcomes map[string]string
+	)
+
+	vdcJSON := func(id string, status string) string {
+		reasons := ""
+		if status == "failed" {
+			reasons = `{"code": "insufficent_ram", "message": "Not enough RAM."}`
+		}
+		return fmt.Sprintf(`{"href": "Href", "id": "%s", "crn": "Crn", "director_site": {"id": "site1", "pvdc": {"id": "pvdc1"}, "url": "URL"}, "edges": [], "status_reasons": [%s], "name": "%s", "ordered_at": "2019-01-01T12:00:00.000Z", "org_href": "OrgHref", "org_name": "OrgName", "status": "%s", "type": "single_tenant", "fast_provisioning_enabled": false, "rhel_byol": false, "windows_byol": false}`, id, reasons, id, status)
+	}
+
+	createVdcOptions := func(name string) *vmwarev1.CreateVdcOptions {
+		directorSite := &vmwarev1.VDCDirectorSitePrototype{ID: core.StringPtr("site1"), Pvdc: &vmwarev1.DirectorSitePVDC{ID: core.StringPtr("pvdc1")}}
+		return vmwareService.NewCreateVdcOptions(name, directorSite)
+	}
+
+	BeforeEach(func() {
+		outcomes = map[string]string{}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			mutex.Lock()
+			defer mutex.Unlock()
+			res.Header().Set("Content-type", "application/json")
+			switch {
+			case req.Method == http.MethodPost && req.URL.Path == "/vdcs":
+				var body map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				name := body["name"].(string)
+				if _, ok := outcomes[name]; !ok {
+					res.WriteHeader(400)
+					fmt.Fprint(res, `{"errors": [{"code": "bad_request", "message": "Unknown VDC."}]}`)
+					return
+				}
+				res.WriteHeader(202)
+				fmt.Fprint(res, vdcJSON(name, "creating"))
+			case req.Method == http.MethodGet && strings.HasPrefix(req.URL.Path, "/vdcs/"):
+				id := strings.TrimPrefix(req.URL.Path, "/vdcs/")
+				res.WriteHeader(200)
+				fmt.Fprint(res, vdcJSON(id, outcomes[id]))
+			case req.Method == http.MethodPost && req.URL.Path == "/director_sites/site1/pvdcs/pvdc1/clusters":
+				res.WriteHeader(201)
+				fmt.Fprint(res, `{"id": "cluster1", "name": "cluster1", "host_count": 2, "host_profile": "BM_2S_20_CORES_192_GB", "data_center_name": "dal10", "status": "creating", "storage_type": "nfs", "billing_plan": "monthly", "file_shares": {}}`)
+			case req.Method == http.MethodGet && req.URL.Path == "/director_sites/site1/pvdcs/pvdc1/clusters/cluster1":
+				res.WriteHeader(200)
+				fmt.Fprint(res, `{"id": "cluster1", "name": "cluster1", "host_count": 2, "host_profile": "BM_2S_20_CORES_192_GB", "data_center_name": "dal10", "status": "ready_to_use", "storage_type": "nfs", "billing_plan": "monthly", "file_shares": {}}`)
+			default:
+				Fail(fmt.Sprintf("unexpected request %s %s", req.Method, req.URL.Path))
+			}
+		}))
+		var serviceErr error
+		vmwareService, serviceErr = vmwarev1.NewVmwareV1(&vmwarev1.VmwareV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		waitOptions = vmwareService.NewWaitOptions(time.Millisecond, 5*time.Second)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Provisions resources in parallel and fans them back in`, func() {
+		outcomes["vdc1"] = "ready_to_use"
+		outcomes["vdc2"] = "ready_to_use"
+		ctx := context.Background()
+
+		vdc1 := vmwareService.CreateVdcAsync(ctx, createVdcOptions("vdc1"), waitOptions)
+		vdc2 := vmwareService.CreateVdcAsync(ctx, createVdcOptions("vdc2"), waitOptions)
+		clusterOptions := vmwareService.NewCreateDirectorSitesPvdcsClustersOptions("site1", "pvdc1", "cluster1", 2, "BM_2S_20_CORES_192_GB",
+			&vmwarev1.FileSharesPrototype{STORAGETWOIOPSGB: core.Int64Ptr(24000)})
+		cluster := vmwareService.CreateDirectorSitesPvdcsClustersAsync(ctx, clusterOptions, waitOptions)
+		Expect(vmwarev1.WaitAll(ctx, vdc1, vdc2, cluster)).To(Succeed())
+
+		vdc, err := vdc1.Get(ctx)
+		Expect(err).To(BeNil())
+		Expect(*vdc.ID).To(Equal("vdc1"))
+		Expect(*vdc.Status).To(Equal("ready_to_use"))
+		vdc, err = vdc2.Get(ctx)
+		Expect(err).To(BeNil())
+		Expect(*vdc.ID).To(Equal("vdc2"))
+		readyCluster, err := cluster.Get(ctx)
+		Expect(err).To(BeNil())
+		Expect(*readyCluster.Status).To(Equal("ready_to_use"))
+	})
+
+	It(`Resolves to the failed resource and its error`, func() {
+		outcomes["vdc1"] = "ready_to_use"
+		outcomes["vdc2"] = "failed"
+		ctx := context.Background()
+
+		vdc1 := vmwareService.CreateVdcAsync(ctx, createVdcOptions("vdc1"), waitOptions)
+		vdc2 := vmwareService.CreateVdcAsync(ctx, createVdcOptions("vdc2"), waitOptions)
+		// The request of vdc3 is rejected.
+		vdc3 := vmwareService.CreateVdcAsync(ctx, createVdcOptions("vdc3"), waitOptions)
+
+		err := vmwarev1.WaitAll(ctx, vdc1, vdc2, vdc3)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("Not enough RAM."))
+		Expect(err.Error()).To(ContainSubstring("Unknown VDC."))
+
+		vdc, err := vdc2.Get(ctx)
+		Expect(err).To(Equal(vdc2.Err()))
+		Expect(*vdc.StatusReasons[0].Code).To(Equal("insufficent_ram"))
+		vdc, err = vdc3.Get(ctx)
+		Expect(err).ToNot(BeNil())
+		Expect(vdc).To(BeNil())
+		Expect(vdc1.Err()).To(BeNil())
+	})
+
+	It(`Returns the first future to resolve`, func() {
+		outcomes["vdc1"] = "creating"
+		outcomes["vdc2"] = "ready_to_use"
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		vdc1 := vmwareService.CreateVdcAsync(ctx, createVdcOptions("vdc1"), waitOptions)
+		vdc2 := vmwareService.CreateVdcAsync(ctx, createVdcOptions("vdc2"), waitOptions)
+		i, err := vmwarev1.WaitAny(ctx, vdc1, vdc2)
+		Expect(err).To(BeNil())
+		Expect(i).To(Equal(1))
+
+		_, err = vmwarev1.WaitAny(ctx)
+		Expect(err).ToNot(BeNil())
+		i, _ = vmwarev1.WaitAny(ctx, vdc2, vmwarev1.ResolvedFuture(0, errors.New("failed")))
+		Expect(i).To(BeNumerically(">=", 0))
+	})
+
+	It(`Honors the cancellation of the context`, func() {
+		outcomes["vdc1"] = "creating"
+		ctx, cancel := context.WithCancel(context.Background())
+		vdc1 := vmwareService.CreateVdcAsync(ctx, createVdcOptions("vdc1"), waitOptions)
+
+		// A caller that stops waiting leaves the future unresolved.
+		shortCtx, shortCancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer shortCancel()
+		_, err := vdc1.Get(shortCtx)
+		Expect(err).ToNot(BeNil())
+		Expect(vmwarev1.WaitAll(shortCtx, vdc1)).ToNot(Succeed())
+		_, err = vmwarev1.WaitAny(shortCtx, vdc1)
+		Expect(err).ToNot(BeNil())
+		Expect(vdc1.Err()).To(BeNil())
+
+		// Canceling the context of the Async method stops the background work.
+		cancel()
+		Eventually(vdc1.Done()).Should(BeClosed())
+		vdc, err := vdc1.Get(context.Background())
+		Expect(err).ToNot(BeNil())
+		Expect(*vdc.Status).To(Equal("creating"))
+	})
+})
@@ -0,0 +1,287 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vmwarev1
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vmware-go-sdk/common"
+)
+
+// CollectInventory : Collect the resources of the account
+// Collect the Cloud Director sites, virtual data centers (VDCs) and catalog (regions, multitenant sites and host
+// profiles) of the account in a single Inventory that can be saved and reloaded for offline reporting.
+func (vmware *VmwareV1) CollectInventory(collectInventoryOptions *CollectInventoryOptions) (result *Inventory, err error) {
+	result, err = vmware.CollectInventoryWithContext(context.Background(), collectInventoryOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CollectInventoryWithContext is an alternate form of the CollectInventory method which supports a Context parameter
+func (vmware *VmwareV1) CollectInventoryWithContext(ctx context.Context, collectInventoryOptions *CollectInventoryOptions) (result *Inventory, err error) {
+	if collectInventoryOptions == nil {
+		collectInventoryOptions = vmware.NewCollectInventoryOptions()
+	}
+	acceptLanguage := collectInventoryOptions.AcceptLanguage
+	transactionID := collectInventoryOptions.XGlobalTransactionID
+	headers := collectInventoryOptions.Headers
+
+	inventory := &Inventory{
+		CollectedAt: time.Now().UTC(),
+	}
+
+	listDirectorSitesOptions := vmware.NewListDirectorSitesOptions()
+	listDirectorSitesOptions.AcceptLanguage = acceptLanguage
+	listDirectorSitesOptions.XGlobalTransactionID = transactionID
+	listDirectorSitesOptions.SetHeaders(headers)
+	directorSites, _, err := vmware.ListDirectorSitesWithContext(ctx, listDirectorSitesOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-director-sites-error")
+		return
+	}
+	inventory.DirectorSites = directorSites.DirectorSites
+	for i := range inventory.DirectorSites {
+		inventory.DirectorSites[i].RhelVmActivationKey = nil
+	}
+
+	listVdcsOptions := vmware.NewListVdcsOptions()
+	listVdcsOptions.AcceptLanguage = acceptLanguage
+	listVdcsOptions.SetHeaders(headers)
+	vdcs, _, err := vmware.ListVdcsWithContext(ctx, listVdcsOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-vdcs-error")
+		return
+	}
+	inventory.Vdcs = vdcs.Vdcs
+
+	if !collectInventoryOptions.SkipCatalog {
+		listRegionsOptions := vmware.NewListDirectorSiteRegionsOptions()
+		listRegionsOptions.AcceptLanguage = acceptLanguage
+		listRegionsOptions.XGlobalTransactionID = transactionID
+		listRegionsOptions.SetHeaders(headers)
+		regions, _, regionsErr := vmware.ListDirectorSiteRegionsWithContext(ctx, listRegionsOptions)
+		if regionsErr != nil {
+			err = core.RepurposeSDKProblem(regionsErr, "list-regions-error")
+			return
+		}
+		inventory.DirectorSiteRegions = regions.DirectorSiteRegions
+
+		listMultitenantOptions := vmware.NewListMultitenantDirectorSitesOptions()
+		listMultitenantOptions.AcceptLanguage = acceptLanguage
+		listMultitenantOptions.XGlobalTransactionID = transactionID
+		listMultitenantOptions.SetHeaders(headers)
+		multitenantSites, _, multitenantErr := vmware.ListMultitenantDirectorSitesWithContext(ctx, listMultitenantOptions)
+		if multitenantErr != nil {
+			err = core.RepurposeSDKProblem(multitenantErr, "list-multitenant-sites-error")
+			return
+		}
+		inventory.MultitenantDirectorSites = multitenantSites.MultitenantDirectorSites
+
+		listHostProfilesOptions := vmware.NewListDirectorSiteHostProfilesOptions()
+		listHostProfilesOptions.AcceptLanguage = acceptLanguage
+		listHostProfilesOptions.XGlobalTransactionID = transactionID
+		listHostProfilesOptions.SetHeaders(headers)
+		hostProfiles, _, hostProfilesErr := vmware.ListDirectorSiteHostProfilesWithContext(ctx, listHostProfilesOptions)
+		if hostProfilesErr != nil {
+			err = core.RepurposeSDKProblem(hostProfilesErr, "list-host-profiles-error")
+			return
+		}
+		inventory.DirectorSiteHostProfiles = hostProfiles.DirectorSiteHostProfiles
+	}
+
+	result = inventory
+	return
+}
+
+// CollectInventoryOptions : The CollectInventory options.
+type CollectInventoryOptions struct {
+	// Do not collect the regions, multitenant Cloud Director sites and host profiles.
+	SkipCatalog bool
+
+	// Language.
+	AcceptLanguage *string `json:"Accept-Language,omitempty"`
+
+	// Transaction ID.
+	XGlobalTransactionID *string `json:"X-Global-Transaction-ID,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewCollectInventoryOptions : Instantiate CollectInventoryOptions
+func (*VmwareV1) NewCollectInventoryOptions() *CollectInventoryOptions {
+	return &CollectInventoryOptions{}
+}
+
+// SetSkipCatalog : Allow user to set SkipCatalog
+func (_options *CollectInventoryOptions) SetSkipCatalog(skipCatalog bool) *CollectInventoryOptions {
+	_options.SkipCatalog = skipCatalog
+	return _options
+}
+
+// SetAcceptLanguage : Allow user to set AcceptLanguage
+func (_options *CollectInventoryOptions) SetAcceptLanguage(acceptLanguage string) *CollectInventoryOptions {
+	_options.AcceptLanguage = core.StringPtr(acceptLanguage)
+	return _options
+}
+
+// SetXGlobalTransactionID : Allow user to set XGlobalTransactionID
+func (_options *CollectInventoryOptions) SetXGlobalTransactionID(xGlobalTransactionID string) *CollectInventoryOptions {
+	_options.XGlobalTransactionID = core.StringPtr(xGlobalTransactionID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *CollectInventoryOptions) SetHeaders(param map[string]string) *CollectInventoryOptions {
+	options.Headers = param
+	return options
+}
+
+// Inventory : A snapshot of the resources of an account. The RHEL VM activation keys of the Cloud Director sites are
+// not kept, so that inventories can be shared.
+type Inventory struct {
+	// When the inventory was collected.
+	CollectedAt time.Time `json:"collected_at"`
+
+	// The Cloud Director sites.
+	DirectorSites []DirectorSite `json:"director_sites"`
+
+	// The virtual data centers (VDCs).
+	Vdcs []VDC `json:"vdcs"`
+
+	// The regions and their data centers.
+	DirectorSiteRegions []DirectorSiteRegion `json:"director_site_regions,omitempty"`
+
+	// The multitenant Cloud Director sites.
+	MultitenantDirectorSites []MultitenantDirectorSite `json:"multitenant_director_sites,omitempty"`
+
+	// The host profiles.
+	DirectorSiteHostProfiles []DirectorSiteHostProfile `json:"director_site_host_profiles,omitempty"`
+
+	// The VCDA cloud-to-cloud connections. The API does not list them, so they are added with AddC2cConnection.
+	C2cConnections []InventoryC2cConnection `json:"c2c_connections,omitempty"`
+}
+
+// InventoryC2cConnection : A VCDA cloud-to-cloud connection and the Cloud Director site it belongs to.
+type InventoryC2cConnection struct {
+	// The ID of the Cloud Director site.
+	DirectorSiteID string `json:"director_site_id"`
+
+	// The connection.
+	Connection VcdaC2c `json:"connection"`
+}
+
+// AddC2cConnection adds a VCDA cloud-to-cloud connection of the Cloud Director site "directorSiteID", such as one
+// returned by CreateDirectorSitesVcdaC2cConnection.
+func (inventory *Inventory) AddC2cConnection(directorSiteID string, connection VcdaC2c) {
+	inventory.C2cConnections = append(inventory.C2cConnections, InventoryC2cConnection{
+		DirectorSiteID: directorSiteID,
+		Connection:     connection,
+	})
+}
+
+// GetDirectorSite returns the Cloud Director site identified by "id", or nil.
+func (inventory *Inventory) GetDirectorSite(id string) *DirectorSite {
+	for i := range inventory.DirectorSites {
+		if stringValue(inventory.DirectorSites[i].ID) == id {
+			return &inventory.DirectorSites[i]
+		}
+	}
+	return nil
+}
+
+// GetVdc returns the virtual data center identified by "id", or nil.
+func (inventory *Inventory) GetVdc(id string) *VDC {
+	for i := range inventory.Vdcs {
+		if stringValue(inventory.Vdcs[i].ID) == id {
+			return &inventory.Vdcs[i]
+		}
+	}
+	return nil
+}
+
+// GetHostProfile returns the host profile identified by "id", or nil.
+func (inventory *Inventory) GetHostProfile(id string) *DirectorSiteHostProfile {
+	for i := range inventory.DirectorSiteHostProfiles {
+		if stringValue(inventory.DirectorSiteHostProfiles[i].ID) == id {
+			return &inventory.DirectorSiteHostProfiles[i]
+		}
+	}
+	return nil
+}
+
+// VdcsOfDirectorSite returns the virtual data centers created in the Cloud Director site identified by "id".
+func (inventory *Inventory) VdcsOfDirectorSite(id string) []VDC {
+	vdcs := []VDC{}
+	for _, vdc := range inventory.Vdcs {
+		if vdc.DirectorSite != nil && stringValue(vdc.DirectorSite.ID) == id {
+			vdcs = append(vdcs, vdc)
+		}
+	}
+	return vdcs
+}
+
+// Write writes the inventory as indented JSON.
+func (inventory *Inventory) Write(writer io.Writer) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	err := encoder.Encode(inventory)
+	if err != nil {
+		return core.SDKErrorf(err, "", "inventory-encode-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// WriteFile writes the inventory as indented JSON to the file at "path".
+func (inventory *Inventory) WriteFile(path string) (err error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return core.SDKErrorf(err, "", "inventory-write-error", common.GetComponentInfo())
+	}
+	defer func() {
+		closeErr := file.Close()
+		if err == nil && closeErr != nil {
+			err = core.SDKErrorf(closeErr, "", "inventory-write-error", common.GetComponentInfo())
+		}
+	}()
+	return inventory.Write(file)
+}
+
+// ReadInventory reads an inventory written by Inventory.Write.
+func ReadInventory(reader io.Reader) (*Inventory, error) {
+	inventory := &Inventory{}
+	err := json.NewDecoder(reader).Decode(inventory)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "inventory-decode-error", common.GetComponentInfo())
+	}
+	return inventory, nil
+}
+
+// ReadInventoryFile reads the inventory in the file at "path".
+func ReadInventoryFile(path string) (*Inventory, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "inventory-read-error", common.GetComponentInfo())
+	}
+	defer file.Close()
+	return ReadInventory(file)
+}
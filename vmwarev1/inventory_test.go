@@ -0,0 +1,113 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vmwarev1_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vmware-go-sdk/vmwarev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Inventory`, func() {
+	var (
+		testServer *httptest.Server
+		requests   []string
+	)
+
+	BeforeEach(func() {
+		requests = []string{}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			requests = append(requests, req.URL.EscapedPath())
+			Expect(req.Header["Accept-Language"][0]).To(Equal("en-us"))
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			switch req.URL.EscapedPath() {
+			case "/director_sites":
+				site := directorSiteJSON("site1", "")
+				fmt.Fprintf(res, `{"director_sites": [%s]}`, site[:len(site)-1]+`, "rhel_vm_activation_key": "rhel-secret"}`)
+			case "/vdcs":
+				fmt.Fprint(res, `{"vdcs": [{"id": "vdc1", "name": "vdc", "director_site": {"id": "site1", "pvdc": {"id": "pvdc1"}, "url": "URL"}, "edges": []}, {"id": "vdc2", "director_site": {"id": "site2", "pvdc": {"id": "pvdc2"}, "url": "URL"}, "edges": []}]}`)
+			case "/director_site_regions":
+				fmt.Fprint(res, `{"director_site_regions": [{"name": "us-south", "endpoint": "Endpoint", "data_centers": [{"display_name": "Dallas 10", "name": "dal10", "uplink_speed": "1g"}]}]}`)
+			case "/multitenant_director_sites":
+				fmt.Fprint(res, `{"multitenant_director_sites": []}`)
+			case "/director_site_host_profiles":
+				fmt.Fprint(res, `{"director_site_host_profiles": [{"id": "BM_2S_20_CORES_192_GB", "cpu": 40, "ram": 192, "socket": 2, "family": "Family", "processor": "Processor", "speed": "Speed", "manufacturer": "Manufacturer", "features": []}]}`)
+			default:
+				Fail("unexpected request " + req.URL.EscapedPath())
+			}
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Collects the resources of the account and round-trips them through JSON`, func() {
+		vmwareService, serviceErr := vmwarev1.NewVmwareV1(&vmwarev1.VmwareV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+
+		inventory, err := vmwareService.CollectInventory(vmwareService.NewCollectInventoryOptions().SetAcceptLanguage("en-us"))
+		Expect(err).To(BeNil())
+		Expect(requests).To(HaveLen(5))
+		Expect(inventory.DirectorSites).To(HaveLen(1))
+		Expect(inventory.DirectorSites[0].RhelVmActivationKey).To(BeNil())
+		Expect(inventory.GetDirectorSite("site1")).ToNot(BeNil())
+		Expect(inventory.GetDirectorSite("site2")).To(BeNil())
+		Expect(*inventory.GetVdc("vdc2").ID).To(Equal("vdc2"))
+		Expect(inventory.VdcsOfDirectorSite("site1")).To(HaveLen(1))
+		Expect(*inventory.GetHostProfile("BM_2S_20_CORES_192_GB").Cpu).To(Equal(int64(40)))
+		Expect(inventory.DirectorSiteRegions[0].DataCenters).To(HaveLen(1))
+
+		inventory.AddC2cConnection("site1", vmwarev1.VcdaC2c{ID: core.StringPtr("c2c1"), PeerSiteName: core.StringPtr("peer")})
+
+		var buffer bytes.Buffer
+		Expect(inventory.Write(&buffer)).To(Succeed())
+		Expect(buffer.String()).ToNot(ContainSubstring("rhel-secret"))
+		loaded, err := vmwarev1.ReadInventory(&buffer)
+		Expect(err).To(BeNil())
+		Expect(loaded.CollectedAt.Equal(inventory.CollectedAt)).To(BeTrue())
+		Expect(loaded.Vdcs).To(Equal(inventory.Vdcs))
+		Expect(loaded.C2cConnections).To(Equal(inventory.C2cConnections))
+
+		_, err = vmwarev1.ReadInventory(bytes.NewBufferString("{"))
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Skips the catalog on request`, func() {
+		vmwareService, serviceErr := vmwarev1.NewVmwareV1(&vmwarev1.VmwareV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+
+		collectInventoryOptions := vmwareService.NewCollectInventoryOptions().SetAcceptLanguage("en-us").SetSkipCatalog(true)
+		inventory, err := vmwareService.CollectInventory(collectInventoryOptions)
+		Expect(err).To(BeNil())
+		Expect(requests).To(Equal([]string{"/director_sites", "/vdcs"}))
+		Expect(inventory.DirectorSiteHostProfiles).To(BeNil())
+	})
+})
@@ -0,0 +1,507 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vmwarev1
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vmware-go-sdk/common"
+)
+
+// ListLicenseInventory : List license keys by product and version
+// Retrieve the VMware licenses and group their keys by product (the name of the key) and version.
+func (vmware *VmwareV1) ListLicenseInventory(listLicenseInventoryOptions *ListLicenseInventoryOptions) (result *LicenseInventory, err error) {
+	result, err = vmware.ListLicenseInventoryWithContext(context.Background(), listLicenseInventoryOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ListLicenseInventoryWithContext is an alternate form of the ListLicenseInventory method which supports a Context parameter
+func (vmware *VmwareV1) ListLicenseInventoryWithContext(ctx context.Context, listLicenseInventoryOptions *ListLicenseInventoryOptions) (result *LicenseInventory, err error) {
+	err = core.ValidateStruct(listLicenseInventoryOptions, "listLicenseInventoryOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	listLicensesOptions := vmware.NewListLicensesOptions()
+	if listLicenseInventoryOptions != nil {
+		// ListLicenses has no Accept-Language and X-Global-Transaction-ID parameters, so they are sent as headers.
+		headers := map[string]string{}
+		for name, value := range listLicenseInventoryOptions.Headers {
+			headers[name] = value
+		}
+		if listLicenseInventoryOptions.AcceptLanguage != nil {
+			headers["Accept-Language"] = *listLicenseInventoryOptions.AcceptLanguage
+		}
+		if listLicenseInventoryOptions.XGlobalTransactionID != nil {
+			headers["X-Global-Transaction-ID"] = *listLicenseInventoryOptions.XGlobalTransactionID
+		}
+		listLicensesOptions.SetHeaders(headers)
+	}
+	licenses, _, err := vmware.ListLicensesWithContext(ctx, listLicensesOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-licenses-error")
+		return
+	}
+	result = NewLicenseInventory(licenses)
+	return
+}
+
+// ListLicenseInventoryOptions : The ListLicenseInventory options.
+type ListLicenseInventoryOptions struct {
+	// Language.
+	AcceptLanguage *string `json:"Accept-Language,omitempty"`
+
+	// Transaction ID.
+	XGlobalTransactionID *string `json:"X-Global-Transaction-ID,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewListLicenseInventoryOptions : Instantiate ListLicenseInventoryOptions
+func (*VmwareV1) NewListLicenseInventoryOptions() *ListLicenseInventoryOptions {
+	return &ListLicenseInventoryOptions{}
+}
+
+// SetAcceptLanguage : Allow user to set AcceptLanguage
+func (_options *ListLicenseInventoryOptions) SetAcceptLanguage(acceptLanguage string) *ListLicenseInventoryOptions {
+	_options.AcceptLanguage = core.StringPtr(acceptLanguage)
+	return _options
+}
+
+// SetXGlobalTransactionID : Allow user to set XGlobalTransactionID
+func (_options *ListLicenseInventoryOptions) SetXGlobalTransactionID(xGlobalTransactionID string) *ListLicenseInventoryOptions {
+	_options.XGlobalTransactionID = core.StringPtr(xGlobalTransactionID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *ListLicenseInventoryOptions) SetHeaders(param map[string]string) *ListLicenseInventoryOptions {
+	options.Headers = param
+	return options
+}
+
+// LicenseInventory : The license keys of an account, one entry per key.
+type LicenseInventory struct {
+	// When the licenses were listed.
+	CollectedAt time.Time `json:"collected_at"`
+
+	// The license keys, sorted by product, version and fingerprint.
+	Licenses []LicenseEntry `json:"licenses"`
+}
+
+// LicenseEntry : A license key with the product and version it licenses.
+type LicenseEntry struct {
+	// Name of the software associated to the license key.
+	Product string `json:"product"`
+
+	// Version of the VMware software.
+	Version string `json:"version"`
+
+	// License key value. It is masked in inventories loaded from an export that did not reveal the values.
+	Value string `json:"value,omitempty"`
+
+	// A digest of the value that identifies the key without revealing it.
+	Fingerprint string `json:"fingerprint"`
+}
+
+// licenseEntryJSON has the fields of LicenseEntry but none of its methods, so that ExportJSON can write the values
+// it reveals.
+type licenseEntryJSON LicenseEntry
+
+// MarshalJSON writes the entry with its value masked by MaskLicenseValue, unless redaction is disabled. ExportJSON
+// writes the values in clear text when asked to.
+func (entry LicenseEntry) MarshalJSON() ([]byte, error) {
+	if IsRedactionEnabled() {
+		entry.Value = MaskLicenseValue(entry.Value)
+	}
+	return json.Marshal(licenseEntryJSON(entry))
+}
+
+// String returns the entry as JSON with its value masked.
+func (entry LicenseEntry) String() string {
+	return redactedString(entry, "LicenseEntry{}")
+}
+
+// GoString returns the entry as JSON with its value masked.
+func (entry LicenseEntry) GoString() string {
+	return "vmwarev1.LicenseEntry" + entry.String()
+}
+
+// NewLicenseInventory builds the inventory of the license keys in "licenses".
+func NewLicenseInventory(licenses *LicenseCollection) *LicenseInventory {
+	inventory := &LicenseInventory{
+		CollectedAt: time.Now().UTC(),
+		Licenses:    []LicenseEntry{},
+	}
+	if licenses == nil {
+		return inventory
+	}
+	for _, license := range licenses.Licenses {
+		for _, licenseKey := range license.LicenseKeys {
+			value := stringValue(licenseKey.Value)
+			inventory.Licenses = append(inventory.Licenses, LicenseEntry{
+				Product:     stringValue(licenseKey.Name),
+				Version:     stringValue(license.Version),
+				Value:       value,
+				Fingerprint: LicenseFingerprint(value),
+			})
+		}
+	}
+	inventory.sort()
+	return inventory
+}
+
+// LoadLicenseInventory reads an inventory written by ExportJSON, so that it can be compared with a newer one.
+func LoadLicenseInventory(reader io.Reader) (*LicenseInventory, error) {
+	inventory := &LicenseInventory{}
+	err := json.NewDecoder(reader).Decode(inventory)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "license-inventory-decode-error", common.GetComponentInfo())
+	}
+	inventory.sort()
+	return inventory, nil
+}
+
+func (inventory *LicenseInventory) sort() {
+	sort.SliceStable(inventory.Licenses, func(i, j int) bool {
+		a, b := inventory.Licenses[i], inventory.Licenses[j]
+		if a.Product != b.Product {
+			return a.Product < b.Product
+		}
+		if a.Version != b.Version {
+			return a.Version < b.Version
+		}
+		return a.Fingerprint < b.Fingerprint
+	})
+}
+
+// Products returns the names of the licensed products, sorted.
+func (inventory *LicenseInventory) Products() []string {
+	products := []string{}
+	for _, entry := range inventory.Licenses {
+		if len(products) == 0 || products[len(products)-1] != entry.Product {
+			products = append(products, entry.Product)
+		}
+	}
+	return products
+}
+
+// ByProduct groups the license keys by product and then by version.
+func (inventory *LicenseInventory) ByProduct() map[string]map[string][]LicenseEntry {
+	grouped := map[string]map[string][]LicenseEntry{}
+	for _, entry := range inventory.Licenses {
+		if grouped[entry.Product] == nil {
+			grouped[entry.Product] = map[string][]LicenseEntry{}
+		}
+		grouped[entry.Product][entry.Version] = append(grouped[entry.Product][entry.Version], entry)
+	}
+	return grouped
+}
+
+// Versions returns the licensed versions of "product", sorted. Product names are compared case-insensitively.
+func (inventory *LicenseInventory) Versions(product string) []string {
+	versions := []string{}
+	for _, entry := range inventory.Licenses {
+		if strings.EqualFold(entry.Product, product) && !containsString(versions, entry.Version) {
+			versions = append(versions, entry.Version)
+		}
+	}
+	sort.Strings(versions)
+	return versions
+}
+
+// LicenseChange : A difference between two license inventories.
+type LicenseChange struct {
+	// The product whose license keys changed.
+	Product string `json:"product"`
+
+	// The type of change.
+	Type string `json:"type"`
+
+	// The licensed versions of the product in the previous inventory.
+	PreviousVersions []string `json:"previous_versions"`
+
+	// The licensed versions of the product in the current inventory.
+	Versions []string `json:"versions"`
+}
+
+// Constants associated with the LicenseChange.Type property.
+// The type of change.
+const (
+	LicenseChange_Type_Added          = "added"
+	LicenseChange_Type_Removed        = "removed"
+	LicenseChange_Type_Rotated        = "rotated"
+	LicenseChange_Type_VersionChanged = "version_changed"
+)
+
+// Compare returns how the licenses changed since "previous", one change per product, sorted by product. A product
+// whose versions are unchanged but whose key fingerprints differ has had its keys rotated. Fingerprints are used so
+// that inventories exported with masked values can be compared.
+func (inventory *LicenseInventory) Compare(previous *LicenseInventory) []LicenseChange {
+	current := inventory.productFingerprints()
+	before := map[string][]string{}
+	if previous != nil {
+		before = previous.productFingerprints()
+	}
+
+	products := []string{}
+	for product := range current {
+		products = append(products, product)
+	}
+	for product := range before {
+		if _, ok := current[product]; !ok {
+			products = append(products, product)
+		}
+	}
+	sort.Strings(products)
+
+	changes := []LicenseChange{}
+	for _, product := range products {
+		change := LicenseChange{
+			Product:          product,
+			PreviousVersions: []string{},
+			Versions:         []string{},
+		}
+		if previous != nil {
+			change.PreviousVersions = previous.Versions(product)
+		}
+		change.Versions = inventory.Versions(product)
+
+		_, inBefore := before[product]
+		_, inCurrent := current[product]
+		switch {
+		case !inBefore:
+			change.Type = LicenseChange_Type_Added
+		case !inCurrent:
+			change.Type = LicenseChange_Type_Removed
+		case strings.Join(change.PreviousVersions, ",") != strings.Join(change.Versions, ","):
+			change.Type = LicenseChange_Type_VersionChanged
+		case strings.Join(before[product], ",") != strings.Join(current[product], ","):
+			change.Type = LicenseChange_Type_Rotated
+		default:
+			continue
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// productFingerprints returns the sorted key fingerprints of every product.
+func (inventory *LicenseInventory) productFingerprints() map[string][]string {
+	fingerprints := map[string][]string{}
+	for _, entry := range inventory.Licenses {
+		fingerprints[entry.Product] = append(fingerprints[entry.Product], entry.Fingerprint)
+	}
+	for product := range fingerprints {
+		sort.Strings(fingerprints[product])
+	}
+	return fingerprints
+}
+
+// LicenseExportOptions : How license inventories are exported.
+type LicenseExportOptions struct {
+	// Write the license key values in clear text. By default, they are masked.
+	RevealValues bool
+}
+
+// ExportCSV writes the inventory as CSV with a header row and the columns product, version, value and fingerprint.
+// Values are masked unless "options" reveals them.
+func (inventory *LicenseInventory) ExportCSV(writer io.Writer, options *LicenseExportOptions) error {
+	csvWriter := csv.NewWriter(writer)
+	records := [][]string{{"product", "version", "value", "fingerprint"}}
+	for _, entry := range inventory.exported(options).Licenses {
+		records = append(records, []string{entry.Product, entry.Version, entry.Value, entry.Fingerprint})
+	}
+	err := csvWriter.WriteAll(records)
+	if err != nil {
+		return core.SDKErrorf(err, "", "license-export-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// ExportJSON writes the inventory as indented JSON. Values are masked unless "options" reveals them.
+func (inventory *LicenseInventory) ExportJSON(writer io.Writer, options *LicenseExportOptions) error {
+	exported := inventory.exported(options)
+	document := struct {
+		CollectedAt time.Time          `json:"collected_at"`
+		Licenses    []licenseEntryJSON `json:"licenses"`
+	}{
+		CollectedAt: exported.CollectedAt,
+		Licenses:    make([]licenseEntryJSON, len(exported.Licenses)),
+	}
+	for i, entry := range exported.Licenses {
+		document.Licenses[i] = licenseEntryJSON(entry)
+	}
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	err := encoder.Encode(document)
+	if err != nil {
+		return core.SDKErrorf(err, "", "license-export-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// exported returns a copy of the inventory whose values are masked unless "options" reveals them.
+func (inventory *LicenseInventory) exported(options *LicenseExportOptions) *LicenseInventory {
+	exported := &LicenseInventory{
+		CollectedAt: inventory.CollectedAt,
+		Licenses:    make([]LicenseEntry, len(inventory.Licenses)),
+	}
+	copy(exported.Licenses, inventory.Licenses)
+	if options == nil || !options.RevealValues {
+		for i := range exported.Licenses {
+			exported.Licenses[i].Value = MaskLicenseValue(exported.Licenses[i].Value)
+		}
+	}
+	return exported
+}
+
+// MaskLicenseValue hides every letter and digit of a license key value except the last five, keeping separators so
+// that the format of the key remains recognizable.
+func MaskLicenseValue(value string) string {
+	runes := []rune(value)
+	visible := 5
+	for i := len(runes) - 1; i >= 0; i-- {
+		if !isLicenseKeyChar(runes[i]) {
+			continue
+		}
+		if visible > 0 {
+			visible--
+			continue
+		}
+		runes[i] = '*'
+	}
+	return string(runes)
+}
+
+func isLicenseKeyChar(r rune) bool {
+	return (r >= '0' && r <= '9') || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
+
+// LicenseFingerprint returns a digest of a license key value that identifies it without revealing it.
+func LicenseFingerprint(value string) string {
+	if value == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])[:16]
+}
+
+// LicenseVersionMatches reports whether a component version, such as Edge.Version, is covered by a license version.
+// The license version is compared segment by segment with the leading segments of the component version, so that
+// license version "4.1" covers "4.1.0.2". A segment "x" or "*" matches any value, and a leading "v" is ignored.
+func LicenseVersionMatches(licenseVersion string, componentVersion string) bool {
+	licenseSegments := versionSegments(licenseVersion)
+	componentSegments := versionSegments(componentVersion)
+	if len(licenseSegments) == 0 || len(licenseSegments) > len(componentSegments) {
+		return false
+	}
+	for i, segment := range licenseSegments {
+		if segment == "x" || segment == "*" {
+			continue
+		}
+		if segment != componentSegments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func versionSegments(version string) []string {
+	version = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(version)), "v")
+	if version == "" {
+		return nil
+	}
+	return strings.Split(version, ".")
+}
+
+// MatchVersion returns the license keys of "product" whose version covers "componentVersion".
+func (inventory *LicenseInventory) MatchVersion(product string, componentVersion string) []LicenseEntry {
+	entries := []LicenseEntry{}
+	for _, entry := range inventory.Licenses {
+		if strings.EqualFold(entry.Product, product) && LicenseVersionMatches(entry.Version, componentVersion) {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// LicenseVersionMismatch : An edge whose version is not covered by any license of a product.
+type LicenseVersionMismatch struct {
+	// The ID of the virtual data center.
+	VdcID string `json:"vdc_id"`
+
+	// The ID of the edge.
+	EdgeID string `json:"edge_id"`
+
+	// The edge version.
+	EdgeVersion string `json:"edge_version"`
+
+	// The product that was checked.
+	Product string `json:"product"`
+
+	// The licensed versions of the product.
+	LicensedVersions []string `json:"licensed_versions"`
+}
+
+// String returns a description of the mismatch.
+func (mismatch LicenseVersionMismatch) String() string {
+	return fmt.Sprintf("edge '%s' of VDC '%s' runs version '%s', which no '%s' license covers (licensed versions: %s)",
+		mismatch.EdgeID, mismatch.VdcID, mismatch.EdgeVersion, mismatch.Product, strings.Join(mismatch.LicensedVersions, ", "))
+}
+
+// CheckEdgeVersions returns the edges of "vdcs" whose version is not covered by a license of "product".
+func (inventory *LicenseInventory) CheckEdgeVersions(product string, vdcs []VDC) []LicenseVersionMismatch {
+	mismatches := []LicenseVersionMismatch{}
+	for _, vdc := range vdcs {
+		for _, edge := range vdc.Edges {
+			if len(inventory.MatchVersion(product, stringValue(edge.Version))) > 0 {
+				continue
+			}
+			mismatches = append(mismatches, LicenseVersionMismatch{
+				VdcID:            stringValue(vdc.ID),
+				EdgeID:           stringValue(edge.ID),
+				EdgeVersion:      stringValue(edge.Version),
+				Product:          product,
+				LicensedVersions: inventory.Versions(product),
+			})
+		}
+	}
+	return mismatches
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,176 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vmwarev1_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vmware-go-sdk/vmwarev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`License inventory`, func() {
+	var testServer *httptest.Server
+
+	licenses := func(nsxKey string) *vmwarev1.LicenseCollection {
+		return &vmwarev1.LicenseCollection{
+			Licenses: []vmwarev1.License{
+				{
+					Version: core.StringPtr("4.1"),
+					LicenseKeys: []vmwarev1.LicenseKey{
+						{Name: core.StringPtr("NSX"), Value: core.StringPtr(nsxKey)},
+					},
+				},
+				{
+					Version: core.StringPtr("10.5"),
+					LicenseKeys: []vmwarev1.LicenseKey{
+						{Name: core.StringPtr("Cloud Director"), Value: core.StringPtr("AAAAA-BBBBB-CCCCC-DDDDD-EEEEE")},
+					},
+				},
+			},
+		}
+	}
+
+	Describe(`ListLicenseInventory(listLicenseInventoryOptions *ListLicenseInventoryOptions)`, func() {
+		var requestHeader http.Header
+		BeforeEach(func() {
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				requestHeader = req.Header
+				Expect(req.URL.EscapedPath()).To(Equal("/licenses"))
+				Expect(req.Method).To(Equal("GET"))
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(200)
+				fmt.Fprint(res, `{"licenses": [{"license_keys": [{"value": "11111-22222-33333-44444-55555", "name": "vSphere"}, {"value": "66666-77777-88888-99999-00000", "name": "vSphere"}], "version": "8.0"}]}`)
+			}))
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+		It(`Groups the license keys by product and version`, func() {
+			vmwareService, serviceErr := vmwarev1.NewVmwareV1(&vmwarev1.VmwareV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			listLicenseInventoryOptions := vmwareService.NewListLicenseInventoryOptions().
+				SetAcceptLanguage("en-us").
+				SetXGlobalTransactionID("transaction1").
+				SetHeaders(map[string]string{"X-Test": "licenses"})
+			inventory, operationErr := vmwareService.ListLicenseInventory(listLicenseInventoryOptions)
+			Expect(operationErr).To(BeNil())
+			Expect(requestHeader.Get("Accept-Language")).To(Equal("en-us"))
+			Expect(requestHeader.Get("X-Global-Transaction-ID")).To(Equal("transaction1"))
+			Expect(requestHeader.Get("X-Test")).To(Equal("licenses"))
+			Expect(listLicenseInventoryOptions.Headers).To(Equal(map[string]string{"X-Test": "licenses"}))
+			Expect(inventory.Products()).To(Equal([]string{"vSphere"}))
+			Expect(inventory.Versions("vsphere")).To(Equal([]string{"8.0"}))
+			Expect(inventory.ByProduct()["vSphere"]["8.0"]).To(HaveLen(2))
+			Expect(inventory.Licenses[0].Fingerprint).To(HaveLen(16))
+		})
+	})
+
+	It(`Masks the license key values unless they are revealed`, func() {
+		inventory := vmwarev1.NewLicenseInventory(licenses("ABCDE-FGHIJ-KLMNO-PQRST-UVWXY"))
+
+		var csvOutput bytes.Buffer
+		Expect(inventory.ExportCSV(&csvOutput, nil)).To(Succeed())
+		Expect(csvOutput.String()).To(HavePrefix("product,version,value,fingerprint\n"))
+		Expect(csvOutput.String()).To(ContainSubstring("NSX,4.1,*****-*****-*****-*****-UVWXY,"))
+		Expect(csvOutput.String()).ToNot(ContainSubstring("ABCDE"))
+
+		var jsonOutput bytes.Buffer
+		Expect(inventory.ExportJSON(&jsonOutput, nil)).To(Succeed())
+		Expect(jsonOutput.String()).ToNot(ContainSubstring("ABCDE"))
+
+		revealed := bytes.Buffer{}
+		Expect(inventory.ExportJSON(&revealed, &vmwarev1.LicenseExportOptions{RevealValues: true})).To(Succeed())
+		Expect(revealed.String()).To(ContainSubstring("ABCDE-FGHIJ-KLMNO-PQRST-UVWXY"))
+		Expect(inventory.Licenses[1].Value).To(Equal("ABCDE-FGHIJ-KLMNO-PQRST-UVWXY"))
+
+		marshalled, err := json.Marshal(inventory)
+		Expect(err).To(BeNil())
+		Expect(string(marshalled)).To(ContainSubstring(`"value":"*****-*****-*****-*****-UVWXY"`))
+		formatted := fmt.Sprintf("%v %+v %#v", inventory.Licenses[1], inventory.Licenses, inventory.Licenses[1])
+		Expect(formatted).To(ContainSubstring("UVWXY"))
+		Expect(formatted).ToNot(ContainSubstring("ABCDE"))
+	})
+
+	It(`Detects rotated keys from a masked export`, func() {
+		previous := vmwarev1.NewLicenseInventory(licenses("ABCDE-FGHIJ-KLMNO-PQRST-UVWXY"))
+		var export bytes.Buffer
+		Expect(previous.ExportJSON(&export, nil)).To(Succeed())
+		loaded, err := vmwarev1.LoadLicenseInventory(&export)
+		Expect(err).To(BeNil())
+
+		Expect(vmwarev1.NewLicenseInventory(licenses("ABCDE-FGHIJ-KLMNO-PQRST-UVWXY")).Compare(loaded)).To(BeEmpty())
+
+		changes := vmwarev1.NewLicenseInventory(licenses("ZZZZZ-FGHIJ-KLMNO-PQRST-UVWXY")).Compare(loaded)
+		Expect(changes).To(Equal([]vmwarev1.LicenseChange{
+			{Product: "NSX", Type: vmwarev1.LicenseChange_Type_Rotated, PreviousVersions: []string{"4.1"}, Versions: []string{"4.1"}},
+		}))
+
+		upgraded := licenses("ABCDE-FGHIJ-KLMNO-PQRST-UVWXY")
+		upgraded.Licenses[0].Version = core.StringPtr("4.2")
+		upgraded.Licenses = append(upgraded.Licenses, vmwarev1.License{
+			Version:     core.StringPtr("8.0"),
+			LicenseKeys: []vmwarev1.LicenseKey{{Name: core.StringPtr("vSphere"), Value: core.StringPtr("11111")}},
+		})
+		changes = vmwarev1.NewLicenseInventory(upgraded).Compare(loaded)
+		Expect(changes).To(HaveLen(2))
+		Expect(changes[0].Product).To(Equal("NSX"))
+		Expect(changes[0].Type).To(Equal(vmwarev1.LicenseChange_Type_VersionChanged))
+		Expect(changes[0].Versions).To(Equal([]string{"4.2"}))
+		Expect(changes[1].Type).To(Equal(vmwarev1.LicenseChange_Type_Added))
+	})
+
+	It(`Checks edge versions against the licensed versions`, func() {
+		Expect(vmwarev1.LicenseVersionMatches("4.1", "4.1.0.2")).To(BeTrue())
+		Expect(vmwarev1.LicenseVersionMatches("4.x", "v4.2.1")).To(BeTrue())
+		Expect(vmwarev1.LicenseVersionMatches("4.1", "4.2.0")).To(BeFalse())
+		Expect(vmwarev1.LicenseVersionMatches("4.1.0", "4.1")).To(BeFalse())
+		Expect(vmwarev1.LicenseVersionMatches("", "4.1")).To(BeFalse())
+
+		inventory := vmwarev1.NewLicenseInventory(licenses("ABCDE-FGHIJ-KLMNO-PQRST-UVWXY"))
+		Expect(inventory.MatchVersion("nsx", "4.1.2")).To(HaveLen(1))
+
+		vdcs := []vmwarev1.VDC{{
+			ID: core.StringPtr("vdc_id"),
+			Edges: []vmwarev1.Edge{
+				{ID: core.StringPtr("edge_ok"), Version: core.StringPtr("4.1.2")},
+				{ID: core.StringPtr("edge_old"), Version: core.StringPtr("3.2.1")},
+			},
+		}}
+		mismatches := inventory.CheckEdgeVersions("NSX", vdcs)
+		Expect(mismatches).To(Equal([]vmwarev1.LicenseVersionMismatch{{
+			VdcID:            "vdc_id",
+			EdgeID:           "edge_old",
+			EdgeVersion:      "3.2.1",
+			Product:          "NSX",
+			LicensedVersions: []string{"4.1"},
+		}}))
+		Expect(mismatches[0].String()).To(ContainSubstring("edge 'edge_old'"))
+	})
+})
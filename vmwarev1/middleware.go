@@ -0,0 +1,241 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vmwarev1
+
+// The generated operations send their requests through the http.Client of the service. Middleware wraps the
+// transport of that client, so that behavior such as caching can be added to every operation without changing them.
+//
+// Middleware runs after the authenticator has set the Authorization header and before the request leaves the
+// process. When retries are enabled with EnableRetries, each attempt goes through the middleware.
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vmware-go-sdk/common"
+)
+
+// Middleware : Wraps the transport used by the service to send requests.
+type Middleware func(next http.RoundTripper) http.RoundTripper
+
+// RoundTripperFunc : An http.RoundTripper implemented by a function.
+type RoundTripperFunc func(req *http.Request) (*http.Response, error)
+
+// RoundTrip calls the function.
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Use installs "middleware" on the HTTP client of the service. The first middleware of the list is the outermost, so
+// it sees the requests first and the responses last. Middleware installed by a later call wraps the middleware
+// installed before it.
+//
+// The HTTP client is copied before its transport is replaced, so that clients created earlier with Clone are not
+// affected.
+func (vmware *VmwareV1) Use(middleware ...Middleware) {
+	client := vmware.Service.GetHTTPClient()
+	if client == nil {
+		client = core.DefaultHTTPClient()
+	}
+	transport := client.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	for i := len(middleware) - 1; i >= 0; i-- {
+		transport = middleware[i](transport)
+	}
+
+	wrapped := *client
+	wrapped.Transport = transport
+	vmware.Service.SetHTTPClient(&wrapped)
+}
+
+// syntheticResponse builds a response that a middleware returns instead of sending "req".
+func syntheticResponse(req *http.Request, statusCode int, header http.Header, body []byte) *http.Response {
+	return &http.Response{
+		Status:        strconv.Itoa(statusCode) + " " + http.StatusText(statusCode),
+		StatusCode:    statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// readRequestBody returns the body of "req", leaving it readable.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	var body []byte
+	var err error
+	if req.GetBody != nil {
+		var copied io.ReadCloser
+		copied, err = req.GetBody()
+		if err == nil {
+			body, err = io.ReadAll(copied)
+			copied.Close()
+		}
+	} else {
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "read-body-error", common.GetComponentInfo())
+	}
+	return body, nil
+}
+
+// RequestOperation : The operation of the service that sent a request.
+type RequestOperation struct {
+	// The name of the operation, which is the name of the method of VmwareV1, such as "ListVdcs".
+	Name string
+
+	// The HTTP method of the operation.
+	Method string
+
+	// The path of the operation, with its parameters in braces, such as "/vdcs/{id}".
+	Path string
+
+	// The values of the path parameters of the request.
+	PathParams map[string]string
+
+	// Whether the operation changes resources.
+	Mutating bool
+}
+
+// operationRoute : A route of the API.
+type operationRoute struct {
+	name     string
+	method   string
+	path     string
+	segments []string
+}
+
+// The routes of the API, one per operation.
+var operationRoutes = newOperationRoutes([][3]string{
+	{"CreateDirectorSites", http.MethodPost, "/director_sites"},
+	{"ListDirectorSites", http.MethodGet, "/director_sites"},
+	{"GetDirectorSite", http.MethodGet, "/director_sites/{id}"},
+	{"DeleteDirectorSite", http.MethodDelete, "/director_sites/{id}"},
+	{"EnableVeeamOnPvdcsList", http.MethodPost, "/director_sites/{site_id}/action/enable_veeam"},
+	{"EnableVcdaOnDataCenter", http.MethodPost, "/director_sites/{site_id}/action/enable_vcda"},
+	{"CreateDirectorSitesVcdaConnectionEndpoints", http.MethodPost, "/director_sites/{site_id}/vcda/connection_endpoints"},
+	{"DeleteDirectorSitesVcdaConnectionEndpoints", http.MethodDelete, "/director_sites/{site_id}/services/vcda/connection_endpoints/{id}"},
+	{"UpdateDirectorSitesVcdaConnectionEndpoints", http.MethodPatch, "/director_sites/{site_id}/services/vcda/connection_endpoints/{id}"},
+	{"CreateDirectorSitesVcdaC2cConnection", http.MethodPost, "/director_sites/{site_id}/services/vcda/c2c_connections"},
+	{"DeleteDirectorSitesVcdaC2cConnection", http.MethodDelete, "/director_sites/{site_id}/services/vcda/c2c_connections/{id}"},
+	{"UpdateDirectorSitesVcdaC2cConnection", http.MethodPatch, "/director_sites/{site_id}/services/vcda/c2c_connections/{id}"},
+	{"GetOidcConfiguration", http.MethodGet, "/director_sites/{site_id}/oidc_configuration"},
+	{"SetOidcConfiguration", http.MethodPut, "/director_sites/{site_id}/oidc_configuration"},
+	{"ListDirectorSitesPvdcs", http.MethodGet, "/director_sites/{site_id}/pvdcs"},
+	{"CreateDirectorSitesPvdcs", http.MethodPost, "/director_sites/{site_id}/pvdcs"},
+	{"GetDirectorSitesPvdcs", http.MethodGet, "/director_sites/{site_id}/pvdcs/{id}"},
+	{"ListDirectorSitesPvdcsClusters", http.MethodGet, "/director_sites/{site_id}/pvdcs/{pvdc_id}/clusters"},
+	{"CreateDirectorSitesPvdcsClusters", http.MethodPost, "/director_sites/{site_id}/pvdcs/{pvdc_id}/clusters"},
+	{"GetDirectorInstancesPvdcsCluster", http.MethodGet, "/director_sites/{site_id}/pvdcs/{pvdc_id}/clusters/{id}"},
+	{"DeleteDirectorSitesPvdcsCluster", http.MethodDelete, "/director_sites/{site_id}/pvdcs/{pvdc_id}/clusters/{id}"},
+	{"UpdateDirectorSitesPvdcsCluster", http.MethodPatch, "/director_sites/{site_id}/pvdcs/{pvdc_id}/clusters/{id}"},
+	{"ListDirectorSiteRegions", http.MethodGet, "/director_site_regions"},
+	{"ListMultitenantDirectorSites", http.MethodGet, "/multitenant_director_sites"},
+	{"ListDirectorSiteHostProfiles", http.MethodGet, "/director_site_host_profiles"},
+	{"ListVdcs", http.MethodGet, "/vdcs"},
+	{"CreateVdc", http.MethodPost, "/vdcs"},
+	{"GetVdc", http.MethodGet, "/vdcs/{id}"},
+	{"DeleteVdc", http.MethodDelete, "/vdcs/{id}"},
+	{"UpdateVdc", http.MethodPatch, "/vdcs/{id}"},
+	{"AddTransitGatewayConnections", http.MethodPut, "/vdcs/{vdc_id}/edges/{edge_id}/transit_gateways/{id}"},
+	{"RemoveTransitGatewayConnections", http.MethodDelete, "/vdcs/{vdc_id}/edges/{edge_id}/transit_gateways/{id}"},
+	{"SwapHaEdgeSites", http.MethodPatch, "/vdcs/{vdc_id}/edges/{edge_id}/swap_primary_and_secondary_network_locations"},
+	{"ListLicenses", http.MethodGet, "/licenses"},
+	{"ListUsageMeterRegistrations", http.MethodGet, "/usage_meter_registrations"},
+	{"CreateUsageMeterRegistration", http.MethodPost, "/usage_meter_registrations"},
+	{"GetUsageMeterRegistration", http.MethodGet, "/usage_meter_registrations/{id}"},
+	{"DeleteUsageMeterRegistration", http.MethodDelete, "/usage_meter_registrations/{id}"},
+})
+
+func newOperationRoutes(definitions [][3]string) []operationRoute {
+	routes := make([]operationRoute, len(definitions))
+	for i, definition := range definitions {
+		routes[i] = operationRoute{
+			name:     definition[0],
+			method:   definition[1],
+			path:     definition[2],
+			segments: strings.Split(strings.Trim(definition[2], "/"), "/"),
+		}
+	}
+	return routes
+}
+
+// OperationForRequest returns the operation that sent "req". The service URL may have a path of its own, such as
+// "/v1", so the path of the operation is matched against the end of the request path; the longest match wins.
+func OperationForRequest(req *http.Request) (operation RequestOperation, ok bool) {
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	best := -1
+	for i, route := range operationRoutes {
+		if route.method != req.Method || len(route.segments) > len(segments) {
+			continue
+		}
+		if best >= 0 && len(route.segments) <= len(operationRoutes[best].segments) {
+			continue
+		}
+		if _, matched := matchSegments(route.segments, segments[len(segments)-len(route.segments):]); matched {
+			best = i
+		}
+	}
+	if best < 0 {
+		return
+	}
+
+	route := operationRoutes[best]
+	params, _ := matchSegments(route.segments, segments[len(segments)-len(route.segments):])
+	operation = RequestOperation{
+		Name:       route.name,
+		Method:     route.method,
+		Path:       route.path,
+		PathParams: params,
+		Mutating:   route.method != http.MethodGet && route.method != http.MethodHead,
+	}
+	ok = true
+	return
+}
+
+// matchSegments matches the segments of a route with the segments of a path and returns the path parameters.
+func matchSegments(routeSegments []string, pathSegments []string) (map[string]string, bool) {
+	params := map[string]string{}
+	for i, segment := range routeSegments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			if pathSegments[i] == "" {
+				return nil, false
+			}
+			params[segment[1:len(segment)-1]] = pathSegments[i]
+			continue
+		}
+		if segment != pathSegments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
@@ -0,0 +1,93 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package vmwarev1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vmware-go-sdk/vmwarev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Middleware`, func() {
+	It(`Identifies the operation of a request`, func() {
+		req := httptest.NewRequest(http.MethodPatch, "https://api.example.com/v1/director_sites/site1/pvdcs/pvdc1/clusters/cluster1", nil)
+		operation, ok := vmwarev1.OperationForRequest(req)
+		Expect(ok).To(BeTrue())
+		Expect(operation.Name).To(Equal("UpdateDirectorSitesPvdcsCluster"))
+		Expect(operation.Path).To(Equal("/director_sites/{site_id}/pvdcs/{pvdc_id}/clusters/{id}"))
+		Expect(operation.PathParams).To(Equal(map[string]string{"site_id": "site1", "pvdc_id": "pvdc1", "id": "cluster1"}))
+		Expect(operation.Mutating).To(BeTrue())
+
+		req = httptest.NewRequest(http.MethodGet, "https://api.example.com/v1/director_site_regions", nil)
+		operation, ok = vmwarev1.OperationForRequest(req)
+		Expect(ok).To(BeTrue())
+		Expect(operation.Name).To(Equal("ListDirectorSiteRegions"))
+		Expect(operation.Mutating).To(BeFalse())
+
+		req = httptest.NewRequest(http.MethodGet, "https://api.example.com/v1/unknown", nil)
+		_, ok = vmwarev1.OperationForRequest(req)
+		Expect(ok).To(BeFalse())
+	})
+	It(`Runs the middleware in order around every request`, func() {
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			Expect(req.Header.Get("X-Trace")).To(Equal("outer,inner"))
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprint(res, `{"vdcs": []}`)
+		}))
+		defer testServer.Close()
+
+		vmwareService, serviceErr := vmwarev1.NewVmwareV1(&vmwarev1.VmwareV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		clone := vmwareService.Clone()
+
+		var operations []string
+		trace := func(name string) vmwarev1.Middleware {
+			return func(next http.RoundTripper) http.RoundTripper {
+				return vmwarev1.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					values := []string{}
+					if current := req.Header.Get("X-Trace"); current != "" {
+						values = append(values, current)
+					}
+					req.Header.Set("X-Trace", strings.Join(append(values, name), ","))
+					if operation, ok := vmwarev1.OperationForRequest(req); ok {
+						operations = append(operations, name+":"+operation.Name)
+					}
+					return next.RoundTrip(req)
+				})
+			}
+		}
+		vmwareService.Use(trace("outer"), trace("inner"))
+
+		_, response, err := vmwareService.ListVdcs(vmwareService.NewListVdcsOptions())
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(200))
+		Expect(operations).To(Equal([]string{"outer:ListVdcs", "inner:ListVdcs"}))
+
+		// Clones made before Use are not affected.
+		Expect(clone.Service.GetHTTPClient().Transport).ToNot(BeAssignableToTypeOf(vmwarev1.RoundTripperFunc(nil)))
+	})
+})
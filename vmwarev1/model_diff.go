@@ -0,0 +1,330 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vmwarev1
+
+// Every model has DeepCopy, Equal and Diff methods, generated from vmware_v1.go into vmware_v1_models.go by
+// internal/modelgen. Run "go generate ./vmwarev1" after regenerating vmware_v1.go.
+//
+// Equal and Diff compare the values of the fields rather than their pointers, and date-times as instants. A nil slice
+// is equal to an empty one, but a nil pointer is not equal to a pointer to a zero value, as the API tells them apart.
+// The changes are reported with the JSON paths of the fields, such as "pvdcs[0].clusters[1].host_count".
+
+//go:generate go run ../internal/modelgen -output vmware_v1_models.go vmware_v1.go
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// FieldChange : A field whose value differs between two models.
+type FieldChange struct {
+	// The JSON path of the field, such as "pvdcs[0].clusters[1].host_count". It is empty when one of the models is nil.
+	Path string `json:"path"`
+
+	// The value of the field in the model that Diff was called on, or nil if it has none.
+	Old interface{} `json:"old"`
+
+	// The value of the field in the other model, or nil if it has none.
+	New interface{} `json:"new"`
+}
+
+// String returns a description of the change. The values of the sensitive fields are redacted, unless redaction was
+// disabled with SetRedactionEnabled.
+func (change FieldChange) String() string {
+	old, other := describeValue(change.Old), describeValue(change.New)
+	if IsRedactionEnabled() && isSensitivePath(change.Path) {
+		if change.Old != nil {
+			old = redactedValue
+		}
+		if change.New != nil {
+			other = redactedValue
+		}
+	}
+	return fmt.Sprintf("%s: %s -> %s", change.Path, old, other)
+}
+
+// fieldChange has the fields of FieldChange but none of its methods.
+type fieldChange FieldChange
+
+// MarshalJSON returns the change as JSON. Like String, it redacts the values of the sensitive fields, including those
+// of the models it holds, unless redaction was disabled with SetRedactionEnabled.
+func (change FieldChange) MarshalJSON() ([]byte, error) {
+	if !IsRedactionEnabled() {
+		return json.Marshal(fieldChange(change))
+	}
+	sensitive := isSensitivePath(change.Path)
+	buf, err := json.Marshal(fieldChange{
+		Path: change.Path,
+		Old:  redactChangeValue(change.Old, sensitive),
+		New:  redactChangeValue(change.New, sensitive),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return []byte(redactSensitiveJSON(string(buf))), nil
+}
+
+// redactChangeValue returns the value of a changed field that is "sensitive", or holds models with sensitive fields,
+// as it is marshalled for logs.
+func redactChangeValue(value interface{}, sensitive bool) interface{} {
+	if value == nil {
+		return nil
+	}
+	if sensitive {
+		return redactedValue
+	}
+	if model, ok := value.(interface{ MarshalLog() interface{} }); ok {
+		return model.MarshalLog()
+	}
+	if slice := reflect.ValueOf(value); slice.Kind() == reflect.Slice {
+		values := make([]interface{}, slice.Len())
+		for i := range values {
+			values[i] = redactChangeValue(slice.Index(i).Interface(), false)
+		}
+		return values
+	}
+	return value
+}
+
+// DiffOptions : The options of the Equal and Diff methods of the models.
+type DiffOptions struct {
+	// Whether the volatile fields, that change without any action on the resource, are ignored: the date-times and the
+	// hrefs.
+	IgnoreVolatile bool
+
+	// The fields that are ignored, by JSON name (such as "status"), which ignores the field in every model, or by JSON
+	// path without indexes (such as "pvdcs.clusters.status").
+	IgnoreFields []string
+}
+
+// NewDiffOptions : Instantiate DiffOptions
+func NewDiffOptions() *DiffOptions {
+	return &DiffOptions{}
+}
+
+// SetIgnoreVolatile : Allow user to set IgnoreVolatile
+func (_options *DiffOptions) SetIgnoreVolatile(ignoreVolatile bool) *DiffOptions {
+	_options.IgnoreVolatile = ignoreVolatile
+	return _options
+}
+
+// SetIgnoreFields : Allow user to set IgnoreFields
+func (_options *DiffOptions) SetIgnoreFields(ignoreFields ...string) *DiffOptions {
+	_options.IgnoreFields = ignoreFields
+	return _options
+}
+
+// differ collects the changes found by the generated diff methods.
+type differ struct {
+	ignoreVolatile bool
+	ignoreFields   []string
+
+	// Whether only the existence of a change matters, so that the comparison stops at the first one.
+	equalOnly bool
+	differs   bool
+	changes   []FieldChange
+}
+
+var reIndexes = regexp.MustCompile(`\[\d+\]`)
+
+func newDiffer(options []*DiffOptions, equalOnly bool) *differ {
+	d := &differ{equalOnly: equalOnly}
+	for _, o := range options {
+		if o == nil {
+			continue
+		}
+		d.ignoreVolatile = d.ignoreVolatile || o.IgnoreVolatile
+		d.ignoreFields = append(d.ignoreFields, o.IgnoreFields...)
+	}
+	return d
+}
+
+// field returns the path of the field "name" of the model at "path", and whether the field must be compared.
+func (d *differ) field(path string, name string, volatile bool) (string, bool) {
+	if (d.equalOnly && d.differs) || (volatile && d.ignoreVolatile) {
+		return "", false
+	}
+	fieldPath := name
+	if path != "" {
+		fieldPath = path + "." + name
+	}
+	if len(d.ignoreFields) > 0 {
+		withoutIndexes := reIndexes.ReplaceAllString(fieldPath, "")
+		for _, ignored := range d.ignoreFields {
+			if ignored == name || ignored == withoutIndexes {
+				return "", false
+			}
+		}
+	}
+	return fieldPath, true
+}
+
+func (d *differ) change(path string, old interface{}, other interface{}) {
+	d.differs = true
+	if !d.equalOnly {
+		d.changes = append(d.changes, FieldChange{Path: path, Old: old, New: other})
+	}
+}
+
+// diffNil records a change if one of the models is nil, and returns true if their fields cannot be compared.
+func diffNil[T any](d *differ, path string, old *T, other *T) bool {
+	if old != nil && other != nil {
+		return false
+	}
+	if old != other {
+		d.change(path, valueOf(old), valueOf(other))
+	}
+	return true
+}
+
+func diffPointers[T comparable](d *differ, path string, old *T, other *T) {
+	switch {
+	case old == nil && other == nil:
+	case old == nil || other == nil || *old != *other:
+		d.change(path, elemOf(old), elemOf(other))
+	}
+}
+
+func diffDateTimes(d *differ, path string, old *strfmt.DateTime, other *strfmt.DateTime) {
+	switch {
+	case old == nil && other == nil:
+	case old == nil || other == nil || !time.Time(*old).Equal(time.Time(*other)):
+		d.change(path, elemOf(old), elemOf(other))
+	}
+}
+
+func diffValues[T comparable](d *differ, path string, old []T, other []T) {
+	equal := len(old) == len(other)
+	for i := 0; equal && i < len(old); i++ {
+		equal = old[i] == other[i]
+	}
+	if !equal {
+		d.change(path, old, other)
+	}
+}
+
+func diffModels[T any](d *differ, path string, old []T, other []T, diff func(*T, *differ, string, *T)) {
+	for i := 0; i < len(old) || i < len(other); i++ {
+		if d.equalOnly && d.differs {
+			return
+		}
+		indexPath := fmt.Sprintf("%s[%d]", path, i)
+		switch {
+		case i >= len(other):
+			d.change(indexPath, &old[i], nil)
+		case i >= len(old):
+			d.change(indexPath, nil, &other[i])
+		default:
+			diff(&old[i], d, indexPath, &other[i])
+		}
+	}
+}
+
+func diffJSON(d *differ, path string, old interface{}, other interface{}) {
+	if !reflect.DeepEqual(old, other) {
+		d.change(path, old, other)
+	}
+}
+
+// valueOf returns "pointer" as an interface that is nil when "pointer" is.
+func valueOf[T any](pointer *T) interface{} {
+	if pointer == nil {
+		return nil
+	}
+	return pointer
+}
+
+// elemOf returns the value "pointer" points to, or nil.
+func elemOf[T any](pointer *T) interface{} {
+	if pointer == nil {
+		return nil
+	}
+	return *pointer
+}
+
+// isSensitivePath returns true if the field at "path" holds a secret.
+func isSensitivePath(path string) bool {
+	segments := strings.Split(reIndexes.ReplaceAllString(path, ""), ".")
+	name := segments[len(segments)-1]
+	if len(segments) > 1 && containsString(sensitiveNestedJSONFields[segments[len(segments)-2]], name) {
+		return true
+	}
+	sensitiveJSONFieldsMutex.RLock()
+	defer sensitiveJSONFieldsMutex.RUnlock()
+	return containsString(sensitiveJSONFields, name)
+}
+
+func describeValue(value interface{}) string {
+	if value == nil {
+		return "<none>"
+	}
+	if model, ok := value.(fmt.Stringer); ok {
+		return model.String()
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+func copyPointer[T any](in *T) *T {
+	if in == nil {
+		return nil
+	}
+	out := *in
+	return &out
+}
+
+func copyValues[T any](in []T) []T {
+	if in == nil {
+		return nil
+	}
+	return append(make([]T, 0, len(in)), in...)
+}
+
+func copyModels[T any](in []T, deepCopy func(*T) *T) []T {
+	if in == nil {
+		return nil
+	}
+	out := make([]T, len(in))
+	for i := range in {
+		out[i] = *deepCopy(&in[i])
+	}
+	return out
+}
+
+// copyJSONValue returns a deep copy of a generic JSON value.
+func copyJSONValue(in interface{}) interface{} {
+	switch in := in.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(in))
+		for key, value := range in {
+			out[key] = copyJSONValue(value)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(in))
+		for i, value := range in {
+			out[i] = copyJSONValue(value)
+		}
+		return out
+	}
+	return in
+}
@@ -0,0 +1,182 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package vmwarev1_test
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vmware-go-sdk/vmwarev1"
+	"github.com/go-openapi/strfmt"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Model comparison`, func() {
+	directorSiteJSON := `{
+		"crn": "crn:site1", "href": "https://api/director_sites/site1", "id": "site1",
+		"ordered_at": "2026-01-02T03:04:05.000Z", "provisioned_at": "2026-01-02T05:04:05.000Z",
+		"name": "site1", "status": "ready_to_use", "type": "single_tenant",
+		"resource_group": {"id": "rg1", "name": "default", "crn": "crn:rg1"},
+		"pvdcs": [{
+			"id": "pvdc1", "name": "pvdc1", "data_center_name": "dal10", "href": "https://api/pvdc1", "status": "ready_to_use",
+			"clusters": [
+				{"id": "cluster1", "name": "cluster1", "href": "https://api/cluster1", "host_count": 3, "status": "ready_to_use", "data_center_name": "dal10", "host_profile": "BM_2S_20_CORES_192_GB", "storage_type": "nfs", "billing_plan": "monthly"},
+				{"id": "cluster2", "name": "cluster2", "href": "https://api/cluster2", "host_count": 2, "status": "ready_to_use", "data_center_name": "dal10", "host_profile": "BM_2S_20_CORES_192_GB", "storage_type": "nfs", "billing_plan": "monthly"}
+			],
+			"provider_types": [{"name": "paygo"}]
+		}],
+		"services": [],
+		"console_connection_type": "private", "console_connection_status": "ready",
+		"ip_allow_list": ["10.0.0.0/8"]
+	}`
+
+	newDirectorSite := func() *vmwarev1.DirectorSite {
+		var m map[string]json.RawMessage
+		Expect(json.Unmarshal([]byte(directorSiteJSON), &m)).To(Succeed())
+		var directorSite *vmwarev1.DirectorSite
+		Expect(core.UnmarshalModel(m, "", &directorSite, vmwarev1.UnmarshalDirectorSite)).To(Succeed())
+		return directorSite
+	}
+
+	It(`Copies models without sharing memory`, func() {
+		directorSite := newDirectorSite()
+		copied := directorSite.DeepCopy()
+		Expect(copied).To(Equal(directorSite))
+		Expect(copied.Equal(directorSite)).To(BeTrue())
+
+		*copied.Name = "renamed"
+		*copied.Pvdcs[0].Clusters[1].HostCount = 4
+		copied.IpAllowList[0] = "192.168.0.0/16"
+		*copied.ResourceGroup.ID = "rg2"
+		Expect(*directorSite.Name).To(Equal("site1"))
+		Expect(*directorSite.Pvdcs[0].Clusters[1].HostCount).To(Equal(int64(2)))
+		Expect(directorSite.IpAllowList).To(Equal([]string{"10.0.0.0/8"}))
+		Expect(*directorSite.ResourceGroup.ID).To(Equal("rg1"))
+
+		var nilSite *vmwarev1.DirectorSite
+		Expect(nilSite.DeepCopy()).To(BeNil())
+	})
+
+	It(`Copies the implementations of model interfaces`, func() {
+		edge := &vmwarev1.VDCEdgePrototype{
+			Type: core.StringPtr(vmwarev1.VDCEdgePrototype_Type_Performance),
+			NetworkHa: &vmwarev1.VDCEdgePrototypeNetworkHaNetworkHaOnNonStretched{
+				SecondaryPvdcID: core.StringPtr("pvdc2"),
+			},
+		}
+		copied := edge.DeepCopy()
+		Expect(copied.Equal(edge)).To(BeTrue())
+		copied.NetworkHa.(*vmwarev1.VDCEdgePrototypeNetworkHaNetworkHaOnNonStretched).SecondaryPvdcID = core.StringPtr("pvdc3")
+		Expect(*edge.NetworkHa.(*vmwarev1.VDCEdgePrototypeNetworkHaNetworkHaOnNonStretched).SecondaryPvdcID).To(Equal("pvdc2"))
+		Expect(copied.Diff(edge)).To(Equal([]vmwarev1.FieldChange{{Path: "network_ha.secondary_pvdc_id", Old: "pvdc3", New: "pvdc2"}}))
+
+		copied.NetworkHa = &vmwarev1.VDCEdgePrototypeNetworkHaNetworkHaOnStretched{
+			PrimaryDataCenterName:   core.StringPtr("dal10"),
+			SecondaryDataCenterName: core.StringPtr("dal12"),
+		}
+		changes := copied.Diff(edge)
+		Expect(changes).To(HaveLen(1))
+		Expect(changes[0].Path).To(Equal("network_ha"))
+	})
+
+	It(`Reports the changed fields with their JSON paths`, func() {
+		directorSite := newDirectorSite()
+		updated := directorSite.DeepCopy()
+		updated.Status = core.StringPtr("modifying")
+		updated.Pvdcs[0].Clusters[1].HostCount = core.Int64Ptr(4)
+		updated.Pvdcs[0].Clusters = append(updated.Pvdcs[0].Clusters, vmwarev1.ClusterSummary{ID: core.StringPtr("cluster3")})
+		updated.IpAllowList = append(updated.IpAllowList, "192.168.0.0/16")
+		updated.RhelVmActivationKey = core.StringPtr("key")
+
+		changes := directorSite.Diff(updated)
+		Expect(changes).To(HaveLen(5))
+		Expect(changes[0]).To(Equal(vmwarev1.FieldChange{Path: "status", Old: "ready_to_use", New: "modifying"}))
+		Expect(changes[1]).To(Equal(vmwarev1.FieldChange{Path: "pvdcs[0].clusters[1].host_count", Old: int64(2), New: int64(4)}))
+		Expect(changes[2].Path).To(Equal("pvdcs[0].clusters[2]"))
+		Expect(changes[2].Old).To(BeNil())
+		Expect(changes[2].New).To(Equal(&updated.Pvdcs[0].Clusters[2]))
+		Expect(changes[3]).To(Equal(vmwarev1.FieldChange{Path: "rhel_vm_activation_key", Old: nil, New: "key"}))
+		Expect(changes[4].Path).To(Equal("ip_allow_list"))
+		Expect(changes[1].String()).To(Equal("pvdcs[0].clusters[1].host_count: 2 -> 4"))
+		Expect(changes[3].String()).To(Equal("rhel_vm_activation_key: <none> -> [redacted]"))
+		Expect(directorSite.Equal(updated)).To(BeFalse())
+
+		options := vmwarev1.NewDiffOptions().SetIgnoreFields("status", "pvdcs.clusters", "ip_allow_list", "rhel_vm_activation_key")
+		Expect(directorSite.Diff(updated, options)).To(BeEmpty())
+		Expect(directorSite.Equal(updated, options)).To(BeTrue())
+		options.SetIgnoreFields("pvdcs.clusters.host_count")
+		Expect(directorSite.Diff(updated, options)).To(HaveLen(4))
+	})
+
+	It(`Redacts the sensitive fields when a change is marshalled`, func() {
+		buf, err := json.Marshal(vmwarev1.FieldChange{Path: "rhel_vm_activation_key", Old: nil, New: "key"})
+		Expect(err).To(BeNil())
+		Expect(string(buf)).To(Equal(`{"path":"rhel_vm_activation_key","old":null,"new":"[redacted]"}`))
+
+		licenseKeys := []vmwarev1.LicenseKey{{Name: core.StringPtr("NSX"), Value: core.StringPtr("license-secret")}}
+		for _, change := range []vmwarev1.FieldChange{
+			{Path: "license_keys", Old: nil, New: licenseKeys},
+			{Path: "licenses[0]", Old: nil, New: &vmwarev1.License{Version: core.StringPtr("4.1"), LicenseKeys: licenseKeys}},
+			{Path: "", Old: &vmwarev1.DirectorSite{RhelVmActivationKey: core.StringPtr("rhel-secret")}, New: nil},
+		} {
+			buf, err = json.Marshal(change)
+			Expect(err).To(BeNil())
+			Expect(string(buf)).To(ContainSubstring("[redacted]"))
+			Expect(string(buf)).ToNot(ContainSubstring("secret"))
+		}
+
+		vmwarev1.SetRedactionEnabled(false)
+		defer vmwarev1.SetRedactionEnabled(true)
+		buf, err = json.Marshal(vmwarev1.FieldChange{Path: "license_keys", Old: nil, New: licenseKeys})
+		Expect(err).To(BeNil())
+		Expect(string(buf)).To(Equal(`{"path":"license_keys","old":null,"new":[{"value":"license-secret","name":"NSX"}]}`))
+	})
+
+	It(`Ignores the volatile fields on demand`, func() {
+		directorSite := newDirectorSite()
+		updated := directorSite.DeepCopy()
+		provisionedAt := strfmt.DateTime(time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC))
+		updated.ProvisionedAt = &provisionedAt
+		updated.Pvdcs[0].Href = core.StringPtr("https://api/v2/pvdc1")
+
+		changes := directorSite.Diff(updated)
+		Expect(changes).To(HaveLen(2))
+		Expect(changes[0].Path).To(Equal("provisioned_at"))
+		Expect(changes[1].Path).To(Equal("pvdcs[0].href"))
+		Expect(directorSite.Equal(updated, vmwarev1.NewDiffOptions().SetIgnoreVolatile(true))).To(BeTrue())
+
+		// The same instant in another time zone is equal.
+		orderedAt := strfmt.DateTime(time.Time(*directorSite.OrderedAt).In(time.FixedZone("CET", 3600)))
+		updated = directorSite.DeepCopy()
+		updated.OrderedAt = &orderedAt
+		Expect(directorSite.Equal(updated)).To(BeTrue())
+	})
+
+	It(`Compares nil models and empty slices`, func() {
+		var nilSite *vmwarev1.DirectorSite
+		directorSite := newDirectorSite()
+		Expect(nilSite.Equal(nil)).To(BeTrue())
+		Expect(nilSite.Diff(directorSite)).To(Equal([]vmwarev1.FieldChange{{Path: "", Old: nil, New: directorSite}}))
+
+		updated := directorSite.DeepCopy()
+		updated.Services = nil
+		Expect(directorSite.Equal(updated)).To(BeTrue())
+		updated.ResourceGroup = nil
+		Expect(directorSite.Diff(updated)).To(Equal([]vmwarev1.FieldChange{{Path: "resource_group", Old: directorSite.ResourceGroup, New: nil}}))
+	})
+})
@@ -0,0 +1,423 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vmwarev1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vmware-go-sdk/common"
+)
+
+// PlanNetworkHa : List the valid network HA pairs of a resource pool
+// Collect the Cloud Director sites and regions of the account and list the data centers or resource pools that can be
+// the secondary of a network HA edge whose primary is the resource pool. A resource pool whose clusters span several
+// data centers is stretched, and pairs its data center with the other ones; otherwise, it pairs with the resource
+// pools of the same Cloud Director site in other data centers of the region. Use Inventory.PlanNetworkHa to work from
+// a saved inventory.
+func (vmware *VmwareV1) PlanNetworkHa(planNetworkHaOptions *PlanNetworkHaOptions) (result *NetworkHaPlan, err error) {
+	result, err = vmware.PlanNetworkHaWithContext(context.Background(), planNetworkHaOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// PlanNetworkHaWithContext is an alternate form of the PlanNetworkHa method which supports a Context parameter
+func (vmware *VmwareV1) PlanNetworkHaWithContext(ctx context.Context, planNetworkHaOptions *PlanNetworkHaOptions) (result *NetworkHaPlan, err error) {
+	err = core.ValidateNotNil(planNetworkHaOptions, "planNetworkHaOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(planNetworkHaOptions, "planNetworkHaOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	collectInventoryOptions := vmware.NewCollectInventoryOptions()
+	collectInventoryOptions.AcceptLanguage = planNetworkHaOptions.AcceptLanguage
+	collectInventoryOptions.XGlobalTransactionID = planNetworkHaOptions.XGlobalTransactionID
+	collectInventoryOptions.SetHeaders(planNetworkHaOptions.Headers)
+	inventory, err := vmware.CollectInventoryWithContext(ctx, collectInventoryOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "collect-inventory-error")
+		return
+	}
+	result, err = inventory.PlanNetworkHa(*planNetworkHaOptions.DirectorSiteID, *planNetworkHaOptions.PvdcID)
+	return
+}
+
+// PlanNetworkHaOptions : The PlanNetworkHa options.
+type PlanNetworkHaOptions struct {
+	// The ID of the Cloud Director site.
+	DirectorSiteID *string `json:"director_site_id" validate:"required,ne="`
+
+	// The ID of the primary resource pool, in which the virtual data center is deployed.
+	PvdcID *string `json:"pvdc_id" validate:"required,ne="`
+
+	// Language.
+	AcceptLanguage *string `json:"Accept-Language,omitempty"`
+
+	// Transaction ID.
+	XGlobalTransactionID *string `json:"X-Global-Transaction-ID,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewPlanNetworkHaOptions : Instantiate PlanNetworkHaOptions
+func (*VmwareV1) NewPlanNetworkHaOptions(directorSiteID string, pvdcID string) *PlanNetworkHaOptions {
+	return &PlanNetworkHaOptions{
+		DirectorSiteID: core.StringPtr(directorSiteID),
+		PvdcID:         core.StringPtr(pvdcID),
+	}
+}
+
+// SetDirectorSiteID : Allow user to set DirectorSiteID
+func (_options *PlanNetworkHaOptions) SetDirectorSiteID(directorSiteID string) *PlanNetworkHaOptions {
+	_options.DirectorSiteID = core.StringPtr(directorSiteID)
+	return _options
+}
+
+// SetPvdcID : Allow user to set PvdcID
+func (_options *PlanNetworkHaOptions) SetPvdcID(pvdcID string) *PlanNetworkHaOptions {
+	_options.PvdcID = core.StringPtr(pvdcID)
+	return _options
+}
+
+// SetAcceptLanguage : Allow user to set AcceptLanguage
+func (_options *PlanNetworkHaOptions) SetAcceptLanguage(acceptLanguage string) *PlanNetworkHaOptions {
+	_options.AcceptLanguage = core.StringPtr(acceptLanguage)
+	return _options
+}
+
+// SetXGlobalTransactionID : Allow user to set XGlobalTransactionID
+func (_options *PlanNetworkHaOptions) SetXGlobalTransactionID(xGlobalTransactionID string) *PlanNetworkHaOptions {
+	_options.XGlobalTransactionID = core.StringPtr(xGlobalTransactionID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *PlanNetworkHaOptions) SetHeaders(param map[string]string) *PlanNetworkHaOptions {
+	options.Headers = param
+	return options
+}
+
+// NetworkHaPlan : The valid network HA pairs of a resource pool.
+type NetworkHaPlan struct {
+	// The ID of the Cloud Director site.
+	DirectorSiteID string `json:"director_site_id"`
+
+	// The ID of the primary resource pool.
+	PvdcID string `json:"pvdc_id"`
+
+	// The name of the data center of the primary resource pool.
+	PrimaryDataCenterName string `json:"primary_data_center_name"`
+
+	// The name of the region of the primary data center.
+	Region string `json:"region"`
+
+	// Whether the clusters of the primary resource pool span several data centers. Stretched resource pools take a
+	// VDCEdgePrototypeNetworkHaNetworkHaOnStretched, the others a VDCEdgePrototypeNetworkHaNetworkHaOnNonStretched.
+	Stretched bool `json:"stretched"`
+
+	// The valid pairs, sorted by secondary data center and resource pool.
+	Pairs []NetworkHaPair `json:"pairs"`
+
+	// Why candidate secondaries are not valid.
+	Exclusions []string `json:"exclusions,omitempty"`
+}
+
+// NetworkHaPair : A valid pair of data centers for a network HA edge.
+type NetworkHaPair struct {
+	// The name of the primary data center.
+	PrimaryDataCenterName string `json:"primary_data_center_name"`
+
+	// The uplink speed of the primary data center.
+	PrimaryUplinkSpeed string `json:"primary_uplink_speed"`
+
+	// The name of the secondary data center.
+	SecondaryDataCenterName string `json:"secondary_data_center_name"`
+
+	// The uplink speed of the secondary data center.
+	SecondaryUplinkSpeed string `json:"secondary_uplink_speed"`
+
+	// The ID of the secondary resource pool. It is empty for the pairs of stretched resource pools.
+	SecondaryPvdcID string `json:"secondary_pvdc_id,omitempty"`
+
+	// Remarks that do not invalidate the pair.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// NetworkHa builds the network HA of an edge prototype for the pair.
+func (pair NetworkHaPair) NetworkHa() VDCEdgePrototypeNetworkHaIntf {
+	if pair.SecondaryPvdcID != "" {
+		return &VDCEdgePrototypeNetworkHaNetworkHaOnNonStretched{
+			SecondaryPvdcID: core.StringPtr(pair.SecondaryPvdcID),
+		}
+	}
+	return &VDCEdgePrototypeNetworkHaNetworkHaOnStretched{
+		PrimaryDataCenterName:   core.StringPtr(pair.PrimaryDataCenterName),
+		SecondaryDataCenterName: core.StringPtr(pair.SecondaryDataCenterName),
+	}
+}
+
+// PlanNetworkHa lists the valid network HA pairs of the resource pool "pvdcID" of the Cloud Director site
+// "directorSiteID", which can be a single-tenant site of the account or a multitenant site. The inventory must include
+// the regions.
+func (inventory *Inventory) PlanNetworkHa(directorSiteID string, pvdcID string) (*NetworkHaPlan, error) {
+	var primary *placementPool
+	sitePools := []placementPool{}
+	for _, pool := range inventory.placementPools() {
+		if pool.siteID != directorSiteID {
+			continue
+		}
+		sitePools = append(sitePools, pool)
+		if pool.id == pvdcID {
+			primaryPool := pool
+			primary = &primaryPool
+		}
+	}
+	if len(sitePools) == 0 {
+		err := fmt.Errorf("the Cloud Director site %s is not in the inventory", directorSiteID)
+		return nil, core.SDKErrorf(err, "", "network-ha-site-not-found", common.GetComponentInfo())
+	}
+	if primary == nil {
+		err := fmt.Errorf("the Cloud Director site %s has no resource pool %s", directorSiteID, pvdcID)
+		return nil, core.SDKErrorf(err, "", "network-ha-pvdc-not-found", common.GetComponentInfo())
+	}
+	primaryDataCenter, region := inventory.findDataCenter(primary.dataCenterName)
+	if primaryDataCenter == nil {
+		err := fmt.Errorf("the data center %s of resource pool %s is not in any region of the inventory", primary.dataCenterName, pvdcID)
+		return nil, core.SDKErrorf(err, "", "network-ha-data-center-not-found", common.GetComponentInfo())
+	}
+
+	plan := &NetworkHaPlan{
+		DirectorSiteID:        directorSiteID,
+		PvdcID:                pvdcID,
+		PrimaryDataCenterName: primary.dataCenterName,
+		Region:                region,
+		Pairs:                 []NetworkHaPair{},
+	}
+	pair := func(secondaryDataCenterName string, secondaryPvdcID string, subject string) {
+		secondaryDataCenter, secondaryRegion := inventory.findDataCenter(secondaryDataCenterName)
+		switch {
+		case secondaryDataCenter == nil:
+			plan.Exclusions = append(plan.Exclusions, fmt.Sprintf("%s: data center %s is not in any region", subject, secondaryDataCenterName))
+			return
+		case secondaryRegion != region:
+			plan.Exclusions = append(plan.Exclusions, fmt.Sprintf("%s: data center %s is in region %s, not %s", subject, secondaryDataCenterName, secondaryRegion, region))
+			return
+		}
+		networkHaPair := NetworkHaPair{
+			PrimaryDataCenterName:   primary.dataCenterName,
+			PrimaryUplinkSpeed:      stringValue(primaryDataCenter.UplinkSpeed),
+			SecondaryDataCenterName: secondaryDataCenterName,
+			SecondaryUplinkSpeed:    stringValue(secondaryDataCenter.UplinkSpeed),
+			SecondaryPvdcID:         secondaryPvdcID,
+		}
+		if networkHaPair.PrimaryUplinkSpeed != networkHaPair.SecondaryUplinkSpeed {
+			networkHaPair.Warnings = append(networkHaPair.Warnings, fmt.Sprintf("the uplink speeds of data centers %s and %s differ (%s and %s)",
+				primary.dataCenterName, secondaryDataCenterName, networkHaPair.PrimaryUplinkSpeed, networkHaPair.SecondaryUplinkSpeed))
+		}
+		plan.Pairs = append(plan.Pairs, networkHaPair)
+	}
+
+	if stretchedDataCenters := clusterDataCenters(primary.pvdc); len(stretchedDataCenters) > 1 {
+		plan.Stretched = true
+		for _, dataCenterName := range stretchedDataCenters {
+			if dataCenterName != primary.dataCenterName {
+				pair(dataCenterName, "", fmt.Sprintf("clusters of resource pool %s", pvdcID))
+			}
+		}
+	} else {
+		for _, other := range sitePools {
+			subject := fmt.Sprintf("resource pool %s", other.id)
+			switch {
+			case other.id == pvdcID:
+			case other.dataCenterName == primary.dataCenterName:
+				plan.Exclusions = append(plan.Exclusions, fmt.Sprintf("%s: in the same data center %s", subject, other.dataCenterName))
+			case !other.ready:
+				plan.Exclusions = append(plan.Exclusions, fmt.Sprintf("%s: not ready to use", subject))
+			default:
+				pair(other.dataCenterName, other.id, subject)
+			}
+		}
+	}
+	sort.SliceStable(plan.Pairs, func(i, j int) bool {
+		a, b := plan.Pairs[i], plan.Pairs[j]
+		if a.SecondaryDataCenterName != b.SecondaryDataCenterName {
+			return a.SecondaryDataCenterName < b.SecondaryDataCenterName
+		}
+		return a.SecondaryPvdcID < b.SecondaryPvdcID
+	})
+	return plan, nil
+}
+
+// clusterDataCenters returns the data centers of the clusters of a resource pool of a single-tenant site, sorted.
+// Multitenant resource pools do not describe their clusters.
+func clusterDataCenters(pvdc *PVDC) []string {
+	dataCenters := []string{}
+	if pvdc == nil {
+		return dataCenters
+	}
+	for _, cluster := range pvdc.Clusters {
+		dataCenterName := stringValue(cluster.DataCenterName)
+		if dataCenterName != "" && !containsString(dataCenters, dataCenterName) {
+			dataCenters = append(dataCenters, dataCenterName)
+		}
+	}
+	sort.Strings(dataCenters)
+	return dataCenters
+}
+
+// findDataCenter returns the data center named "name" and its region, or nil when the catalog does not list it.
+func (inventory *Inventory) findDataCenter(name string) (*DataCenter, string) {
+	for _, region := range inventory.DirectorSiteRegions {
+		for i := range region.DataCenters {
+			if stringValue(region.DataCenters[i].Name) == name {
+				return &region.DataCenters[i], stringValue(region.Name)
+			}
+		}
+	}
+	return nil, ""
+}
+
+// Pair returns the valid pair whose secondary is the data center or resource pool "secondary", or an error listing
+// the valid secondaries.
+func (plan *NetworkHaPlan) Pair(secondary string) (*NetworkHaPair, error) {
+	for i := range plan.Pairs {
+		if plan.Pairs[i].SecondaryDataCenterName == secondary || (secondary != "" && plan.Pairs[i].SecondaryPvdcID == secondary) {
+			return &plan.Pairs[i], nil
+		}
+	}
+	err := fmt.Errorf("%s is not a valid network HA secondary of resource pool %s; %s", secondary, plan.PvdcID, plan.validSecondaries())
+	return nil, core.SDKErrorf(err, "", "network-ha-invalid-pair", common.GetComponentInfo())
+}
+
+// NetworkHa builds the network HA of an edge prototype whose secondary is the data center or resource pool
+// "secondary", with the variant that the primary resource pool takes.
+func (plan *NetworkHaPlan) NetworkHa(secondary string) (VDCEdgePrototypeNetworkHaIntf, error) {
+	pair, err := plan.Pair(secondary)
+	if err != nil {
+		return nil, err
+	}
+	return pair.NetworkHa(), nil
+}
+
+// validSecondaries describes the secondaries of the valid pairs, for error messages.
+func (plan *NetworkHaPlan) validSecondaries() string {
+	if len(plan.Pairs) == 0 {
+		if len(plan.Exclusions) == 0 {
+			return "no secondary is available"
+		}
+		return "no secondary is available (" + strings.Join(plan.Exclusions, "; ") + ")"
+	}
+	secondaries := []string{}
+	for _, pair := range plan.Pairs {
+		if pair.SecondaryPvdcID != "" {
+			secondaries = append(secondaries, fmt.Sprintf("resource pool %s in %s", pair.SecondaryPvdcID, pair.SecondaryDataCenterName))
+		} else {
+			secondaries = append(secondaries, "data center "+pair.SecondaryDataCenterName)
+		}
+	}
+	return "valid secondaries: " + strings.Join(secondaries, ", ")
+}
+
+// Validate checks the network HA of an edge prototype for a virtual data center deployed in the primary resource
+// pool. An edge without network HA is valid. The error lists every problem found.
+func (plan *NetworkHaPlan) Validate(edge *VDCEdgePrototype) error {
+	if edge == nil || edge.NetworkHa == nil {
+		return nil
+	}
+	problems := []error{}
+	if stringValue(edge.Type) != VDCEdgePrototype_Type_Performance {
+		problems = append(problems, fmt.Errorf("network HA requires a %s edge, not %q", VDCEdgePrototype_Type_Performance, stringValue(edge.Type)))
+	}
+
+	var primaryDataCenterName, secondaryDataCenterName, secondaryPvdcID *string
+	switch networkHa := edge.NetworkHa.(type) {
+	case *VDCEdgePrototypeNetworkHaNetworkHaOnStretched:
+		primaryDataCenterName, secondaryDataCenterName = networkHa.PrimaryDataCenterName, networkHa.SecondaryDataCenterName
+	case *VDCEdgePrototypeNetworkHaNetworkHaOnNonStretched:
+		secondaryPvdcID = networkHa.SecondaryPvdcID
+	case *VDCEdgePrototypeNetworkHa:
+		primaryDataCenterName, secondaryDataCenterName, secondaryPvdcID = networkHa.PrimaryDataCenterName, networkHa.SecondaryDataCenterName, networkHa.SecondaryPvdcID
+	default:
+		problems = append(problems, fmt.Errorf("unsupported network HA %T", networkHa))
+		return core.SDKErrorf(errors.Join(problems...), "", "network-ha-invalid", common.GetComponentInfo())
+	}
+
+	stretched := primaryDataCenterName != nil || secondaryDataCenterName != nil
+	switch {
+	case stretched && secondaryPvdcID != nil:
+		problems = append(problems, errors.New("the network HA sets both data center names, for a stretched resource pool, and a secondary resource pool, for a non-stretched one"))
+	case stretched:
+		problems = append(problems, plan.validateStretched(stringValue(primaryDataCenterName), stringValue(secondaryDataCenterName))...)
+	case secondaryPvdcID != nil:
+		problems = append(problems, plan.validateNonStretched(stringValue(secondaryPvdcID))...)
+	default:
+		problems = append(problems, errors.New("the network HA sets neither data center names nor a secondary resource pool"))
+	}
+
+	if len(problems) > 0 {
+		err := errors.Join(problems...)
+		return core.SDKErrorf(err, "", "network-ha-invalid", common.GetComponentInfo())
+	}
+	return nil
+}
+
+func (plan *NetworkHaPlan) validateStretched(primaryDataCenterName string, secondaryDataCenterName string) (problems []error) {
+	if !plan.Stretched {
+		return []error{fmt.Errorf("resource pool %s is not stretched across data centers: set a secondary resource pool instead of data center names; %s",
+			plan.PvdcID, plan.validSecondaries())}
+	}
+	if primaryDataCenterName == "" || secondaryDataCenterName == "" {
+		problems = append(problems, errors.New("a stretched network HA requires the primary and the secondary data center names"))
+		return
+	}
+	if primaryDataCenterName != plan.PrimaryDataCenterName {
+		problems = append(problems, fmt.Errorf("the primary data center must be %s, the data center of resource pool %s, not %s",
+			plan.PrimaryDataCenterName, plan.PvdcID, primaryDataCenterName))
+	}
+	if secondaryDataCenterName == primaryDataCenterName {
+		problems = append(problems, fmt.Errorf("the primary and secondary data centers are both %s", secondaryDataCenterName))
+	} else if _, err := plan.Pair(secondaryDataCenterName); err != nil {
+		problems = append(problems, fmt.Errorf("data center %s is not paired with %s: %s", secondaryDataCenterName, plan.PrimaryDataCenterName, plan.validSecondaries()))
+	}
+	return
+}
+
+func (plan *NetworkHaPlan) validateNonStretched(secondaryPvdcID string) (problems []error) {
+	if plan.Stretched {
+		return []error{fmt.Errorf("resource pool %s is stretched across data centers: set the primary and secondary data center names instead of a secondary resource pool; %s",
+			plan.PvdcID, plan.validSecondaries())}
+	}
+	if secondaryPvdcID == plan.PvdcID {
+		return []error{fmt.Errorf("the secondary resource pool cannot be the primary resource pool %s", plan.PvdcID)}
+	}
+	for _, pair := range plan.Pairs {
+		if pair.SecondaryPvdcID == secondaryPvdcID {
+			return nil
+		}
+	}
+	return []error{fmt.Errorf("resource pool %s is not a valid secondary of resource pool %s: %s", secondaryPvdcID, plan.PvdcID, plan.validSecondaries())}
+}
@@ -0,0 +1,203 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package vmwarev1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vmware-go-sdk/vmwarev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Network HA planner`, func() {
+	const (
+		directorSitesJSON = `[{"id": "site1", "name": "dedicated", "type": "single_tenant", "status": "ready_to_use", "pvdcs": [
+			{"id": "pvdc1", "name": "pvdc1", "data_center_name": "dal10", "status": "ready_to_use", "clusters": [{"id": "cluster1", "data_center_name": "dal10"}]},
+			{"id": "pvdc2", "name": "pvdc2", "data_center_name": "dal12", "status": "ready_to_use", "clusters": []},
+			{"id": "pvdc3", "name": "pvdc3", "data_center_name": "dal13", "status": "ready_to_use", "clusters": []},
+			{"id": "pvdc4", "name": "pvdc4", "data_center_name": "dal10", "status": "ready_to_use", "clusters": []},
+			{"id": "pvdc5", "name": "pvdc5", "data_center_name": "fra02", "status": "ready_to_use", "clusters": []},
+			{"id": "pvdc6", "name": "pvdc6", "data_center_name": "dal12", "status": "creating", "clusters": []},
+			{"id": "stretched", "name": "stretched", "data_center_name": "dal10", "status": "ready_to_use", "clusters": [
+				{"id": "cluster7", "data_center_name": "dal10"}, {"id": "cluster8", "data_center_name": "dal12"}, {"id": "cluster9", "data_center_name": "dal12"}]}]}]`
+		regionsJSON = `[
+			{"name": "us-south", "endpoint": "Endpoint", "data_centers": [{"display_name": "Dallas 10", "name": "dal10", "uplink_speed": "10g"}, {"display_name": "Dallas 12", "name": "dal12", "uplink_speed": "10g"}, {"display_name": "Dallas 13", "name": "dal13", "uplink_speed": "1g"}]},
+			{"name": "eu-de", "endpoint": "Endpoint", "data_centers": [{"display_name": "Frankfurt 2", "name": "fra02", "uplink_speed": "10g"}]}]`
+		multitenantSitesJSON = `[{"id": "mt1", "name": "mt1", "display_name": "Dallas multitenant", "private_only": false, "region": "us-south", "services": [], "pvdcs": [
+			{"id": "pvdcA", "name": "pvdcA", "data_center_name": "dal10", "private_only": false, "provider_types": [{"name": "on_demand"}]},
+			{"id": "pvdcB", "name": "pvdcB", "data_center_name": "dal12", "private_only": false, "provider_types": [{"name": "on_demand"}]}]}]`
+	)
+
+	readInventory := func() *vmwarev1.Inventory {
+		inventory, err := vmwarev1.ReadInventory(strings.NewReader(fmt.Sprintf(
+			`{"director_sites": %s, "vdcs": [], "director_site_regions": %s, "multitenant_director_sites": %s}`,
+			directorSitesJSON, regionsJSON, multitenantSitesJSON)))
+		Expect(err).To(BeNil())
+		return inventory
+	}
+	performanceEdge := func(networkHa vmwarev1.VDCEdgePrototypeNetworkHaIntf) *vmwarev1.VDCEdgePrototype {
+		return &vmwarev1.VDCEdgePrototype{Type: core.StringPtr("performance"), Size: core.StringPtr("medium"), NetworkHa: networkHa}
+	}
+
+	It(`Enumerates the pairs of non-stretched and stretched resource pools`, func() {
+		inventory := readInventory()
+
+		plan, err := inventory.PlanNetworkHa("site1", "pvdc1")
+		Expect(err).To(BeNil())
+		Expect(plan.Stretched).To(BeFalse())
+		Expect(plan.Region).To(Equal("us-south"))
+		Expect(plan.Pairs).To(Equal([]vmwarev1.NetworkHaPair{
+			{PrimaryDataCenterName: "dal10", PrimaryUplinkSpeed: "10g", SecondaryDataCenterName: "dal12", SecondaryUplinkSpeed: "10g", SecondaryPvdcID: "pvdc2"},
+			{PrimaryDataCenterName: "dal10", PrimaryUplinkSpeed: "10g", SecondaryDataCenterName: "dal13", SecondaryUplinkSpeed: "1g", SecondaryPvdcID: "pvdc3",
+				Warnings: []string{"the uplink speeds of data centers dal10 and dal13 differ (10g and 1g)"}},
+		}))
+		Expect(plan.Exclusions).To(Equal([]string{
+			"resource pool pvdc4: in the same data center dal10",
+			"resource pool pvdc5: data center fra02 is in region eu-de, not us-south",
+			"resource pool pvdc6: not ready to use",
+			"resource pool stretched: in the same data center dal10",
+		}))
+		networkHa, err := plan.NetworkHa("dal13")
+		Expect(err).To(BeNil())
+		Expect(networkHa).To(Equal(&vmwarev1.VDCEdgePrototypeNetworkHaNetworkHaOnNonStretched{SecondaryPvdcID: core.StringPtr("pvdc3")}))
+		_, err = plan.NetworkHa("fra02")
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("valid secondaries: resource pool pvdc2 in dal12, resource pool pvdc3 in dal13"))
+
+		plan, err = inventory.PlanNetworkHa("site1", "stretched")
+		Expect(err).To(BeNil())
+		Expect(plan.Stretched).To(BeTrue())
+		Expect(plan.Pairs).To(HaveLen(1))
+		networkHa, err = plan.NetworkHa("dal12")
+		Expect(err).To(BeNil())
+		Expect(networkHa).To(Equal(&vmwarev1.VDCEdgePrototypeNetworkHaNetworkHaOnStretched{
+			PrimaryDataCenterName:   core.StringPtr("dal10"),
+			SecondaryDataCenterName: core.StringPtr("dal12"),
+		}))
+
+		plan, err = inventory.PlanNetworkHa("mt1", "pvdcB")
+		Expect(err).To(BeNil())
+		Expect(plan.Pairs[0].SecondaryPvdcID).To(Equal("pvdcA"))
+
+		_, err = inventory.PlanNetworkHa("site9", "pvdc1")
+		Expect(err).ToNot(BeNil())
+		_, err = inventory.PlanNetworkHa("site1", "pvdc9")
+		Expect(err).ToNot(BeNil())
+	})
+
+	It(`Validates edge prototypes with explanatory errors`, func() {
+		inventory := readInventory()
+		plan, err := inventory.PlanNetworkHa("site1", "pvdc1")
+		Expect(err).To(BeNil())
+		stretchedPlan, err := inventory.PlanNetworkHa("site1", "stretched")
+		Expect(err).To(BeNil())
+
+		Expect(plan.Validate(nil)).To(Succeed())
+		Expect(plan.Validate(&vmwarev1.VDCEdgePrototype{Type: core.StringPtr("efficiency")})).To(Succeed())
+		Expect(plan.Validate(performanceEdge(&vmwarev1.VDCEdgePrototypeNetworkHaNetworkHaOnNonStretched{SecondaryPvdcID: core.StringPtr("pvdc2")}))).To(Succeed())
+		Expect(stretchedPlan.Validate(performanceEdge(&vmwarev1.VDCEdgePrototypeNetworkHaNetworkHaOnStretched{
+			PrimaryDataCenterName:   core.StringPtr("dal10"),
+			SecondaryDataCenterName: core.StringPtr("dal12"),
+		}))).To(Succeed())
+		// The generic model that responses unmarshal to is accepted too.
+		Expect(plan.Validate(performanceEdge(&vmwarev1.VDCEdgePrototypeNetworkHa{SecondaryPvdcID: core.StringPtr("pvdc3")}))).To(Succeed())
+
+		err = plan.Validate(&vmwarev1.VDCEdgePrototype{
+			Type:      core.StringPtr("efficiency"),
+			NetworkHa: &vmwarev1.VDCEdgePrototypeNetworkHaNetworkHaOnNonStretched{SecondaryPvdcID: core.StringPtr("pvdc5")},
+		})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring(`network HA requires a performance edge, not "efficiency"`))
+		Expect(err.Error()).To(ContainSubstring("resource pool pvdc5 is not a valid secondary of resource pool pvdc1"))
+
+		for edge, message := range map[*vmwarev1.VDCEdgePrototype]string{
+			performanceEdge(&vmwarev1.VDCEdgePrototypeNetworkHaNetworkHaOnStretched{
+				PrimaryDataCenterName:   core.StringPtr("dal10"),
+				SecondaryDataCenterName: core.StringPtr("dal12"),
+			}): "resource pool pvdc1 is not stretched across data centers",
+			performanceEdge(&vmwarev1.VDCEdgePrototypeNetworkHaNetworkHaOnNonStretched{SecondaryPvdcID: core.StringPtr("pvdc1")}): "cannot be the primary resource pool pvdc1",
+			performanceEdge(&vmwarev1.VDCEdgePrototypeNetworkHa{
+				PrimaryDataCenterName: core.StringPtr("dal10"),
+				SecondaryPvdcID:       core.StringPtr("pvdc2"),
+			}): "sets both data center names",
+			performanceEdge(&vmwarev1.VDCEdgePrototypeNetworkHa{}): "sets neither data center names nor a secondary resource pool",
+		} {
+			err = plan.Validate(edge)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring(message))
+		}
+
+		for edge, message := range map[*vmwarev1.VDCEdgePrototype]string{
+			performanceEdge(&vmwarev1.VDCEdgePrototypeNetworkHaNetworkHaOnStretched{
+				PrimaryDataCenterName:   core.StringPtr("dal12"),
+				SecondaryDataCenterName: core.StringPtr("dal10"),
+			}): "the primary data center must be dal10, the data center of resource pool stretched, not dal12",
+			performanceEdge(&vmwarev1.VDCEdgePrototypeNetworkHaNetworkHaOnStretched{
+				PrimaryDataCenterName:   core.StringPtr("dal10"),
+				SecondaryDataCenterName: core.StringPtr("dal13"),
+			}): "data center dal13 is not paired with dal10: valid secondaries: data center dal12",
+			performanceEdge(&vmwarev1.VDCEdgePrototypeNetworkHaNetworkHaOnStretched{PrimaryDataCenterName: core.StringPtr("dal10")}): "requires the primary and the secondary data center names",
+			performanceEdge(&vmwarev1.VDCEdgePrototypeNetworkHaNetworkHaOnNonStretched{SecondaryPvdcID: core.StringPtr("pvdc2")}):    "resource pool stretched is stretched across data centers",
+		} {
+			err = stretchedPlan.Validate(edge)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring(message))
+		}
+	})
+
+	It(`Plans from live calls`, func() {
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			switch req.URL.Path {
+			case "/director_sites":
+				fmt.Fprintf(res, `{"director_sites": %s}`, directorSitesJSON)
+			case "/vdcs":
+				fmt.Fprint(res, `{"vdcs": []}`)
+			case "/director_site_regions":
+				fmt.Fprintf(res, `{"director_site_regions": %s}`, regionsJSON)
+			case "/multitenant_director_sites":
+				fmt.Fprintf(res, `{"multitenant_director_sites": %s}`, multitenantSitesJSON)
+			case "/director_site_host_profiles":
+				fmt.Fprint(res, `{"director_site_host_profiles": []}`)
+			default:
+				Fail("unexpected request " + req.URL.Path)
+			}
+		}))
+		defer testServer.Close()
+		vmwareService, serviceErr := vmwarev1.NewVmwareV1(&vmwarev1.VmwareV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+
+		plan, err := vmwareService.PlanNetworkHa(vmwareService.NewPlanNetworkHaOptions("site1", "pvdc2"))
+		Expect(err).To(BeNil())
+		Expect(plan.PrimaryDataCenterName).To(Equal("dal12"))
+		Expect(plan.Pairs).To(HaveLen(4))
+
+		_, err = vmwareService.PlanNetworkHa(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = vmwareService.PlanNetworkHa(vmwareService.NewPlanNetworkHaOptions("", "pvdc2"))
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,766 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vmwarev1
+
+// Most operations that change resources return as soon as the change is accepted, while the resources are still
+// creating, updating or deleting. The Start methods below send the same requests as the operations they are named
+// after, and return an Operation that tracks the change until it completes: Poll checks the resource once, Wait polls
+// it until the change completes, and Done, Result and Err report the outcome.
+//
+// An Operation can be marshalled to JSON, so that another process can resume tracking it with
+// VmwareV1.ResumeOperation.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vmware-go-sdk/common"
+)
+
+// Constants associated with the Operation.Type property.
+// The change tracked by the operation, which determines the type of its result.
+const (
+	// Result: *DirectorSite.
+	Operation_Type_CreateDirectorSite = "create_director_site"
+	Operation_Type_DeleteDirectorSite = "delete_director_site"
+
+	// Result: *Cluster.
+	Operation_Type_CreateCluster = "create_cluster"
+	Operation_Type_UpdateCluster = "update_cluster"
+
+	// Result: *VDC.
+	Operation_Type_CreateVdc = "create_vdc"
+	Operation_Type_UpdateVdc = "update_vdc"
+	Operation_Type_DeleteVdc = "delete_vdc"
+
+	// Result: *Service.
+	Operation_Type_EnableVcda  = "enable_vcda"
+	Operation_Type_DisableVcda = "disable_vcda"
+
+	// Result: *TransitGateway.
+	Operation_Type_AddTransitGateway = "add_transit_gateway"
+
+	// Result: *Edge.
+	Operation_Type_SwapHaEdgeSites = "swap_ha_edge_sites"
+)
+
+// Constants associated with the Operation.Status method.
+const (
+	Operation_Status_Running   = "running"
+	Operation_Status_Succeeded = "succeeded"
+	Operation_Status_Failed    = "failed"
+)
+
+// Operation : A change of a resource that completes asynchronously.
+type Operation struct {
+	// The type of the operation.
+	Type string `json:"type"`
+
+	// The ID of the operation reported by the API, if any.
+	ID string `json:"id,omitempty"`
+
+	// The message returned by the API when the change was accepted, if any.
+	Message string `json:"message,omitempty"`
+
+	// The IDs of the resources that the operation changes.
+	SiteID           string `json:"site_id,omitempty"`
+	PvdcID           string `json:"pvdc_id,omitempty"`
+	ClusterID        string `json:"cluster_id,omitempty"`
+	VdcID            string `json:"vdc_id,omitempty"`
+	EdgeID           string `json:"edge_id,omitempty"`
+	TransitGatewayID string `json:"transit_gateway_id,omitempty"`
+
+	// When the change was accepted.
+	StartedAt time.Time `json:"started_at"`
+
+	// Controls how Wait polls the resource.
+	WaitOptions *WaitOptions `json:"-"`
+
+	service *VmwareV1
+
+	mutex  sync.Mutex
+	status string
+	result interface{}
+	err    error
+
+	// The patch sent by an update, and whether the resource was seen leaving the ready_to_use status since: an update
+	// completes once the resource is ready to use again after either, or shows the patched values.
+	patch          map[string]interface{}
+	changeObserved bool
+}
+
+// operationJSON is the format of a marshalled Operation.
+type operationJSON struct {
+	operationFields
+	Status         string                 `json:"status"`
+	Error          string                 `json:"error,omitempty"`
+	Result         json.RawMessage        `json:"result,omitempty"`
+	Patch          map[string]interface{} `json:"patch,omitempty"`
+	ChangeObserved bool                   `json:"change_observed,omitempty"`
+}
+
+// operationFields has the exported fields of Operation but none of its methods.
+type operationFields struct {
+	Type             string    `json:"type"`
+	ID               string    `json:"id,omitempty"`
+	Message          string    `json:"message,omitempty"`
+	SiteID           string    `json:"site_id,omitempty"`
+	PvdcID           string    `json:"pvdc_id,omitempty"`
+	ClusterID        string    `json:"cluster_id,omitempty"`
+	VdcID            string    `json:"vdc_id,omitempty"`
+	EdgeID           string    `json:"edge_id,omitempty"`
+	TransitGatewayID string    `json:"transit_gateway_id,omitempty"`
+	StartedAt        time.Time `json:"started_at"`
+}
+
+func (vmware *VmwareV1) newOperation(operationType string, result interface{}) *Operation {
+	return &Operation{
+		Type:      operationType,
+		StartedAt: time.Now().UTC(),
+		service:   vmware,
+		status:    Operation_Status_Running,
+		result:    result,
+	}
+}
+
+// ResumeOperation returns the operation marshalled in "data", so that it can be polled with the service.
+func (vmware *VmwareV1) ResumeOperation(data []byte) (*Operation, error) {
+	operation := &Operation{}
+	err := json.Unmarshal(data, operation)
+	if err != nil {
+		return nil, core.SDKErrorf(err, fmt.Sprintf("invalid operation: %s", err.Error()), "operation-unmarshal-error", common.GetComponentInfo())
+	}
+	operation.service = vmware
+	return operation, nil
+}
+
+// MarshalJSON returns the operation as JSON, including its status, its error and its last observed result.
+func (operation *Operation) MarshalJSON() ([]byte, error) {
+	operation.mutex.Lock()
+	defer operation.mutex.Unlock()
+	data := operationJSON{
+		operationFields: operationFields{
+			Type:             operation.Type,
+			ID:               operation.ID,
+			Message:          operation.Message,
+			SiteID:           operation.SiteID,
+			PvdcID:           operation.PvdcID,
+			ClusterID:        operation.ClusterID,
+			VdcID:            operation.VdcID,
+			EdgeID:           operation.EdgeID,
+			TransitGatewayID: operation.TransitGatewayID,
+			StartedAt:        operation.StartedAt,
+		},
+		Status:         operation.status,
+		Patch:          operation.patch,
+		ChangeObserved: operation.changeObserved,
+	}
+	if operation.err != nil {
+		data.Error = operation.err.Error()
+	}
+	if operation.result != nil {
+		result, err := json.Marshal(operation.result)
+		if err != nil {
+			return nil, err
+		}
+		data.Result = result
+	}
+	return json.Marshal(data)
+}
+
+// UnmarshalJSON reads an operation marshalled with MarshalJSON. The operation must be resumed with
+// VmwareV1.ResumeOperation to be polled.
+func (operation *Operation) UnmarshalJSON(buf []byte) error {
+	data := operationJSON{}
+	err := json.Unmarshal(buf, &data)
+	if err != nil {
+		return err
+	}
+	var result interface{}
+	if len(data.Result) > 0 && string(data.Result) != "null" {
+		result, err = unmarshalOperationResult(data.Type, data.Result)
+		if err != nil {
+			return err
+		}
+	}
+
+	operation.mutex.Lock()
+	defer operation.mutex.Unlock()
+	fields := data.operationFields
+	operation.Type = fields.Type
+	operation.ID = fields.ID
+	operation.Message = fields.Message
+	operation.SiteID = fields.SiteID
+	operation.PvdcID = fields.PvdcID
+	operation.ClusterID = fields.ClusterID
+	operation.VdcID = fields.VdcID
+	operation.EdgeID = fields.EdgeID
+	operation.TransitGatewayID = fields.TransitGatewayID
+	operation.StartedAt = fields.StartedAt
+	operation.status = data.Status
+	if operation.status == "" {
+		operation.status = Operation_Status_Running
+	}
+	operation.err = nil
+	if data.Error != "" {
+		operation.err = core.SDKErrorf(nil, data.Error, "operation-failed", common.GetComponentInfo())
+	}
+	operation.result = result
+	operation.patch = data.Patch
+	operation.changeObserved = data.ChangeObserved
+	return nil
+}
+
+// unmarshalOperationResult decodes the result of an operation of type "operationType".
+func unmarshalOperationResult(operationType string, data json.RawMessage) (result interface{}, err error) {
+	var m map[string]json.RawMessage
+	err = json.Unmarshal(data, &m)
+	if err != nil {
+		return
+	}
+	switch operationType {
+	case Operation_Type_CreateDirectorSite, Operation_Type_DeleteDirectorSite:
+		var directorSite *DirectorSite
+		err = core.UnmarshalModel(m, "", &directorSite, UnmarshalDirectorSite)
+		result = directorSite
+	case Operation_Type_CreateCluster, Operation_Type_UpdateCluster:
+		var cluster *Cluster
+		err = core.UnmarshalModel(m, "", &cluster, UnmarshalCluster)
+		result = cluster
+	case Operation_Type_CreateVdc, Operation_Type_UpdateVdc, Operation_Type_DeleteVdc:
+		var vdc *VDC
+		err = core.UnmarshalModel(m, "", &vdc, UnmarshalVDC)
+		result = vdc
+	case Operation_Type_EnableVcda, Operation_Type_DisableVcda:
+		var service *Service
+		err = core.UnmarshalModel(m, "", &service, UnmarshalService)
+		result = service
+	case Operation_Type_AddTransitGateway:
+		var transitGateway *TransitGateway
+		err = core.UnmarshalModel(m, "", &transitGateway, UnmarshalTransitGateway)
+		result = transitGateway
+	case Operation_Type_SwapHaEdgeSites:
+		var edge *Edge
+		err = core.UnmarshalModel(m, "", &edge, UnmarshalEdge)
+		result = edge
+	default:
+		err = fmt.Errorf("unknown operation type '%s'", operationType)
+	}
+	return
+}
+
+// Status returns the status of the operation: running, succeeded or failed.
+func (operation *Operation) Status() string {
+	operation.mutex.Lock()
+	defer operation.mutex.Unlock()
+	return operation.status
+}
+
+// Done returns true if the operation succeeded or failed.
+func (operation *Operation) Done() bool {
+	return operation.Status() != Operation_Status_Running
+}
+
+// Result returns the resource as it was last observed, or nil. Its type depends on the type of the operation.
+func (operation *Operation) Result() interface{} {
+	operation.mutex.Lock()
+	defer operation.mutex.Unlock()
+	return operation.result
+}
+
+// Err returns the error of a failed operation, or nil.
+func (operation *Operation) Err() error {
+	operation.mutex.Lock()
+	defer operation.mutex.Unlock()
+	return operation.err
+}
+
+// Poll checks the resource once and returns true if the operation is done. An error that prevents the check, such as
+// a network error, is returned without failing the operation, which can be polled again.
+func (operation *Operation) Poll(ctx context.Context) (done bool, err error) {
+	if operation.Done() {
+		return true, nil
+	}
+	if operation.service == nil {
+		err = core.SDKErrorf(nil, "the operation is not attached to a service: use VmwareV1.ResumeOperation", "operation-not-attached", common.GetComponentInfo())
+		return
+	}
+
+	result, status, failure, err := operation.observe(ctx)
+	if err != nil {
+		return
+	}
+	operation.mutex.Lock()
+	defer operation.mutex.Unlock()
+	if result != nil {
+		operation.result = result
+	}
+	operation.status = status
+	if status == Operation_Status_Failed {
+		operation.err = core.SDKErrorf(nil, fmt.Sprintf("operation %s failed: %s", operation.Type, failure), "operation-failed", common.GetComponentInfo())
+	}
+	return status != Operation_Status_Running, nil
+}
+
+// Wait polls the resource until the operation is done, and returns the error of the operation if it failed. It gives
+// up when the timeout of the WaitOptions of the operation elapses or "ctx" is done, leaving the operation running.
+func (operation *Operation) Wait(ctx context.Context) error {
+	description := fmt.Sprintf("operation %s to complete", operation.Type)
+	err := waitUntil(ctx, operation.WaitOptions, description, operation.Poll)
+	if err != nil {
+		return err
+	}
+	return operation.Err()
+}
+
+// observe returns the current state of the resource of the operation: the resource itself, the status of the
+// operation and, if it failed, the reason.
+func (operation *Operation) observe(ctx context.Context) (result interface{}, status string, failure string, err error) {
+	vmware := operation.service
+	status = Operation_Status_Running
+	switch operation.Type {
+	case Operation_Type_CreateDirectorSite, Operation_Type_DeleteDirectorSite:
+		var directorSite *DirectorSite
+		var response *core.DetailedResponse
+		directorSite, response, err = vmware.GetDirectorSiteWithContext(ctx, vmware.NewGetDirectorSiteOptions(operation.SiteID))
+		if operation.Type == Operation_Type_DeleteDirectorSite && isNotFound(response) {
+			return nil, Operation_Status_Succeeded, "", nil
+		}
+		if err != nil {
+			return
+		}
+		result = directorSite
+		switch stringValue(directorSite.Status) {
+		case DirectorSite_Status_ReadyToUse:
+			if operation.Type == Operation_Type_CreateDirectorSite {
+				status = Operation_Status_Succeeded
+			}
+		case DirectorSite_Status_Deleted:
+			if operation.Type == Operation_Type_DeleteDirectorSite {
+				status = Operation_Status_Succeeded
+			} else {
+				status, failure = Operation_Status_Failed, "the Cloud Director site was deleted"
+			}
+		}
+
+	case Operation_Type_CreateCluster, Operation_Type_UpdateCluster:
+		var cluster *Cluster
+		options := vmware.NewGetDirectorInstancesPvdcsClusterOptions(operation.SiteID, operation.ClusterID, operation.PvdcID)
+		cluster, _, err = vmware.GetDirectorInstancesPvdcsClusterWithContext(ctx, options)
+		if err != nil {
+			return
+		}
+		result = cluster
+		switch stringValue(cluster.Status) {
+		case "ready_to_use":
+			status = Operation_Status_Succeeded
+			if operation.Type == Operation_Type_UpdateCluster {
+				status = operation.updateStatus(true, cluster)
+			}
+		case "failed", "deleted":
+			status, failure = Operation_Status_Failed, fmt.Sprintf("the cluster is %s", *cluster.Status)
+		default:
+			if operation.Type == Operation_Type_UpdateCluster {
+				status = operation.updateStatus(false, cluster)
+			}
+		}
+
+	case Operation_Type_CreateVdc, Operation_Type_UpdateVdc, Operation_Type_DeleteVdc:
+		var vdc *VDC
+		var response *core.DetailedResponse
+		vdc, response, err = vmware.GetVdcWithContext(ctx, vmware.NewGetVdcOptions(operation.VdcID))
+		if operation.Type == Operation_Type_DeleteVdc && isNotFound(response) {
+			return nil, Operation_Status_Succeeded, "", nil
+		}
+		if err != nil {
+			return
+		}
+		result = vdc
+		switch stringValue(vdc.Status) {
+		case VDC_Status_ReadyToUse:
+			if operation.Type == Operation_Type_CreateVdc {
+				status = Operation_Status_Succeeded
+			} else if operation.Type == Operation_Type_UpdateVdc {
+				status = operation.updateStatus(true, vdc)
+			}
+		case VDC_Status_Deleted:
+			if operation.Type == Operation_Type_DeleteVdc {
+				status = Operation_Status_Succeeded
+			} else {
+				status, failure = Operation_Status_Failed, "the virtual data center was deleted"
+			}
+		case VDC_Status_Failed:
+			status, failure = Operation_Status_Failed, "the virtual data center failed"
+			reasons := make([]string, 0, len(vdc.StatusReasons))
+			for _, reason := range vdc.StatusReasons {
+				reasons = append(reasons, fmt.Sprintf("%s (%s)", stringValue(reason.Message), stringValue(reason.Code)))
+			}
+			if len(reasons) > 0 {
+				failure += ": " + strings.Join(reasons, "; ")
+			}
+		default:
+			if operation.Type == Operation_Type_UpdateVdc {
+				status = operation.updateStatus(false, vdc)
+			}
+		}
+
+	case Operation_Type_EnableVcda, Operation_Type_DisableVcda:
+		var directorSite *DirectorSite
+		directorSite, _, err = vmware.GetDirectorSiteWithContext(ctx, vmware.NewGetDirectorSiteOptions(operation.SiteID))
+		if err != nil {
+			return
+		}
+		service := findService(directorSite, Service_Name_Vcda)
+		if service != nil {
+			result = service
+		}
+		serviceStatus := ""
+		if service != nil {
+			serviceStatus = stringValue(service.Status)
+		}
+		if operation.Type == Operation_Type_EnableVcda && serviceStatus == Service_Status_ReadyToUse {
+			status = Operation_Status_Succeeded
+		}
+		if operation.Type == Operation_Type_DisableVcda && (service == nil || serviceStatus == Service_Status_Deleted) {
+			status = Operation_Status_Succeeded
+		}
+
+	case Operation_Type_AddTransitGateway:
+		var vdc *VDC
+		vdc, _, err = vmware.GetVdcWithContext(ctx, vmware.NewGetVdcOptions(operation.VdcID))
+		if err != nil {
+			return
+		}
+		edge := findEdge(vdc, operation.EdgeID)
+		if edge == nil {
+			return nil, Operation_Status_Failed, fmt.Sprintf("edge '%s' disappeared from virtual data center '%s'", operation.EdgeID, operation.VdcID), nil
+		}
+		for i := range edge.TransitGateways {
+			transitGateway := &edge.TransitGateways[i]
+			if stringValue(transitGateway.ID) != operation.TransitGatewayID {
+				continue
+			}
+			result = transitGateway
+			if hasDetachedConnection(transitGateway) {
+				status, failure = Operation_Status_Failed, "a connection of the transit gateway is detached"
+			} else if isTransitGatewaySettled(transitGateway) {
+				status = Operation_Status_Succeeded
+			}
+		}
+
+	case Operation_Type_SwapHaEdgeSites:
+		var vdc *VDC
+		vdc, _, err = vmware.GetVdcWithContext(ctx, vmware.NewGetVdcOptions(operation.VdcID))
+		if err != nil {
+			return
+		}
+		edge := findEdge(vdc, operation.EdgeID)
+		if edge == nil {
+			return nil, Operation_Status_Failed, fmt.Sprintf("edge '%s' disappeared from virtual data center '%s'", operation.EdgeID, operation.VdcID), nil
+		}
+		result = edge
+		if stringValue(vdc.Status) == VDC_Status_Failed {
+			status, failure = Operation_Status_Failed, "the virtual data center failed"
+		} else {
+			ready := stringValue(vdc.Status) == VDC_Status_ReadyToUse && stringValue(edge.Status) == Edge_Status_ReadyToUse
+			status = operation.updateStatus(ready, edge)
+		}
+
+	default:
+		err = core.SDKErrorf(nil, fmt.Sprintf("unknown operation type '%s'", operation.Type), "operation-unknown-type", common.GetComponentInfo())
+	}
+	return
+}
+
+// updateStatus returns the status of an update whose resource is "ready" to use or not. The API may still report the
+// resource as ready to use right after it accepted the update, so the update only succeeds once the resource was seen
+// changing, or shows the patched values.
+func (operation *Operation) updateStatus(ready bool, result interface{}) string {
+	operation.mutex.Lock()
+	defer operation.mutex.Unlock()
+	if !ready {
+		operation.changeObserved = true
+		return Operation_Status_Running
+	}
+	if operation.changeObserved || isPatchApplied(operation.patch, result) {
+		return Operation_Status_Succeeded
+	}
+	return Operation_Status_Running
+}
+
+// isPatchApplied returns true if "patch" is not empty and "result" has all of its values.
+func isPatchApplied(patch map[string]interface{}, result interface{}) bool {
+	if len(patch) == 0 {
+		return false
+	}
+	var expected, actual map[string]interface{}
+	buf, err := json.Marshal(patch)
+	if err == nil {
+		err = json.Unmarshal(buf, &expected)
+	}
+	if err == nil {
+		buf, err = json.Marshal(result)
+	}
+	if err == nil {
+		err = json.Unmarshal(buf, &actual)
+	}
+	if err != nil {
+		return false
+	}
+	for key, value := range expected {
+		if !reflect.DeepEqual(actual[key], value) {
+			return false
+		}
+	}
+	return true
+}
+
+func isNotFound(response *core.DetailedResponse) bool {
+	return response != nil && response.StatusCode == http.StatusNotFound
+}
+
+// StartCreateDirectorSites : Create a Cloud Director site and track its creation
+// Send a CreateDirectorSites request and return an Operation that completes when the Cloud Director site is ready to
+// use.
+func (vmware *VmwareV1) StartCreateDirectorSites(createDirectorSitesOptions *CreateDirectorSitesOptions) (operation *Operation, response *core.DetailedResponse, err error) {
+	operation, response, err = vmware.StartCreateDirectorSitesWithContext(context.Background(), createDirectorSitesOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// StartCreateDirectorSitesWithContext is an alternate form of the StartCreateDirectorSites method which supports a Context parameter
+func (vmware *VmwareV1) StartCreateDirectorSitesWithContext(ctx context.Context, createDirectorSitesOptions *CreateDirectorSitesOptions) (operation *Operation, response *core.DetailedResponse, err error) {
+	result, response, err := vmware.CreateDirectorSitesWithContext(ctx, createDirectorSitesOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "create-director-sites-error")
+		return
+	}
+	operation = vmware.newOperation(Operation_Type_CreateDirectorSite, result)
+	operation.SiteID = stringValue(result.ID)
+	return
+}
+
+// StartDeleteDirectorSite : Delete a Cloud Director site and track its deletion
+// Send a DeleteDirectorSite request and return an Operation that completes when the Cloud Director site is deleted.
+func (vmware *VmwareV1) StartDeleteDirectorSite(deleteDirectorSiteOptions *DeleteDirectorSiteOptions) (operation *Operation, response *core.DetailedResponse, err error) {
+	operation, response, err = vmware.StartDeleteDirectorSiteWithContext(context.Background(), deleteDirectorSiteOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// StartDeleteDirectorSiteWithContext is an alternate form of the StartDeleteDirectorSite method which supports a Context parameter
+func (vmware *VmwareV1) StartDeleteDirectorSiteWithContext(ctx context.Context, deleteDirectorSiteOptions *DeleteDirectorSiteOptions) (operation *Operation, response *core.DetailedResponse, err error) {
+	result, response, err := vmware.DeleteDirectorSiteWithContext(ctx, deleteDirectorSiteOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "delete-director-site-error")
+		return
+	}
+	operation = vmware.newOperation(Operation_Type_DeleteDirectorSite, result)
+	operation.SiteID = *deleteDirectorSiteOptions.ID
+	return
+}
+
+// StartCreateDirectorSitesPvdcsClusters : Create a cluster and track its creation
+// Send a CreateDirectorSitesPvdcsClusters request and return an Operation that completes when the cluster is ready to
+// use.
+func (vmware *VmwareV1) StartCreateDirectorSitesPvdcsClusters(createDirectorSitesPvdcsClustersOptions *CreateDirectorSitesPvdcsClustersOptions) (operation *Operation, response *core.DetailedResponse, err error) {
+	operation, response, err = vmware.StartCreateDirectorSitesPvdcsClustersWithContext(context.Background(), createDirectorSitesPvdcsClustersOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// StartCreateDirectorSitesPvdcsClustersWithContext is an alternate form of the StartCreateDirectorSitesPvdcsClusters method which supports a Context parameter
+func (vmware *VmwareV1) StartCreateDirectorSitesPvdcsClustersWithContext(ctx context.Context, createDirectorSitesPvdcsClustersOptions *CreateDirectorSitesPvdcsClustersOptions) (operation *Operation, response *core.DetailedResponse, err error) {
+	result, response, err := vmware.CreateDirectorSitesPvdcsClustersWithContext(ctx, createDirectorSitesPvdcsClustersOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "create-cluster-error")
+		return
+	}
+	operation = vmware.newOperation(Operation_Type_CreateCluster, result)
+	operation.SiteID = *createDirectorSitesPvdcsClustersOptions.SiteID
+	operation.PvdcID = *createDirectorSitesPvdcsClustersOptions.PvdcID
+	operation.ClusterID = stringValue(result.ID)
+	return
+}
+
+// StartUpdateDirectorSitesPvdcsCluster : Update a cluster and track the update
+// Send an UpdateDirectorSitesPvdcsCluster request and return an Operation that completes when the cluster is ready to
+// use again. The ID of the operation is the operation ID returned by the API.
+func (vmware *VmwareV1) StartUpdateDirectorSitesPvdcsCluster(updateDirectorSitesPvdcsClusterOptions *UpdateDirectorSitesPvdcsClusterOptions) (operation *Operation, response *core.DetailedResponse, err error) {
+	operation, response, err = vmware.StartUpdateDirectorSitesPvdcsClusterWithContext(context.Background(), updateDirectorSitesPvdcsClusterOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// StartUpdateDirectorSitesPvdcsClusterWithContext is an alternate form of the StartUpdateDirectorSitesPvdcsCluster method which supports a Context parameter
+func (vmware *VmwareV1) StartUpdateDirectorSitesPvdcsClusterWithContext(ctx context.Context, updateDirectorSitesPvdcsClusterOptions *UpdateDirectorSitesPvdcsClusterOptions) (operation *Operation, response *core.DetailedResponse, err error) {
+	result, response, err := vmware.UpdateDirectorSitesPvdcsClusterWithContext(ctx, updateDirectorSitesPvdcsClusterOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "update-cluster-error")
+		return
+	}
+	operation = vmware.newOperation(Operation_Type_UpdateCluster, nil)
+	operation.ID = stringValue(result.OperationID)
+	operation.Message = stringValue(result.Message)
+	operation.SiteID = *updateDirectorSitesPvdcsClusterOptions.SiteID
+	operation.PvdcID = *updateDirectorSitesPvdcsClusterOptions.PvdcID
+	operation.ClusterID = *updateDirectorSitesPvdcsClusterOptions.ID
+	operation.patch = updateDirectorSitesPvdcsClusterOptions.Body
+	return
+}
+
+// StartCreateVdc : Create a virtual data center and track its creation
+// Send a CreateVdc request and return an Operation that completes when the virtual data center is ready to use.
+func (vmware *VmwareV1) StartCreateVdc(createVdcOptions *CreateVdcOptions) (operation *Operation, response *core.DetailedResponse, err error) {
+	operation, response, err = vmware.StartCreateVdcWithContext(context.Background(), createVdcOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// StartCreateVdcWithContext is an alternate form of the StartCreateVdc method which supports a Context parameter
+func (vmware *VmwareV1) StartCreateVdcWithContext(ctx context.Context, createVdcOptions *CreateVdcOptions) (operation *Operation, response *core.DetailedResponse, err error) {
+	result, response, err := vmware.CreateVdcWithContext(ctx, createVdcOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "create-vdc-error")
+		return
+	}
+	operation = vmware.newOperation(Operation_Type_CreateVdc, result)
+	operation.VdcID = stringValue(result.ID)
+	return
+}
+
+// StartUpdateVdc : Update a virtual data center and track the update
+// Send an UpdateVdc request and return an Operation that completes when the virtual data center is ready to use
+// again.
+func (vmware *VmwareV1) StartUpdateVdc(updateVdcOptions *UpdateVdcOptions) (operation *Operation, response *core.DetailedResponse, err error) {
+	operation, response, err = vmware.StartUpdateVdcWithContext(context.Background(), updateVdcOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// StartUpdateVdcWithContext is an alternate form of the StartUpdateVdc method which supports a Context parameter
+func (vmware *VmwareV1) StartUpdateVdcWithContext(ctx context.Context, updateVdcOptions *UpdateVdcOptions) (operation *Operation, response *core.DetailedResponse, err error) {
+	result, response, err := vmware.UpdateVdcWithContext(ctx, updateVdcOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "update-vdc-error")
+		return
+	}
+	operation = vmware.newOperation(Operation_Type_UpdateVdc, result)
+	operation.VdcID = *updateVdcOptions.ID
+	operation.patch = updateVdcOptions.VDCPatch
+	return
+}
+
+// StartDeleteVdc : Delete a virtual data center and track its deletion
+// Send a DeleteVdc request and return an Operation that completes when the virtual data center is deleted.
+func (vmware *VmwareV1) StartDeleteVdc(deleteVdcOptions *DeleteVdcOptions) (operation *Operation, response *core.DetailedResponse, err error) {
+	operation, response, err = vmware.StartDeleteVdcWithContext(context.Background(), deleteVdcOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// StartDeleteVdcWithContext is an alternate form of the StartDeleteVdc method which supports a Context parameter
+func (vmware *VmwareV1) StartDeleteVdcWithContext(ctx context.Context, deleteVdcOptions *DeleteVdcOptions) (operation *Operation, response *core.DetailedResponse, err error) {
+	result, response, err := vmware.DeleteVdcWithContext(ctx, deleteVdcOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "delete-vdc-error")
+		return
+	}
+	operation = vmware.newOperation(Operation_Type_DeleteVdc, result)
+	operation.VdcID = *deleteVdcOptions.ID
+	return
+}
+
+// StartEnableVcdaOnDataCenter : Enable or disable VCDA on a Cloud Director site and track the change
+// Send an EnableVcdaOnDataCenter request and return an Operation that completes when the VCDA service instance is
+// ready to use, or deleted when VCDA is disabled.
+func (vmware *VmwareV1) StartEnableVcdaOnDataCenter(enableVcdaOnDataCenterOptions *EnableVcdaOnDataCenterOptions) (operation *Operation, response *core.DetailedResponse, err error) {
+	operation, response, err = vmware.StartEnableVcdaOnDataCenterWithContext(context.Background(), enableVcdaOnDataCenterOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// StartEnableVcdaOnDataCenterWithContext is an alternate form of the StartEnableVcdaOnDataCenter method which supports a Context parameter
+func (vmware *VmwareV1) StartEnableVcdaOnDataCenterWithContext(ctx context.Context, enableVcdaOnDataCenterOptions *EnableVcdaOnDataCenterOptions) (operation *Operation, response *core.DetailedResponse, err error) {
+	result, response, err := vmware.EnableVcdaOnDataCenterWithContext(ctx, enableVcdaOnDataCenterOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "enable-vcda-error")
+		return
+	}
+	operationType := Operation_Type_EnableVcda
+	if !*enableVcdaOnDataCenterOptions.Enable {
+		operationType = Operation_Type_DisableVcda
+	}
+	operation = vmware.newOperation(operationType, nil)
+	operation.Message = stringValue(result.Message)
+	operation.SiteID = *enableVcdaOnDataCenterOptions.SiteID
+	return
+}
+
+// StartAddTransitGatewayConnections : Connect a transit gateway to an edge and track the connection
+// Send an AddTransitGatewayConnections request and return an Operation that completes when the transit gateway and
+// its connections are no longer creating. Connections pending approval on the IBM Transit Gateway side complete the
+// operation.
+func (vmware *VmwareV1) StartAddTransitGatewayConnections(addTransitGatewayConnectionsOptions *AddTransitGatewayConnectionsOptions) (operation *Operation, response *core.DetailedResponse, err error) {
+	operation, response, err = vmware.StartAddTransitGatewayConnectionsWithContext(context.Background(), addTransitGatewayConnectionsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// StartAddTransitGatewayConnectionsWithContext is an alternate form of the StartAddTransitGatewayConnections method which supports a Context parameter
+func (vmware *VmwareV1) StartAddTransitGatewayConnectionsWithContext(ctx context.Context, addTransitGatewayConnectionsOptions *AddTransitGatewayConnectionsOptions) (operation *Operation, response *core.DetailedResponse, err error) {
+	result, response, err := vmware.AddTransitGatewayConnectionsWithContext(ctx, addTransitGatewayConnectionsOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "add-transit-gateway-error")
+		return
+	}
+	operation = vmware.newOperation(Operation_Type_AddTransitGateway, result)
+	operation.VdcID = *addTransitGatewayConnectionsOptions.VdcID
+	operation.EdgeID = *addTransitGatewayConnectionsOptions.EdgeID
+	operation.TransitGatewayID = *addTransitGatewayConnectionsOptions.ID
+	return
+}
+
+// StartSwapHaEdgeSites : Swap the network locations of a high availability edge and track the swap
+// Send a SwapHaEdgeSites request and return an Operation that completes when the edge and its virtual data center are
+// ready to use again.
+func (vmware *VmwareV1) StartSwapHaEdgeSites(swapHaEdgeSitesOptions *SwapHaEdgeSitesOptions) (operation *Operation, response *core.DetailedResponse, err error) {
+	operation, response, err = vmware.StartSwapHaEdgeSitesWithContext(context.Background(), swapHaEdgeSitesOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// StartSwapHaEdgeSitesWithContext is an alternate form of the StartSwapHaEdgeSites method which supports a Context parameter
+func (vmware *VmwareV1) StartSwapHaEdgeSitesWithContext(ctx context.Context, swapHaEdgeSitesOptions *SwapHaEdgeSitesOptions) (operation *Operation, response *core.DetailedResponse, err error) {
+	result, response, err := vmware.SwapHaEdgeSitesWithContext(ctx, swapHaEdgeSitesOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "swap-ha-edge-sites-error")
+		return
+	}
+	operation = vmware.newOperation(Operation_Type_SwapHaEdgeSites, nil)
+	operation.Message = stringValue(result.Message)
+	operation.VdcID = *swapHaEdgeSitesOptions.VdcID
+	operation.EdgeID = *swapHaEdgeSitesOptions.EdgeID
+	return
+}
@@ -0,0 +1,294 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package vmwarev1_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vmware-go-sdk/vmwarev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func operationVdcJSON(status string, statusReasons string) string {
+	return fmt.Sprintf(`{"href": "Href", "id": "vdc1", "crn": "Crn", "director_site": {"id": "site1", "pvdc": {"id": "pvdc1"}, "url": "URL"}, "edges": [], "status_reasons": [%s], "name": "prod", "ordered_at": "2019-01-01T12:00:00.000Z", "org_href": "OrgHref", "org_name": "OrgName", "status": "%s", "type": "single_tenant", "fast_provisioning_enabled": false, "rhel_byol": false, "windows_byol": false}`, statusReasons, status)
+}
+
+func operationClusterJSON(status string, hostCount int) string {
+	return fmt.Sprintf(`{"id": "cluster1", "name": "cluster1", "href": "Href", "host_count": %d, "host_profile": "BM_2S_20_CORES_192_GB", "data_center_name": "dal10", "status": "%s", "storage_type": "nfs", "billing_plan": "monthly", "file_shares": {}}`, hostCount, status)
+}
+
+var _ = Describe(`Operations`, func() {
+	var (
+		testServer    *httptest.Server
+		vmwareService *vmwarev1.VmwareV1
+		waitOptions   *vmwarev1.WaitOptions
+
+		mutex sync.Mutex
+		// The responses of the server by method and path. The GET responses are returned in turn, and the last one is
+		// repeated.
+		responses map[string][]string
+		gets      map[string]int
+	)
+
+	respond := func(key string, bodies ...string) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		responses[key] = bodies
+	}
+
+	BeforeEach(func() {
+		responses = map[string][]string{}
+		gets = map[string]int{}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			mutex.Lock()
+			defer mutex.Unlock()
+			key := req.Method + " " + req.URL.EscapedPath()
+			bodies, ok := responses[key]
+			if !ok || len(bodies) == 0 {
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"errors": [{"code": "not_found", "message": "Not found"}]}`)
+				return
+			}
+			body := bodies[0]
+			if req.Method == http.MethodGet {
+				if gets[key] < len(bodies) {
+					body = bodies[gets[key]]
+				} else {
+					body = bodies[len(bodies)-1]
+				}
+				gets[key]++
+			}
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(202)
+			fmt.Fprint(res, body)
+		}))
+		var serviceErr error
+		vmwareService, serviceErr = vmwarev1.NewVmwareV1(&vmwarev1.VmwareV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		waitOptions = vmwareService.NewWaitOptions(time.Millisecond, 5*time.Second)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Tracks a cluster update until the cluster is ready to use`, func() {
+		respond("PATCH /director_sites/site1/pvdcs/pvdc1/clusters/cluster1", `{"operation_id": "op1", "message": "The cluster is being updated."}`)
+		respond("GET /director_sites/site1/pvdcs/pvdc1/clusters/cluster1",
+			operationClusterJSON("updating", 3), operationClusterJSON("updating", 3), operationClusterJSON("ready_to_use", 4))
+
+		options := vmwareService.NewUpdateDirectorSitesPvdcsClusterOptions("site1", "cluster1", "pvdc1", map[string]interface{}{"host_count": 4})
+		operation, response, err := vmwareService.StartUpdateDirectorSitesPvdcsCluster(options)
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(202))
+		Expect(operation.Type).To(Equal(vmwarev1.Operation_Type_UpdateCluster))
+		Expect(operation.ID).To(Equal("op1"))
+		Expect(operation.Message).To(Equal("The cluster is being updated."))
+		Expect(operation.Done()).To(BeFalse())
+		Expect(operation.Result()).To(BeNil())
+
+		done, err := operation.Poll(context.Background())
+		Expect(err).To(BeNil())
+		Expect(done).To(BeFalse())
+		Expect(*operation.Result().(*vmwarev1.Cluster).Status).To(Equal("updating"))
+
+		operation.WaitOptions = waitOptions
+		Expect(operation.Wait(context.Background())).To(Succeed())
+		Expect(operation.Done()).To(BeTrue())
+		Expect(operation.Status()).To(Equal(vmwarev1.Operation_Status_Succeeded))
+		Expect(operation.Err()).To(BeNil())
+		Expect(*operation.Result().(*vmwarev1.Cluster).HostCount).To(Equal(int64(4)))
+	})
+
+	It(`Does not complete an update before the resource changes`, func() {
+		// The cluster still reports its previous host count when the update is accepted.
+		respond("PATCH /director_sites/site1/pvdcs/pvdc1/clusters/cluster1", `{"operation_id": "op1", "message": "The cluster is being updated."}`)
+		respond("GET /director_sites/site1/pvdcs/pvdc1/clusters/cluster1",
+			operationClusterJSON("ready_to_use", 3), operationClusterJSON("ready_to_use", 4))
+		options := vmwareService.NewUpdateDirectorSitesPvdcsClusterOptions("site1", "cluster1", "pvdc1", map[string]interface{}{"host_count": 4})
+		operation, _, err := vmwareService.StartUpdateDirectorSitesPvdcsCluster(options)
+		Expect(err).To(BeNil())
+		Expect(operation.Poll(context.Background())).To(BeFalse())
+		Expect(operation.Poll(context.Background())).To(BeTrue())
+		Expect(operation.Status()).To(Equal(vmwarev1.Operation_Status_Succeeded))
+
+		// A virtual data center that is updating completes the update once it is ready to use again, even in another
+		// process.
+		vdcJSON := `{"id": "vdc1", "cpu": %d, "status": "%s", "director_site": {"id": "site1", "pvdc": {"id": "pvdc1"}, "url": "URL"}, "edges": []}`
+		respond("PATCH /vdcs/vdc1", fmt.Sprintf(vdcJSON, 10, "ready_to_use"))
+		respond("GET /vdcs/vdc1", fmt.Sprintf(vdcJSON, 10, "ready_to_use"), fmt.Sprintf(vdcJSON, 10, "modifying"))
+		operation, _, err = vmwareService.StartUpdateVdc(vmwareService.NewUpdateVdcOptions("vdc1", map[string]interface{}{"cpu": 20, "ram": 64}))
+		Expect(err).To(BeNil())
+		Expect(operation.Poll(context.Background())).To(BeFalse())
+		Expect(operation.Poll(context.Background())).To(BeFalse())
+		data, err := json.Marshal(operation)
+		Expect(err).To(BeNil())
+		respond("GET /vdcs/vdc1", fmt.Sprintf(vdcJSON, 20, "ready_to_use"))
+		resumed, err := vmwareService.ResumeOperation(data)
+		Expect(err).To(BeNil())
+		Expect(resumed.Poll(context.Background())).To(BeTrue())
+		Expect(resumed.Status()).To(Equal(vmwarev1.Operation_Status_Succeeded))
+
+		// A swap has no patched values to look for, so the edge must be seen changing.
+		edgeVdcJSON := `{"id": "vdc2", "status": "ready_to_use", "director_site": {"id": "site1", "pvdc": {"id": "pvdc1"}, "url": "URL"},
+			"edges": [{"id": "edge1", "type": "performance", "size": "medium", "status": "%s"}]}`
+		respond("PATCH /vdcs/vdc2/edges/edge1/swap_primary_and_secondary_network_locations", `{"message": "The edge is being swapped."}`)
+		respond("GET /vdcs/vdc2", fmt.Sprintf(edgeVdcJSON, "ready_to_use"), fmt.Sprintf(edgeVdcJSON, "ready_to_use"),
+			fmt.Sprintf(edgeVdcJSON, "modifying"), fmt.Sprintf(edgeVdcJSON, "ready_to_use"))
+		operation, _, err = vmwareService.StartSwapHaEdgeSites(vmwareService.NewSwapHaEdgeSitesOptions("vdc2", "edge1"))
+		Expect(err).To(BeNil())
+		Expect(operation.Poll(context.Background())).To(BeFalse())
+		Expect(operation.Poll(context.Background())).To(BeFalse())
+		Expect(operation.Poll(context.Background())).To(BeFalse())
+		Expect(operation.Poll(context.Background())).To(BeTrue())
+		Expect(operation.Status()).To(Equal(vmwarev1.Operation_Status_Succeeded))
+	})
+
+	It(`Resumes an operation marshalled by another process`, func() {
+		respond("POST /vdcs", operationVdcJSON("creating", ""))
+		respond("GET /vdcs/vdc1", operationVdcJSON("creating", ""))
+
+		directorSite := &vmwarev1.VDCDirectorSitePrototype{ID: core.StringPtr("site1"), Pvdc: &vmwarev1.DirectorSitePVDC{ID: core.StringPtr("pvdc1")}}
+		operation, _, err := vmwareService.StartCreateVdc(vmwareService.NewCreateVdcOptions("prod", directorSite))
+		Expect(err).To(BeNil())
+		Expect(operation.VdcID).To(Equal("vdc1"))
+		done, err := operation.Poll(context.Background())
+		Expect(err).To(BeNil())
+		Expect(done).To(BeFalse())
+
+		data, err := json.Marshal(operation)
+		Expect(err).To(BeNil())
+		var fields map[string]interface{}
+		Expect(json.Unmarshal(data, &fields)).To(Succeed())
+		Expect(fields["type"]).To(Equal("create_vdc"))
+		Expect(fields["status"]).To(Equal("running"))
+		Expect(fields["vdc_id"]).To(Equal("vdc1"))
+
+		// An operation that is not attached to a service cannot be polled.
+		detached := &vmwarev1.Operation{}
+		Expect(json.Unmarshal(data, detached)).To(Succeed())
+		_, err = detached.Poll(context.Background())
+		Expect(err).ToNot(BeNil())
+
+		respond("GET /vdcs/vdc1", operationVdcJSON("ready_to_use", ""))
+		resumed, err := vmwareService.ResumeOperation(data)
+		Expect(err).To(BeNil())
+		Expect(resumed.StartedAt.Equal(operation.StartedAt)).To(BeTrue())
+		Expect(*resumed.Result().(*vmwarev1.VDC).Status).To(Equal("creating"))
+		resumed.WaitOptions = waitOptions
+		Expect(resumed.Wait(context.Background())).To(Succeed())
+		Expect(*resumed.Result().(*vmwarev1.VDC).Status).To(Equal("ready_to_use"))
+
+		_, err = vmwareService.ResumeOperation([]byte(`{"type": "unknown", "result": {}}`))
+		Expect(err).ToNot(BeNil())
+	})
+
+	It(`Reports the status reasons of a VDC that failed`, func() {
+		respond("POST /vdcs", operationVdcJSON("creating", ""))
+		respond("GET /vdcs/vdc1", operationVdcJSON("creating", ""),
+			operationVdcJSON("failed", `{"code": "insufficent_cpu", "message": "Not enough CPU.", "more_info": "https://cloud.ibm.com"}`))
+
+		directorSite := &vmwarev1.VDCDirectorSitePrototype{ID: core.StringPtr("site1"), Pvdc: &vmwarev1.DirectorSitePVDC{ID: core.StringPtr("pvdc1")}}
+		operation, _, err := vmwareService.StartCreateVdc(vmwareService.NewCreateVdcOptions("prod", directorSite))
+		Expect(err).To(BeNil())
+		operation.WaitOptions = waitOptions
+		err = operation.Wait(context.Background())
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("Not enough CPU. (insufficent_cpu)"))
+		Expect(operation.Err()).To(Equal(err))
+		Expect(operation.Status()).To(Equal(vmwarev1.Operation_Status_Failed))
+
+		// The outcome survives the round trip, without polling again.
+		data, err := json.Marshal(operation)
+		Expect(err).To(BeNil())
+		resumed, err := vmwareService.ResumeOperation(data)
+		Expect(err).To(BeNil())
+		Expect(resumed.Done()).To(BeTrue())
+		Expect(resumed.Err().Error()).To(ContainSubstring("insufficent_cpu"))
+		Expect(*resumed.Result().(*vmwarev1.VDC).StatusReasons[0].Code).To(Equal("insufficent_cpu"))
+	})
+
+	It(`Completes a VDC deletion when the VDC is not found`, func() {
+		respond("DELETE /vdcs/vdc1", operationVdcJSON("deleting", ""))
+		respond("GET /vdcs/vdc1", operationVdcJSON("deleting", ""))
+
+		operation, _, err := vmwareService.StartDeleteVdc(vmwareService.NewDeleteVdcOptions("vdc1"))
+		Expect(err).To(BeNil())
+		Expect(operation.Poll(context.Background())).To(BeFalse())
+
+		respond("GET /vdcs/vdc1")
+		Expect(operation.Poll(context.Background())).To(BeTrue())
+		Expect(operation.Err()).To(BeNil())
+	})
+
+	It(`Tracks the enablement of VCDA`, func() {
+		vcdaService := `{"name": "vcda", "id": "vcda1", "ordered_at": "2019-01-01T12:00:00.000Z", "status": "%s"}`
+		respond("POST /director_sites/site1/action/enable_vcda", `{"message": "VCDA is being enabled."}`)
+		respond("GET /director_sites/site1",
+			directorSiteJSON("site1", ""),
+			directorSiteJSON("site1", fmt.Sprintf(vcdaService, "creating")),
+			directorSiteJSON("site1", fmt.Sprintf(vcdaService, "ready_to_use")))
+
+		operation, _, err := vmwareService.StartEnableVcdaOnDataCenter(vmwareService.NewEnableVcdaOnDataCenterOptions("site1", true))
+		Expect(err).To(BeNil())
+		Expect(operation.Type).To(Equal(vmwarev1.Operation_Type_EnableVcda))
+		Expect(operation.Message).To(Equal("VCDA is being enabled."))
+		operation.WaitOptions = waitOptions
+		Expect(operation.Wait(context.Background())).To(Succeed())
+		Expect(*operation.Result().(*vmwarev1.Service).ID).To(Equal("vcda1"))
+	})
+
+	It(`Tracks a transit gateway until its connections settle`, func() {
+		edge := &fakeTransitGatewayEdge{gateways: map[string]string{}, reads: map[string]int{}}
+		edgeServer := httptest.NewServer(edge.handler())
+		defer edgeServer.Close()
+		Expect(vmwareService.SetServiceURL(edgeServer.URL)).To(Succeed())
+
+		options := vmwareService.NewAddTransitGatewayConnectionsOptions("vdc_id", "edge_id", "tgw1").SetRegion("us-south")
+		operation, _, err := vmwareService.StartAddTransitGatewayConnections(options)
+		Expect(err).To(BeNil())
+		Expect(operation.TransitGatewayID).To(Equal("tgw1"))
+		operation.WaitOptions = waitOptions
+		Expect(operation.Wait(context.Background())).To(Succeed())
+		Expect(*operation.Result().(*vmwarev1.TransitGateway).Status).To(Equal("ready_to_use"))
+	})
+
+	It(`Stops waiting without failing the operation when the context is canceled`, func() {
+		respond("DELETE /director_sites/site1", directorSiteJSON("site1", ""))
+		respond("GET /director_sites/site1", directorSiteJSON("site1", ""))
+
+		operation, _, err := vmwareService.StartDeleteDirectorSite(vmwareService.NewDeleteDirectorSiteOptions("site1"))
+		Expect(err).To(BeNil())
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		operation.WaitOptions = waitOptions
+		Expect(operation.Wait(ctx)).ToNot(Succeed())
+		Expect(operation.Done()).To(BeFalse())
+		Expect(operation.Err()).To(BeNil())
+	})
+})
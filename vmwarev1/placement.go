@@ -0,0 +1,672 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vmwarev1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vmware-go-sdk/common"
+)
+
+// Scores of the placement candidates. Resource pools of the account's single-tenant Cloud Director sites are preferred
+// because their hosts are already paid for; among them, the ones left with the most free capacity rank first.
+const (
+	placementScoreSingleTenant    = 50
+	placementScoreMaxHeadroom     = 30
+	placementScoreUnknownCapacity = 10
+	placementScorePreferenceStep  = 5
+)
+
+// PlaceVdc : Rank the resource pools that can host a new virtual data center
+// Collect the Cloud Director sites, virtual data centers and catalog of the account and rank the resource pools of the
+// single-tenant sites of the account and of the multitenant sites that meet the requirements. Use Inventory.PlaceVdc
+// to work from a saved inventory.
+func (vmware *VmwareV1) PlaceVdc(placeVdcOptions *PlaceVdcOptions) (result *VdcPlacement, err error) {
+	result, err = vmware.PlaceVdcWithContext(context.Background(), placeVdcOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// PlaceVdcWithContext is an alternate form of the PlaceVdc method which supports a Context parameter
+func (vmware *VmwareV1) PlaceVdcWithContext(ctx context.Context, placeVdcOptions *PlaceVdcOptions) (result *VdcPlacement, err error) {
+	err = core.ValidateNotNil(placeVdcOptions, "placeVdcOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(placeVdcOptions, "placeVdcOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	collectInventoryOptions := vmware.NewCollectInventoryOptions()
+	collectInventoryOptions.AcceptLanguage = placeVdcOptions.AcceptLanguage
+	collectInventoryOptions.XGlobalTransactionID = placeVdcOptions.XGlobalTransactionID
+	collectInventoryOptions.SetHeaders(placeVdcOptions.Headers)
+	inventory, err := vmware.CollectInventoryWithContext(ctx, collectInventoryOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "collect-inventory-error")
+		return
+	}
+	result, err = inventory.PlaceVdc(placeVdcOptions.Requirements)
+	return
+}
+
+// PlaceVdcOptions : The PlaceVdc options.
+type PlaceVdcOptions struct {
+	// The requirements of the virtual data center.
+	Requirements *VdcRequirements `json:"requirements" validate:"required"`
+
+	// Language.
+	AcceptLanguage *string `json:"Accept-Language,omitempty"`
+
+	// Transaction ID.
+	XGlobalTransactionID *string `json:"X-Global-Transaction-ID,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewPlaceVdcOptions : Instantiate PlaceVdcOptions
+func (*VmwareV1) NewPlaceVdcOptions(requirements *VdcRequirements) *PlaceVdcOptions {
+	return &PlaceVdcOptions{
+		Requirements: requirements,
+	}
+}
+
+// SetRequirements : Allow user to set Requirements
+func (_options *PlaceVdcOptions) SetRequirements(requirements *VdcRequirements) *PlaceVdcOptions {
+	_options.Requirements = requirements
+	return _options
+}
+
+// SetAcceptLanguage : Allow user to set AcceptLanguage
+func (_options *PlaceVdcOptions) SetAcceptLanguage(acceptLanguage string) *PlaceVdcOptions {
+	_options.AcceptLanguage = core.StringPtr(acceptLanguage)
+	return _options
+}
+
+// SetXGlobalTransactionID : Allow user to set XGlobalTransactionID
+func (_options *PlaceVdcOptions) SetXGlobalTransactionID(xGlobalTransactionID string) *PlaceVdcOptions {
+	_options.XGlobalTransactionID = core.StringPtr(xGlobalTransactionID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *PlaceVdcOptions) SetHeaders(param map[string]string) *PlaceVdcOptions {
+	options.Headers = param
+	return options
+}
+
+// VdcRequirements : The requirements that the resource pool of a new virtual data center (VDC) must meet. Unset
+// requirements do not restrict the placement.
+type VdcRequirements struct {
+	// The name of the region.
+	Region *string `json:"region,omitempty"`
+
+	// The names of the data centers that the VDC can be placed in, most preferred first.
+	DataCenterNames []string `json:"data_center_names,omitempty"`
+
+	// Whether the resource pool must be private only (true) or must allow public connectivity (false). Only
+	// multitenant resource pools declare it.
+	PrivateOnly *bool `json:"private_only,omitempty"`
+
+	// The resource pool type of the VDC. Only used for multitenant resource pools.
+	ProviderType *string `json:"provider_type,omitempty"`
+
+	// The type of Cloud Director site.
+	DirectorSiteType *string `json:"director_site_type,omitempty"`
+
+	// The vCPU reservation of the VDC.
+	Cpu *int64 `json:"cpu,omitempty"`
+
+	// The RAM reservation of the VDC in GB.
+	Ram *int64 `json:"ram,omitempty"`
+
+	// Whether the VDC needs a network HA edge, which requires a valid pair in the plan of Inventory.PlanNetworkHa: another
+	// data center of the region, for a stretched resource pool, or a resource pool of the same Cloud Director site in
+	// another data center of the region.
+	NetworkHa *bool `json:"network_ha,omitempty"`
+}
+
+// Constants associated with the VdcRequirements.DirectorSiteType property.
+// The type of Cloud Director site.
+const (
+	VdcRequirements_DirectorSiteType_Multitenant  = "multitenant"
+	VdcRequirements_DirectorSiteType_SingleTenant = "single_tenant"
+)
+
+// NewVdcRequirements : Instantiate VdcRequirements
+func (*VmwareV1) NewVdcRequirements() *VdcRequirements {
+	return &VdcRequirements{}
+}
+
+// SetRegion : Allow user to set Region
+func (_options *VdcRequirements) SetRegion(region string) *VdcRequirements {
+	_options.Region = core.StringPtr(region)
+	return _options
+}
+
+// SetDataCenterNames : Allow user to set DataCenterNames
+func (_options *VdcRequirements) SetDataCenterNames(dataCenterNames []string) *VdcRequirements {
+	_options.DataCenterNames = dataCenterNames
+	return _options
+}
+
+// SetPrivateOnly : Allow user to set PrivateOnly
+func (_options *VdcRequirements) SetPrivateOnly(privateOnly bool) *VdcRequirements {
+	_options.PrivateOnly = core.BoolPtr(privateOnly)
+	return _options
+}
+
+// SetProviderType : Allow user to set ProviderType
+func (_options *VdcRequirements) SetProviderType(providerType string) *VdcRequirements {
+	_options.ProviderType = core.StringPtr(providerType)
+	return _options
+}
+
+// SetDirectorSiteType : Allow user to set DirectorSiteType
+func (_options *VdcRequirements) SetDirectorSiteType(directorSiteType string) *VdcRequirements {
+	_options.DirectorSiteType = core.StringPtr(directorSiteType)
+	return _options
+}
+
+// SetCpu : Allow user to set Cpu
+func (_options *VdcRequirements) SetCpu(cpu int64) *VdcRequirements {
+	_options.Cpu = core.Int64Ptr(cpu)
+	return _options
+}
+
+// SetRam : Allow user to set Ram
+func (_options *VdcRequirements) SetRam(ram int64) *VdcRequirements {
+	_options.Ram = core.Int64Ptr(ram)
+	return _options
+}
+
+// SetNetworkHa : Allow user to set NetworkHa
+func (_options *VdcRequirements) SetNetworkHa(networkHa bool) *VdcRequirements {
+	_options.NetworkHa = core.BoolPtr(networkHa)
+	return _options
+}
+
+func (requirements *VdcRequirements) validate() error {
+	providerType := stringValue(requirements.ProviderType)
+	switch providerType {
+	case "", VDCProviderType_Name_OnDemand, VDCProviderType_Name_Paygo, VDCProviderType_Name_Reserved:
+	default:
+		return fmt.Errorf("unknown provider type %q", providerType)
+	}
+	if providerType == VDCProviderType_Name_Reserved && (requirements.Cpu == nil || requirements.Ram == nil) {
+		return errors.New("the reserved provider type requires a CPU and a RAM reservation")
+	}
+	switch siteType := stringValue(requirements.DirectorSiteType); siteType {
+	case "", VdcRequirements_DirectorSiteType_Multitenant, VdcRequirements_DirectorSiteType_SingleTenant:
+	default:
+		return fmt.Errorf("unknown Cloud Director site type %q", siteType)
+	}
+	if (requirements.Cpu != nil && *requirements.Cpu < 0) || (requirements.Ram != nil && *requirements.Ram < 0) {
+		return errors.New("the CPU and RAM reservations cannot be negative")
+	}
+	return nil
+}
+
+// VdcPlacement : The resource pools that can host a virtual data center, and the ones that cannot.
+type VdcPlacement struct {
+	// The resource pools that meet the requirements, best first.
+	Candidates []VdcPlacementCandidate `json:"candidates"`
+
+	// The resource pools that do not meet the requirements.
+	Rejections []VdcPlacementRejection `json:"rejections"`
+}
+
+// Best returns the best candidate, or nil when no resource pool meets the requirements.
+func (placement *VdcPlacement) Best() *VdcPlacementCandidate {
+	if len(placement.Candidates) == 0 {
+		return nil
+	}
+	return &placement.Candidates[0]
+}
+
+// VdcPlacementCandidate : A resource pool that can host a virtual data center.
+type VdcPlacementCandidate struct {
+	// The Cloud Director site and resource pool to set in CreateVdcOptions.
+	DirectorSite *VDCDirectorSitePrototype `json:"director_site"`
+
+	// The name of the Cloud Director site.
+	DirectorSiteName string `json:"director_site_name"`
+
+	// The type of Cloud Director site.
+	DirectorSiteType string `json:"director_site_type"`
+
+	// The name of the region, when known.
+	Region string `json:"region,omitempty"`
+
+	// The name of the data center of the resource pool.
+	DataCenterName string `json:"data_center_name"`
+
+	// The name of the resource pool.
+	PvdcName string `json:"pvdc_name"`
+
+	// The ID of the secondary resource pool of a network HA edge. It is empty for stretched resource pools.
+	SecondaryPvdcID string `json:"secondary_pvdc_id,omitempty"`
+
+	// The name of the secondary data center of a network HA edge.
+	SecondaryDataCenterName string `json:"secondary_data_center_name,omitempty"`
+
+	// The vCPUs of the resource pool that no VDC or edge commits, before the placement. Only known for the resource
+	// pools of single-tenant sites whose host profiles are known.
+	AvailableCpu *int64 `json:"available_cpu,omitempty"`
+
+	// The RAM of the resource pool in GB that no VDC or edge commits, before the placement.
+	AvailableRam *int64 `json:"available_ram,omitempty"`
+
+	// The score of the candidate; candidates with higher scores rank first.
+	Score int `json:"score"`
+
+	// Why the resource pool meets the requirements and how its score was computed.
+	Explanations []string `json:"explanations"`
+}
+
+// VdcPlacementRejection : A resource pool that cannot host a virtual data center.
+type VdcPlacementRejection struct {
+	// The ID of the Cloud Director site.
+	DirectorSiteID string `json:"director_site_id"`
+
+	// The ID of the resource pool. It is empty when the whole Cloud Director site is rejected.
+	PvdcID string `json:"pvdc_id,omitempty"`
+
+	// Why the resource pool does not meet the requirements.
+	Reasons []string `json:"reasons"`
+}
+
+// placementPool is a resource pool considered by the placement, whether multitenant or single-tenant.
+type placementPool struct {
+	siteID         string
+	siteName       string
+	siteType       string
+	region         string
+	id             string
+	name           string
+	dataCenterName string
+	privateOnly    *bool
+	providerTypes  []string
+	ready          bool
+	pvdc           *PVDC
+}
+
+// PlaceVdc ranks the resource pools of the inventory that can host a virtual data center meeting "requirements". The
+// inventory must include the catalog for multitenant sites and regions to be considered.
+func (inventory *Inventory) PlaceVdc(requirements *VdcRequirements) (*VdcPlacement, error) {
+	err := core.ValidateNotNil(requirements, "requirements cannot be nil")
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+	}
+	err = requirements.validate()
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "placement-requirements-error", common.GetComponentInfo())
+	}
+
+	placement := &VdcPlacement{
+		Candidates: []VdcPlacementCandidate{},
+		Rejections: []VdcPlacementRejection{},
+	}
+	sites := map[string][]placementPool{}
+	siteIDs := []string{}
+	for _, pool := range inventory.placementPools() {
+		if _, ok := sites[pool.siteID]; !ok {
+			siteIDs = append(siteIDs, pool.siteID)
+		}
+		sites[pool.siteID] = append(sites[pool.siteID], pool)
+	}
+	for _, siteID := range siteIDs {
+		pools := sites[siteID]
+		if siteType := stringValue(requirements.DirectorSiteType); siteType != "" && siteType != pools[0].siteType {
+			placement.Rejections = append(placement.Rejections, VdcPlacementRejection{
+				DirectorSiteID: siteID,
+				Reasons:        []string{fmt.Sprintf("the Cloud Director site is %s, not %s", pools[0].siteType, siteType)},
+			})
+			continue
+		}
+		for _, pool := range pools {
+			candidate, reasons := inventory.placeOnPool(requirements, pool, pools)
+			if len(reasons) > 0 {
+				placement.Rejections = append(placement.Rejections, VdcPlacementRejection{
+					DirectorSiteID: pool.siteID,
+					PvdcID:         pool.id,
+					Reasons:        reasons,
+				})
+				continue
+			}
+			placement.Candidates = append(placement.Candidates, *candidate)
+		}
+	}
+
+	sort.SliceStable(placement.Candidates, func(i, j int) bool {
+		a, b := placement.Candidates[i], placement.Candidates[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if a.DirectorSiteName != b.DirectorSiteName {
+			return a.DirectorSiteName < b.DirectorSiteName
+		}
+		return a.PvdcName < b.PvdcName
+	})
+	return placement, nil
+}
+
+// placementPools lists the resource pools of the single-tenant sites of the account followed by the ones of the
+// multitenant sites.
+func (inventory *Inventory) placementPools() []placementPool {
+	pools := []placementPool{}
+	for i := range inventory.DirectorSites {
+		site := &inventory.DirectorSites[i]
+		if stringValue(site.Type) == DirectorSite_Type_Multitenant {
+			// The multitenant sites are described by the catalog.
+			continue
+		}
+		siteReady := stringValue(site.Status) == DirectorSite_Status_ReadyToUse
+		for j := range site.Pvdcs {
+			pvdc := &site.Pvdcs[j]
+			pools = append(pools, placementPool{
+				siteID:         stringValue(site.ID),
+				siteName:       stringValue(site.Name),
+				siteType:       VdcRequirements_DirectorSiteType_SingleTenant,
+				region:         inventory.regionOfDataCenter(stringValue(pvdc.DataCenterName)),
+				id:             stringValue(pvdc.ID),
+				name:           stringValue(pvdc.Name),
+				dataCenterName: stringValue(pvdc.DataCenterName),
+				providerTypes:  providerTypeNames(pvdc.ProviderTypes),
+				ready:          siteReady && stringValue(pvdc.Status) == PVDC_Status_ReadyToUse,
+				pvdc:           pvdc,
+			})
+		}
+	}
+	for _, site := range inventory.MultitenantDirectorSites {
+		for _, pvdc := range site.Pvdcs {
+			privateOnly := pvdc.PrivateOnly
+			if site.PrivateOnly != nil && *site.PrivateOnly {
+				privateOnly = site.PrivateOnly
+			}
+			pools = append(pools, placementPool{
+				siteID:         stringValue(site.ID),
+				siteName:       stringValue(site.DisplayName),
+				siteType:       VdcRequirements_DirectorSiteType_Multitenant,
+				region:         stringValue(site.Region),
+				id:             stringValue(pvdc.ID),
+				name:           stringValue(pvdc.Name),
+				dataCenterName: stringValue(pvdc.DataCenterName),
+				privateOnly:    privateOnly,
+				providerTypes:  providerTypeNames(pvdc.ProviderTypes),
+				ready:          true,
+			})
+		}
+	}
+	return pools
+}
+
+// placeOnPool checks "pool" against the requirements. It returns the candidate, or the reasons why the pool is rejected.
+func (inventory *Inventory) placeOnPool(requirements *VdcRequirements, pool placementPool, sitePools []placementPool) (candidate *VdcPlacementCandidate, reasons []string) {
+	explanations := []string{}
+	score := 0
+	if !pool.ready {
+		reasons = append(reasons, "the resource pool or its Cloud Director site is not ready to use")
+	}
+
+	if region := stringValue(requirements.Region); region != "" {
+		if pool.region == "" {
+			reasons = append(reasons, fmt.Sprintf("the region of data center %s is unknown", pool.dataCenterName))
+		} else if pool.region != region {
+			reasons = append(reasons, fmt.Sprintf("the resource pool is in region %s, not %s", pool.region, region))
+		} else {
+			explanations = append(explanations, fmt.Sprintf("in region %s", region))
+		}
+	}
+
+	if len(requirements.DataCenterNames) > 0 {
+		preference := indexOfString(requirements.DataCenterNames, pool.dataCenterName)
+		if preference < 0 {
+			reasons = append(reasons, fmt.Sprintf("data center %s is not one of the requested data centers", pool.dataCenterName))
+		} else {
+			score -= preference * placementScorePreferenceStep
+			explanations = append(explanations, fmt.Sprintf("in data center %s, preference %d of %d (-%d)",
+				pool.dataCenterName, preference+1, len(requirements.DataCenterNames), preference*placementScorePreferenceStep))
+		}
+	}
+
+	if requirements.PrivateOnly != nil && pool.siteType == VdcRequirements_DirectorSiteType_Multitenant {
+		privateOnly := pool.privateOnly != nil && *pool.privateOnly
+		if privateOnly != *requirements.PrivateOnly {
+			if privateOnly {
+				reasons = append(reasons, "the resource pool is private only")
+			} else {
+				reasons = append(reasons, "the resource pool is not private only")
+			}
+		} else if privateOnly {
+			explanations = append(explanations, "private only")
+		} else {
+			explanations = append(explanations, "allows public connectivity")
+		}
+	}
+
+	var providerType *VDCProviderType
+	if pool.siteType == VdcRequirements_DirectorSiteType_Multitenant {
+		name := stringValue(requirements.ProviderType)
+		if name == "" && len(pool.providerTypes) > 0 {
+			name = pool.providerTypes[0]
+			if indexOfString(pool.providerTypes, VDCProviderType_Name_OnDemand) >= 0 {
+				name = VDCProviderType_Name_OnDemand
+			}
+		}
+		switch {
+		case name == "":
+			reasons = append(reasons, "the resource pool offers no provider type")
+		case indexOfString(pool.providerTypes, name) < 0:
+			reasons = append(reasons, fmt.Sprintf("the resource pool does not offer the %s provider type", name))
+		default:
+			providerType = &VDCProviderType{Name: core.StringPtr(name)}
+			explanations = append(explanations, fmt.Sprintf("offers the %s provider type", name))
+		}
+		if requirements.Cpu != nil || requirements.Ram != nil {
+			score += placementScoreUnknownCapacity
+			explanations = append(explanations, fmt.Sprintf("multitenant resource pool, capacity is managed by IBM Cloud (+%d)", placementScoreUnknownCapacity))
+		}
+	} else if name := stringValue(requirements.ProviderType); name != "" {
+		// Single-tenant VDCs do not take a provider type, but the resource pool may declare the ones it supports.
+		if len(pool.providerTypes) > 0 && indexOfString(pool.providerTypes, name) < 0 {
+			reasons = append(reasons, fmt.Sprintf("the resource pool does not offer the %s provider type", name))
+		}
+	}
+
+	var availableCpu, availableRam *int64
+	if pool.siteType == VdcRequirements_DirectorSiteType_SingleTenant {
+		score += placementScoreSingleTenant
+		explanations = append(explanations, fmt.Sprintf("single-tenant Cloud Director site of the account (+%d)", placementScoreSingleTenant))
+		capacity := inventory.pvdcUtilization(pool.siteID, pool.pvdc, nil)
+		if len(capacity.UnknownHostProfiles) > 0 {
+			if requirements.Cpu != nil || requirements.Ram != nil {
+				explanations = append(explanations, "the capacity of the resource pool is unknown because a host profile is missing")
+			}
+		} else {
+			availableCpu = core.Int64Ptr(capacity.AvailableCpu)
+			availableRam = core.Int64Ptr(capacity.AvailableRam)
+			cpu, ram := int64Value(requirements.Cpu), int64Value(requirements.Ram)
+			if cpu > *availableCpu {
+				reasons = append(reasons, fmt.Sprintf("needs %d vCPUs but %d of %d are free", cpu, *availableCpu, capacity.Cpu))
+			}
+			if ram > *availableRam {
+				reasons = append(reasons, fmt.Sprintf("needs %d GB of RAM but %d of %d are free", ram, *availableRam, capacity.Ram))
+			}
+			if cpu <= *availableCpu && ram <= *availableRam {
+				headroom := placementScoreMaxHeadroom
+				if capacity.Cpu > 0 {
+					headroom = minInt(headroom, int((*availableCpu-cpu)*placementScoreMaxHeadroom/capacity.Cpu))
+				}
+				if capacity.Ram > 0 {
+					headroom = minInt(headroom, int((*availableRam-ram)*placementScoreMaxHeadroom/capacity.Ram))
+				}
+				score += headroom
+				explanations = append(explanations, fmt.Sprintf("%d vCPUs and %d GB of RAM free after the placement (+%d)",
+					*availableCpu-cpu, *availableRam-ram, headroom))
+			}
+		}
+	}
+
+	var secondary *NetworkHaPair
+	if requirements.NetworkHa != nil && *requirements.NetworkHa {
+		var reason string
+		secondary, reason = inventory.networkHaSecondary(requirements, pool, sitePools)
+		switch {
+		case secondary == nil:
+			reasons = append(reasons, reason)
+		case secondary.SecondaryPvdcID == "":
+			explanations = append(explanations, fmt.Sprintf("network HA with data center %s of the stretched resource pool", secondary.SecondaryDataCenterName))
+		default:
+			explanations = append(explanations, fmt.Sprintf("network HA with resource pool %s in data center %s",
+				placementPoolName(sitePools, secondary.SecondaryPvdcID), secondary.SecondaryDataCenterName))
+		}
+	}
+
+	if len(reasons) > 0 {
+		return nil, reasons
+	}
+	candidate = &VdcPlacementCandidate{
+		DirectorSite: &VDCDirectorSitePrototype{
+			ID: core.StringPtr(pool.siteID),
+			Pvdc: &DirectorSitePVDC{
+				ID:           core.StringPtr(pool.id),
+				ProviderType: providerType,
+			},
+		},
+		DirectorSiteName: pool.siteName,
+		DirectorSiteType: pool.siteType,
+		Region:           pool.region,
+		DataCenterName:   pool.dataCenterName,
+		PvdcName:         pool.name,
+		AvailableCpu:     availableCpu,
+		AvailableRam:     availableRam,
+		Score:            score,
+		Explanations:     explanations,
+	}
+	if secondary != nil {
+		candidate.SecondaryPvdcID = secondary.SecondaryPvdcID
+		candidate.SecondaryDataCenterName = secondary.SecondaryDataCenterName
+	}
+	return candidate, nil
+}
+
+// networkHaSecondary returns the pair of the network HA plan of "pool" that a network HA edge on it would use,
+// preferring the requested data centers, or the reason why there is none.
+func (inventory *Inventory) networkHaSecondary(requirements *VdcRequirements, pool placementPool, sitePools []placementPool) (secondary *NetworkHaPair, reason string) {
+	plan, err := inventory.PlanNetworkHa(pool.siteID, pool.id)
+	if err != nil {
+		return nil, "network HA cannot be planned: " + err.Error()
+	}
+	best := -1
+	for i := range plan.Pairs {
+		pair := &plan.Pairs[i]
+		if requirements.PrivateOnly != nil && pool.siteType == VdcRequirements_DirectorSiteType_Multitenant {
+			other := findPlacementPool(sitePools, pair.SecondaryPvdcID)
+			if other == nil || (other.privateOnly != nil && *other.privateOnly) != *requirements.PrivateOnly {
+				continue
+			}
+		}
+		rank := len(requirements.DataCenterNames)
+		if preference := indexOfString(requirements.DataCenterNames, pair.SecondaryDataCenterName); preference >= 0 {
+			rank = preference
+		}
+		if secondary == nil || rank < best {
+			secondary, best = pair, rank
+		}
+	}
+	if secondary == nil {
+		reason = "no ready resource pool of the Cloud Director site is in another data center for network HA"
+		if plan.Stretched {
+			reason = "no other data center of the region hosts the clusters of the stretched resource pool for network HA"
+		}
+		if len(plan.Exclusions) > 0 {
+			reason += " (" + strings.Join(plan.Exclusions, "; ") + ")"
+		}
+	}
+	return
+}
+
+// findPlacementPool returns the resource pool with the ID "id" of "pools", or nil.
+func findPlacementPool(pools []placementPool, id string) *placementPool {
+	for i := range pools {
+		if pools[i].id == id {
+			return &pools[i]
+		}
+	}
+	return nil
+}
+
+// placementPoolName returns the name of the resource pool with the ID "id" of "pools", or its ID.
+func placementPoolName(pools []placementPool, id string) string {
+	if pool := findPlacementPool(pools, id); pool != nil {
+		return pool.name
+	}
+	return id
+}
+
+// regionOfDataCenter returns the name of the region of the data center, or "" when the catalog does not list it.
+func (inventory *Inventory) regionOfDataCenter(dataCenterName string) string {
+	for _, region := range inventory.DirectorSiteRegions {
+		for _, dataCenter := range region.DataCenters {
+			if stringValue(dataCenter.Name) == dataCenterName {
+				return stringValue(region.Name)
+			}
+		}
+	}
+	return ""
+}
+
+func providerTypeNames(providerTypes []ProviderType) []string {
+	names := []string{}
+	for _, providerType := range providerTypes {
+		names = append(names, stringValue(providerType.Name))
+	}
+	return names
+}
+
+func indexOfString(values []string, value string) int {
+	for i, v := range values {
+		if v == value {
+			return i
+		}
+	}
+	return -1
+}
+
+func int64Value(value *int64) int64 {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
+
+func minInt(a int, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
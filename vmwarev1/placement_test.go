@@ -0,0 +1,242 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package vmwarev1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vmware-go-sdk/vmwarev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`VDC placement`, func() {
+	const (
+		directorSitesJSON = `[{"id": "site1", "name": "dedicated", "type": "single_tenant", "status": "ready_to_use", "pvdcs": [
+			{"id": "pvdc1", "name": "pvdc1", "data_center_name": "dal10", "status": "ready_to_use", "provider_types": [], "clusters": [
+				{"id": "cluster1", "name": "cluster1", "host_count": 2, "host_profile": "BM_2S_20_CORES_192_GB", "data_center_name": "dal10", "status": "ready_to_use"}]},
+			{"id": "pvdc2", "name": "pvdc2", "data_center_name": "dal12", "status": "ready_to_use", "provider_types": [], "clusters": [
+				{"id": "cluster2", "name": "cluster2", "host_count": 2, "host_profile": "BM_2S_20_CORES_192_GB", "data_center_name": "dal12", "status": "ready_to_use"}]}]}]`
+		vdcsJSON = `[
+			{"id": "vdc1", "cpu": 60, "ram": 100, "status": "ready_to_use", "director_site": {"id": "site1", "pvdc": {"id": "pvdc1"}, "url": "URL"}, "edges": []},
+			{"id": "vdc2", "cpu": 10, "ram": 32, "status": "ready_to_use", "director_site": {"id": "site1", "pvdc": {"id": "pvdc2"}, "url": "URL"}, "edges": []},
+			{"id": "vdc3", "cpu": 70, "ram": 32, "status": "failed", "director_site": {"id": "site1", "pvdc": {"id": "pvdc2"}, "url": "URL"}, "edges": []}]`
+		regionsJSON = `[
+			{"name": "us-south", "endpoint": "Endpoint", "data_centers": [{"display_name": "Dallas 10", "name": "dal10", "uplink_speed": "1g"}, {"display_name": "Dallas 12", "name": "dal12", "uplink_speed": "1g"}, {"display_name": "Dallas 13", "name": "dal13", "uplink_speed": "1g"}]},
+			{"name": "eu-de", "endpoint": "Endpoint", "data_centers": [{"display_name": "Frankfurt 2", "name": "fra02", "uplink_speed": "1g"}]}]`
+		multitenantSitesJSON = `[
+			{"id": "mt1", "name": "mt1", "display_name": "Dallas multitenant", "private_only": false, "region": "us-south", "services": [], "pvdcs": [
+				{"id": "pvdcA", "name": "pvdcA", "data_center_name": "dal10", "private_only": false, "provider_types": [{"name": "reserved"}, {"name": "on_demand"}]},
+				{"id": "pvdcB", "name": "pvdcB", "data_center_name": "dal13", "private_only": true, "provider_types": [{"name": "reserved"}]}]},
+			{"id": "mt2", "name": "mt2", "display_name": "Frankfurt multitenant", "private_only": false, "region": "eu-de", "services": [], "pvdcs": [
+				{"id": "pvdcC", "name": "pvdcC", "data_center_name": "fra02", "private_only": false, "provider_types": [{"name": "on_demand"}]}]}]`
+		hostProfilesJSON = `[{"id": "BM_2S_20_CORES_192_GB", "cpu": 40, "ram": 192}]`
+	)
+
+	var vmwareService *vmwarev1.VmwareV1
+
+	BeforeEach(func() {
+		var serviceErr error
+		vmwareService, serviceErr = vmwarev1.NewVmwareV1(&vmwarev1.VmwareV1Options{
+			URL:           "http://vmwarev1/api",
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+
+	readInventory := func() *vmwarev1.Inventory {
+		inventory, err := vmwarev1.ReadInventory(strings.NewReader(fmt.Sprintf(
+			`{"director_sites": %s, "vdcs": %s, "director_site_regions": %s, "multitenant_director_sites": %s, "director_site_host_profiles": %s}`,
+			directorSitesJSON, vdcsJSON, regionsJSON, multitenantSitesJSON, hostProfilesJSON)))
+		Expect(err).To(BeNil())
+		return inventory
+	}
+	pvdcIDs := func(candidates []vmwarev1.VdcPlacementCandidate) []string {
+		ids := []string{}
+		for _, candidate := range candidates {
+			ids = append(ids, *candidate.DirectorSite.Pvdc.ID)
+		}
+		return ids
+	}
+	rejection := func(placement *vmwarev1.VdcPlacement, pvdcID string) []string {
+		for _, rejection := range placement.Rejections {
+			if rejection.PvdcID == pvdcID {
+				return rejection.Reasons
+			}
+		}
+		return nil
+	}
+
+	It(`Ranks the resource pools of a saved inventory`, func() {
+		inventory := readInventory()
+
+		requirements := vmwareService.NewVdcRequirements().SetRegion("us-south").SetCpu(30).SetRam(64)
+		placement, err := inventory.PlaceVdc(requirements)
+		Expect(err).To(BeNil())
+		Expect(pvdcIDs(placement.Candidates)).To(Equal([]string{"pvdc2", "pvdcA", "pvdcB"}))
+		best := placement.Best()
+		Expect(*best.DirectorSite.ID).To(Equal("site1"))
+		Expect(best.DirectorSite.Pvdc.ProviderType).To(BeNil())
+		// The failed VDC does not reserve capacity.
+		Expect(*best.AvailableCpu).To(Equal(int64(70)))
+		Expect(*best.AvailableRam).To(Equal(int64(352)))
+		Expect(best.Score).To(Equal(65))
+		Expect(best.Region).To(Equal("us-south"))
+		Expect(best.Explanations).To(ContainElement("40 vCPUs and 288 GB of RAM free after the placement (+15)"))
+		Expect(*placement.Candidates[1].DirectorSite.Pvdc.ProviderType.Name).To(Equal("on_demand"))
+		Expect(*placement.Candidates[2].DirectorSite.Pvdc.ProviderType.Name).To(Equal("reserved"))
+		Expect(rejection(placement, "pvdc1")).To(Equal([]string{"needs 30 vCPUs but 20 of 80 are free"}))
+		Expect(rejection(placement, "pvdcC")).To(Equal([]string{"the resource pool is in region eu-de, not us-south"}))
+
+		requirements = vmwareService.NewVdcRequirements().SetPrivateOnly(false).SetProviderType("reserved").SetCpu(10).SetRam(10).
+			SetNetworkHa(true).SetDataCenterNames([]string{"dal13", "dal10", "dal12"})
+		placement, err = inventory.PlaceVdc(requirements)
+		Expect(err).To(BeNil())
+		Expect(pvdcIDs(placement.Candidates)).To(Equal([]string{"pvdc2", "pvdc1"}))
+		Expect(placement.Candidates[0].Score).To(Equal(62))
+		Expect(placement.Candidates[0].SecondaryPvdcID).To(Equal("pvdc1"))
+		Expect(placement.Candidates[0].SecondaryDataCenterName).To(Equal("dal10"))
+		Expect(placement.Candidates[1].SecondaryPvdcID).To(Equal("pvdc2"))
+		Expect(rejection(placement, "pvdcA")).To(Equal([]string{"no ready resource pool of the Cloud Director site is in another data center for network HA"}))
+		Expect(rejection(placement, "pvdcB")).To(ContainElement("the resource pool is private only"))
+		Expect(rejection(placement, "pvdcC")).To(ContainElement("data center fra02 is not one of the requested data centers"))
+	})
+
+	It(`Subtracts the edge nodes from the headroom of a resource pool`, func() {
+		// The large performance edge of vdc2 runs two nodes of 8 vCPUs and 32 GB of RAM in pvdc2.
+		edgeVdcsJSON := strings.Replace(vdcsJSON, `"pvdc": {"id": "pvdc2"}, "url": "URL"}, "edges": []`,
+			`"pvdc": {"id": "pvdc2"}, "url": "URL"}, "edges": [{"id": "edge2", "type": "performance", "size": "large", "status": "ready_to_use"}]`, 1)
+		inventory, err := vmwarev1.ReadInventory(strings.NewReader(fmt.Sprintf(
+			`{"director_sites": %s, "vdcs": %s, "director_site_regions": %s, "multitenant_director_sites": %s, "director_site_host_profiles": %s}`,
+			directorSitesJSON, edgeVdcsJSON, regionsJSON, multitenantSitesJSON, hostProfilesJSON)))
+		Expect(err).To(BeNil())
+
+		placement, err := inventory.PlaceVdc(vmwareService.NewVdcRequirements().SetDirectorSiteType("single_tenant").SetCpu(30).SetRam(64))
+		Expect(err).To(BeNil())
+		Expect(pvdcIDs(placement.Candidates)).To(Equal([]string{"pvdc2"}))
+		best := placement.Best()
+		Expect(*best.AvailableCpu).To(Equal(int64(54)))
+		Expect(*best.AvailableRam).To(Equal(int64(288)))
+		Expect(best.Score).To(Equal(59))
+		Expect(best.Explanations).To(ContainElement("24 vCPUs and 224 GB of RAM free after the placement (+9)"))
+
+		// Without the edge, pvdc2 would have 70 vCPUs free.
+		placement, err = inventory.PlaceVdc(vmwareService.NewVdcRequirements().SetDirectorSiteType("single_tenant").SetCpu(60).SetRam(64))
+		Expect(err).To(BeNil())
+		Expect(placement.Candidates).To(BeEmpty())
+		Expect(rejection(placement, "pvdc2")).To(Equal([]string{"needs 60 vCPUs but 54 of 80 are free"}))
+	})
+
+	It(`Pairs network HA edges like the network HA plan`, func() {
+		// pvdc1 is stretched across dal10 and dal12, and pvdc2 is in another region.
+		sitesJSON := `[{"id": "site1", "name": "dedicated", "type": "single_tenant", "status": "ready_to_use", "pvdcs": [
+			{"id": "pvdc1", "name": "pvdc1", "data_center_name": "dal10", "status": "ready_to_use", "provider_types": [], "clusters": [
+				{"id": "cluster1", "name": "cluster1", "host_count": 2, "host_profile": "BM_2S_20_CORES_192_GB", "data_center_name": "dal10", "status": "ready_to_use"},
+				{"id": "cluster3", "name": "cluster3", "host_count": 2, "host_profile": "BM_2S_20_CORES_192_GB", "data_center_name": "dal12", "status": "ready_to_use"}]},
+			{"id": "pvdc2", "name": "pvdc2", "data_center_name": "fra02", "status": "ready_to_use", "provider_types": [], "clusters": [
+				{"id": "cluster2", "name": "cluster2", "host_count": 2, "host_profile": "BM_2S_20_CORES_192_GB", "data_center_name": "fra02", "status": "ready_to_use"}]}]}]`
+		inventory, err := vmwarev1.ReadInventory(strings.NewReader(fmt.Sprintf(
+			`{"director_sites": %s, "vdcs": %s, "director_site_regions": %s, "multitenant_director_sites": [], "director_site_host_profiles": %s}`,
+			sitesJSON, vdcsJSON, regionsJSON, hostProfilesJSON)))
+		Expect(err).To(BeNil())
+
+		placement, err := inventory.PlaceVdc(vmwareService.NewVdcRequirements().SetNetworkHa(true))
+		Expect(err).To(BeNil())
+		Expect(pvdcIDs(placement.Candidates)).To(Equal([]string{"pvdc1"}))
+		best := placement.Best()
+		Expect(best.SecondaryPvdcID).To(BeEmpty())
+		Expect(best.SecondaryDataCenterName).To(Equal("dal12"))
+		Expect(best.Explanations).To(ContainElement("network HA with data center dal12 of the stretched resource pool"))
+		Expect(rejection(placement, "pvdc2")).To(Equal([]string{"no ready resource pool of the Cloud Director site is in another data center for network HA " +
+			"(resource pool pvdc1: data center dal10 is in region us-south, not eu-de)"}))
+
+		plan, err := inventory.PlanNetworkHa("site1", "pvdc1")
+		Expect(err).To(BeNil())
+		networkHa, err := plan.NetworkHa(best.SecondaryDataCenterName)
+		Expect(err).To(BeNil())
+		edge := &vmwarev1.VDCEdgePrototype{Type: core.StringPtr(vmwarev1.VDCEdgePrototype_Type_Performance), NetworkHa: networkHa}
+		Expect(plan.Validate(edge)).To(Succeed())
+	})
+
+	It(`Filters the type of Cloud Director site and validates the requirements`, func() {
+		inventory := readInventory()
+
+		placement, err := inventory.PlaceVdc(vmwareService.NewVdcRequirements().SetDirectorSiteType("multitenant").SetProviderType("on_demand"))
+		Expect(err).To(BeNil())
+		Expect(pvdcIDs(placement.Candidates)).To(Equal([]string{"pvdcA", "pvdcC"}))
+		Expect(placement.Rejections[0]).To(Equal(vmwarev1.VdcPlacementRejection{
+			DirectorSiteID: "site1",
+			Reasons:        []string{"the Cloud Director site is single_tenant, not multitenant"},
+		}))
+		Expect(rejection(placement, "pvdcB")).To(Equal([]string{"the resource pool does not offer the on_demand provider type"}))
+
+		placement, err = inventory.PlaceVdc(vmwareService.NewVdcRequirements().SetRegion("jp-tok"))
+		Expect(err).To(BeNil())
+		Expect(placement.Best()).To(BeNil())
+
+		for _, requirements := range []*vmwarev1.VdcRequirements{
+			nil,
+			vmwareService.NewVdcRequirements().SetProviderType("reserved").SetCpu(10),
+			vmwareService.NewVdcRequirements().SetProviderType("spot"),
+			vmwareService.NewVdcRequirements().SetDirectorSiteType("shared"),
+			vmwareService.NewVdcRequirements().SetRam(-1),
+		} {
+			_, err = inventory.PlaceVdc(requirements)
+			Expect(err).ToNot(BeNil())
+		}
+	})
+
+	It(`Places from live calls`, func() {
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			Expect(req.Header.Get("X-Test")).To(Equal("placement"))
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			switch req.URL.Path {
+			case "/director_sites":
+				fmt.Fprintf(res, `{"director_sites": %s}`, directorSitesJSON)
+			case "/vdcs":
+				fmt.Fprintf(res, `{"vdcs": %s}`, vdcsJSON)
+			case "/director_site_regions":
+				fmt.Fprintf(res, `{"director_site_regions": %s}`, regionsJSON)
+			case "/multitenant_director_sites":
+				fmt.Fprintf(res, `{"multitenant_director_sites": %s}`, multitenantSitesJSON)
+			case "/director_site_host_profiles":
+				fmt.Fprintf(res, `{"director_site_host_profiles": %s}`, hostProfilesJSON)
+			default:
+				Fail("unexpected request " + req.URL.Path)
+			}
+		}))
+		defer testServer.Close()
+		Expect(vmwareService.SetServiceURL(testServer.URL)).To(Succeed())
+
+		requirements := vmwareService.NewVdcRequirements().SetRegion("eu-de")
+		placeVdcOptions := vmwareService.NewPlaceVdcOptions(requirements).SetHeaders(map[string]string{"X-Test": "placement"})
+		placement, err := vmwareService.PlaceVdc(placeVdcOptions)
+		Expect(err).To(BeNil())
+		Expect(pvdcIDs(placement.Candidates)).To(Equal([]string{"pvdcC"}))
+		Expect(*placement.Best().DirectorSite.ID).To(Equal("mt2"))
+
+		_, err = vmwareService.PlaceVdc(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = vmwareService.PlaceVdc(vmwareService.NewPlaceVdcOptions(nil))
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,409 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vmwarev1
+
+// Policies are rules that requests must follow before they are sent, such as "a director site with a public console
+// has an IP allow list". A PolicyEngine evaluates its rules against the body of every request that changes resources.
+// Violations of blocking rules stop the request: the operation fails with a core.HTTPProblem whose status code is 422,
+// without calling the API, and PolicyViolationsFromError returns the violations. Violations of warning rules are
+// reported and the request is sent.
+//
+// Rules are Go functions, or declarative rules read from a JSON file with LoadPolicyRules.
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vmware-go-sdk/common"
+)
+
+// Constants associated with the PolicyRule.Severity property.
+// What happens when a rule is violated.
+const (
+	PolicyRule_Severity_Block = "block"
+	PolicyRule_Severity_Warn  = "warn"
+)
+
+// PolicyHeader is set on the responses of the requests that a PolicyEngine blocked.
+const PolicyHeader = "X-Vmware-Sdk-Policy"
+
+// PolicyInput : A request that is evaluated by the rules of a PolicyEngine. The typed field that matches the operation
+// is set; the others are nil.
+type PolicyInput struct {
+	// The operation of the request.
+	Operation RequestOperation
+
+	// The body of the request, decoded as a generic JSON object, or nil.
+	Body map[string]interface{}
+
+	// The body of a CreateDirectorSites request.
+	CreateDirectorSites *CreateDirectorSitesOptions
+
+	// The body of a CreateVdc request.
+	CreateVdc *CreateVdcOptions
+
+	// The body of an UpdateVdc request.
+	VDCPatch *VDCPatch
+
+	// The body of an UpdateDirectorSitesPvdcsCluster request.
+	ClusterPatch *ClusterPatch
+
+	// The body of a CreateDirectorSitesPvdcsClusters request.
+	CreateCluster *ClusterPrototype
+
+	// The body of a CreateDirectorSitesVcdaConnectionEndpoints request.
+	CreateVcdaConnectionEndpoints *CreateDirectorSitesVcdaConnectionEndpointsOptions
+
+	// The body of an UpdateDirectorSitesVcdaConnectionEndpoints request.
+	UpdateVcdaConnectionEndpoints *UpdateDirectorSitesVcdaConnectionEndpointsOptions
+}
+
+// PolicyViolation : A violation of a rule.
+type PolicyViolation struct {
+	// The name of the violated rule.
+	Rule string `json:"rule"`
+
+	// The severity of the rule.
+	Severity string `json:"severity"`
+
+	// The operation of the request.
+	Operation string `json:"operation"`
+
+	// The field of the request body that violates the rule, such as "ip_allow_list".
+	Field string `json:"field,omitempty"`
+
+	// A description of the violation.
+	Message string `json:"message"`
+}
+
+// String returns a description of the violation.
+func (violation PolicyViolation) String() string {
+	return fmt.Sprintf("%s (%s): %s", violation.Rule, violation.Severity, violation.Message)
+}
+
+// PolicyRule : A rule evaluated by a PolicyEngine.
+type PolicyRule struct {
+	// The name of the rule, reported in its violations.
+	Name string
+
+	// The severity of the rule: block or warn. Defaults to block.
+	Severity string
+
+	// Returns the violations of the rule by "input". The Rule, Severity and Operation of the violations are set by
+	// the engine when empty.
+	Evaluate func(input *PolicyInput) []PolicyViolation
+}
+
+// PolicyEngine : Evaluates rules against the requests that change resources.
+type PolicyEngine struct {
+	// Called with the violations of warning rules by a request that is sent. If nil, they are logged as warnings with
+	// the logger of the SDK.
+	OnWarning func(operation RequestOperation, violations []PolicyViolation)
+
+	mutex sync.RWMutex
+	rules []PolicyRule
+}
+
+// NewPolicyEngine : Instantiate PolicyEngine
+func NewPolicyEngine(rules ...PolicyRule) *PolicyEngine {
+	return &PolicyEngine{rules: rules}
+}
+
+// EnablePolicies installs "engine" on the service.
+func (vmware *VmwareV1) EnablePolicies(engine *PolicyEngine) {
+	vmware.Use(engine.Middleware())
+}
+
+// AddRules adds rules to the engine.
+func (engine *PolicyEngine) AddRules(rules ...PolicyRule) {
+	engine.mutex.Lock()
+	defer engine.mutex.Unlock()
+	engine.rules = append(engine.rules, rules...)
+}
+
+// Evaluate returns the violations of the rules of the engine by "input".
+func (engine *PolicyEngine) Evaluate(input *PolicyInput) (violations []PolicyViolation) {
+	engine.mutex.RLock()
+	rules := engine.rules
+	engine.mutex.RUnlock()
+
+	for _, rule := range rules {
+		if rule.Evaluate == nil {
+			continue
+		}
+		severity := rule.Severity
+		if severity == "" {
+			severity = PolicyRule_Severity_Block
+		}
+		for _, violation := range rule.Evaluate(input) {
+			if violation.Rule == "" {
+				violation.Rule = rule.Name
+			}
+			if violation.Severity == "" {
+				violation.Severity = severity
+			}
+			if violation.Operation == "" {
+				violation.Operation = input.Operation.Name
+			}
+			violations = append(violations, violation)
+		}
+	}
+	return
+}
+
+// Middleware returns the middleware that evaluates the rules of the engine before requests are sent.
+func (engine *PolicyEngine) Middleware() Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			operation, ok := OperationForRequest(req)
+			if !ok || !operation.Mutating {
+				return next.RoundTrip(req)
+			}
+			input, err := newPolicyInput(req, operation)
+			if err != nil {
+				return nil, err
+			}
+
+			var blocking, warnings []PolicyViolation
+			for _, violation := range engine.Evaluate(input) {
+				if violation.Severity == PolicyRule_Severity_Warn {
+					warnings = append(warnings, violation)
+				} else {
+					blocking = append(blocking, violation)
+				}
+			}
+			if len(blocking) > 0 {
+				return policyBlockedResponse(req, append(blocking, warnings...)), nil
+			}
+			if len(warnings) > 0 {
+				if engine.OnWarning != nil {
+					engine.OnWarning(operation, warnings)
+				} else {
+					for _, warning := range warnings {
+						core.GetLogger().Warn("policy violation by %s: %s", operation.Name, warning.String())
+					}
+				}
+			}
+			return next.RoundTrip(req)
+		})
+	}
+}
+
+// policyBlockedResponse returns the response of a request that violates blocking rules. Its body follows the format of
+// the errors of the API, so that the operation fails with a core.HTTPProblem, and also carries the violations.
+func policyBlockedResponse(req *http.Request, violations []PolicyViolation) *http.Response {
+	messages := make([]string, 0, len(violations))
+	errs := make([]map[string]interface{}, 0, len(violations))
+	for _, violation := range violations {
+		messages = append(messages, violation.String())
+		errs = append(errs, map[string]interface{}{
+			"code":    "policy_violation",
+			"message": violation.String(),
+		})
+	}
+	body, _ := json.Marshal(map[string]interface{}{
+		"errors":     errs,
+		"message":    "the request violates policies: " + strings.Join(messages, "; "),
+		"violations": violations,
+	})
+	header := http.Header{}
+	header.Set("Content-Type", "application/json")
+	header.Set(PolicyHeader, "blocked")
+	return syntheticResponse(req, http.StatusUnprocessableEntity, header, body)
+}
+
+// PolicyViolationsFromError returns the violations of the request that failed with "err", or nil if the request was
+// not blocked by a PolicyEngine.
+func PolicyViolationsFromError(err error) []PolicyViolation {
+	var httpProblem *core.HTTPProblem
+	if !errors.As(err, &httpProblem) || httpProblem.Response == nil {
+		return nil
+	}
+	if headerValue(httpProblem.Response.Headers, PolicyHeader) == "" {
+		return nil
+	}
+	result, ok := httpProblem.Response.Result.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	buf, err := json.Marshal(result["violations"])
+	if err != nil {
+		return nil
+	}
+	var violations []PolicyViolation
+	if json.Unmarshal(buf, &violations) != nil {
+		return nil
+	}
+	return violations
+}
+
+// newPolicyInput decodes the body of "req", leaving it readable.
+func newPolicyInput(req *http.Request, operation RequestOperation) (*PolicyInput, error) {
+	input := &PolicyInput{Operation: operation}
+	body, err := readRequestBody(req)
+	if err != nil {
+		return input, core.RepurposeSDKProblem(err, "policy-read-body-error")
+	}
+	if len(body) == 0 {
+		return input, nil
+	}
+	if json.Unmarshal(body, &input.Body) != nil {
+		// Not a JSON object: the typed inputs cannot be decoded either.
+		return input, nil
+	}
+
+	var m map[string]json.RawMessage
+	_ = json.Unmarshal(body, &m)
+	switch operation.Name {
+	case "CreateDirectorSites":
+		input.CreateDirectorSites = &CreateDirectorSitesOptions{}
+		err = json.Unmarshal(body, input.CreateDirectorSites)
+	case "CreateVdc":
+		err = decodeCreateVdcBody(m, body, input)
+	case "UpdateVdc":
+		err = core.UnmarshalModel(m, "", &input.VDCPatch, UnmarshalVDCPatch)
+	case "UpdateDirectorSitesPvdcsCluster":
+		err = core.UnmarshalModel(m, "", &input.ClusterPatch, UnmarshalClusterPatch)
+	case "CreateDirectorSitesPvdcsClusters":
+		err = core.UnmarshalModel(m, "", &input.CreateCluster, UnmarshalClusterPrototype)
+	case "CreateDirectorSitesVcdaConnectionEndpoints":
+		input.CreateVcdaConnectionEndpoints = &CreateDirectorSitesVcdaConnectionEndpointsOptions{}
+		err = json.Unmarshal(body, input.CreateVcdaConnectionEndpoints)
+		input.CreateVcdaConnectionEndpoints.SiteID = stringPtrOrNil(operation.PathParams["site_id"])
+	case "UpdateDirectorSitesVcdaConnectionEndpoints":
+		input.UpdateVcdaConnectionEndpoints = &UpdateDirectorSitesVcdaConnectionEndpointsOptions{}
+		err = json.Unmarshal(body, input.UpdateVcdaConnectionEndpoints)
+		input.UpdateVcdaConnectionEndpoints.SiteID = stringPtrOrNil(operation.PathParams["site_id"])
+		input.UpdateVcdaConnectionEndpoints.ID = stringPtrOrNil(operation.PathParams["id"])
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "policy-decode-error", common.GetComponentInfo())
+	}
+	return input, err
+}
+
+// decodeCreateVdcBody decodes the body of a CreateVdc request. The edge is decoded by its model unmarshaller, as its
+// network_ha field is an interface.
+func decodeCreateVdcBody(m map[string]json.RawMessage, body []byte, input *PolicyInput) error {
+	var createVdc struct {
+		CreateVdcOptions
+		Edge json.RawMessage `json:"edge,omitempty"`
+	}
+	err := json.Unmarshal(body, &createVdc)
+	if err != nil {
+		return err
+	}
+	input.CreateVdc = &createVdc.CreateVdcOptions
+	if len(createVdc.Edge) > 0 {
+		return core.UnmarshalModel(m, "edge", &input.CreateVdc.Edge, UnmarshalVDCEdgePrototype)
+	}
+	return nil
+}
+
+func stringPtrOrNil(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// RequireIPAllowListForPublicConsole returns a rule that blocks director sites with a public console and no IP allow
+// list.
+func RequireIPAllowListForPublicConsole() PolicyRule {
+	return PolicyRule{
+		Name: "public-console-requires-ip-allow-list",
+		Evaluate: func(input *PolicyInput) []PolicyViolation {
+			site := input.CreateDirectorSites
+			if site == nil || stringValue(site.ConsoleConnectionType) != CreateDirectorSitesOptions_ConsoleConnectionType_Public {
+				return nil
+			}
+			if len(site.IpAllowList) > 0 {
+				return nil
+			}
+			return []PolicyViolation{{
+				Field:   "ip_allow_list",
+				Message: fmt.Sprintf("director site '%s' has a public console but no IP allow list", stringValue(site.Name)),
+			}}
+		},
+	}
+}
+
+// RequirePrivateOnlyEdges returns a rule that blocks VDCs with an edge that is not private only in the resource groups
+// "resourceGroupIDs". Use the empty ID for VDCs that do not set a resource group.
+func RequirePrivateOnlyEdges(resourceGroupIDs ...string) PolicyRule {
+	return PolicyRule{
+		Name: "private-only-edges",
+		Evaluate: func(input *PolicyInput) []PolicyViolation {
+			vdc := input.CreateVdc
+			if vdc == nil || vdc.Edge == nil {
+				return nil
+			}
+			resourceGroupID := ""
+			if vdc.ResourceGroup != nil {
+				resourceGroupID = stringValue(vdc.ResourceGroup.ID)
+			}
+			if !containsString(resourceGroupIDs, resourceGroupID) {
+				return nil
+			}
+			if vdc.Edge.PrivateOnly != nil && *vdc.Edge.PrivateOnly {
+				return nil
+			}
+			return []PolicyViolation{{
+				Field:   "edge.private_only",
+				Message: fmt.Sprintf("the edge of VDC '%s' must be private only in resource group '%s'", stringValue(vdc.Name), resourceGroupID),
+			}}
+		},
+	}
+}
+
+// MinimumClusterHosts returns a rule that blocks clusters with less than "hosts" hosts, whether they are created with
+// a director site, added to a resource pool or resized.
+func MinimumClusterHosts(hosts int64) PolicyRule {
+	check := func(field string, name string, hostCount *int64) []PolicyViolation {
+		if hostCount == nil || *hostCount >= hosts {
+			return nil
+		}
+		return []PolicyViolation{{
+			Field:   field,
+			Message: fmt.Sprintf("cluster '%s' would have %d hosts, less than the minimum of %d", name, *hostCount, hosts),
+		}}
+	}
+	return PolicyRule{
+		Name: "minimum-cluster-hosts",
+		Evaluate: func(input *PolicyInput) (violations []PolicyViolation) {
+			switch {
+			case input.ClusterPatch != nil:
+				violations = check("host_count", input.Operation.PathParams["id"], input.ClusterPatch.HostCount)
+			case input.CreateCluster != nil:
+				violations = check("host_count", stringValue(input.CreateCluster.Name), input.CreateCluster.HostCount)
+			case input.CreateDirectorSites != nil:
+				for i, pvdc := range input.CreateDirectorSites.Pvdcs {
+					for j, cluster := range pvdc.Clusters {
+						field := fmt.Sprintf("pvdcs[%d].clusters[%d].host_count", i, j)
+						violations = append(violations, check(field, stringValue(cluster.Name), cluster.HostCount)...)
+					}
+				}
+			}
+			return
+		},
+	}
+}
@@ -0,0 +1,310 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vmwarev1
+
+// Declarative policy rules are read from JSON documents such as:
+//
+//	{
+//	  "rules": [
+//	    {
+//	      "name": "public-console-requires-ip-allow-list",
+//	      "severity": "block",
+//	      "operations": ["CreateDirectorSites"],
+//	      "when": [{"field": "console_connection_type", "equals": "public"}],
+//	      "require": [{"field": "ip_allow_list", "present": true}]
+//	    }
+//	  ]
+//	}
+//
+// A rule applies to a request of one of its operations (or of any operation that changes resources when none is
+// listed) whose body meets all the "when" conditions. Each "require" condition that the body does not meet is a
+// violation.
+//
+// The field of a condition is a dot-separated path in the JSON body of the request, such as "edge.private_only".
+// Arrays are traversed, so "pvdcs.clusters.host_count" designates the host counts of all the clusters. The "path."
+// prefix designates a path parameter, such as "path.site_id". A condition is met when:
+//   - "present" is true and the field has a non-empty value, or "present" is false and it has none;
+//   - every value of the field is equal to "equals", is one of "in", is at least "min" and is at most "max".
+//
+// A "when" condition on a field that has no value is not met, so that rules only apply to the requests that set the
+// field. A "require" condition on a field that has no value is met, unless it has "present": true.
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vmware-go-sdk/common"
+)
+
+// PolicyRuleSpec : A declarative policy rule.
+type PolicyRuleSpec struct {
+	// The name of the rule.
+	Name string `json:"name"`
+
+	// The severity of the rule: block or warn. Defaults to block.
+	Severity string `json:"severity,omitempty"`
+
+	// The operations that the rule applies to, such as "CreateVdc". If empty, the rule applies to every operation that
+	// changes resources.
+	Operations []string `json:"operations,omitempty"`
+
+	// The conditions that a request must meet for the rule to apply.
+	When []PolicyCondition `json:"when,omitempty"`
+
+	// The conditions that a request must meet to follow the rule.
+	Require []PolicyCondition `json:"require"`
+
+	// The message of the violations. If empty, the violated condition is described.
+	Message string `json:"message,omitempty"`
+}
+
+// PolicyCondition : A condition on a field of a request.
+type PolicyCondition struct {
+	// The dot-separated path of the field in the request body, or "path." followed by the name of a path parameter.
+	Field string `json:"field"`
+
+	// Whether the field must have a value, or must not have one.
+	Present *bool `json:"present,omitempty"`
+
+	// The value that the field must be equal to.
+	Equals interface{} `json:"equals,omitempty"`
+
+	// The values that the field must be one of.
+	In []interface{} `json:"in,omitempty"`
+
+	// The minimum value of a numeric field.
+	Min *float64 `json:"min,omitempty"`
+
+	// The maximum value of a numeric field.
+	Max *float64 `json:"max,omitempty"`
+}
+
+// policyRulesDocument is the format of a declarative rule file.
+type policyRulesDocument struct {
+	Rules []PolicyRuleSpec `json:"rules"`
+}
+
+// LoadPolicyRules reads declarative rules from "reader" and returns them as rules of a PolicyEngine.
+func LoadPolicyRules(reader io.Reader) ([]PolicyRule, error) {
+	decoder := json.NewDecoder(reader)
+	decoder.DisallowUnknownFields()
+	document := &policyRulesDocument{}
+	err := decoder.Decode(document)
+	if err != nil {
+		return nil, core.SDKErrorf(err, fmt.Sprintf("invalid policy rules: %s", err.Error()), "policy-rules-decode-error", common.GetComponentInfo())
+	}
+
+	rules := make([]PolicyRule, 0, len(document.Rules))
+	for i := range document.Rules {
+		rule, err := document.Rules[i].Rule()
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// LoadPolicyRulesFile reads declarative rules from the file at "path".
+func LoadPolicyRulesFile(path string) ([]PolicyRule, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "policy-rules-read-error", common.GetComponentInfo())
+	}
+	defer file.Close()
+	return LoadPolicyRules(file)
+}
+
+// Rule validates the declarative rule and returns it as a rule of a PolicyEngine.
+func (spec *PolicyRuleSpec) Rule() (PolicyRule, error) {
+	invalid := func(format string, args ...interface{}) (PolicyRule, error) {
+		message := fmt.Sprintf("invalid policy rule '%s': ", spec.Name) + fmt.Sprintf(format, args...)
+		return PolicyRule{}, core.SDKErrorf(nil, message, "policy-rule-invalid", common.GetComponentInfo())
+	}
+	if spec.Name == "" {
+		return invalid("the name is missing")
+	}
+	if spec.Severity != "" && spec.Severity != PolicyRule_Severity_Block && spec.Severity != PolicyRule_Severity_Warn {
+		return invalid("unknown severity '%s'", spec.Severity)
+	}
+	if len(spec.Require) == 0 {
+		return invalid("no required conditions")
+	}
+	for _, condition := range append(append([]PolicyCondition{}, spec.When...), spec.Require...) {
+		if condition.Field == "" {
+			return invalid("a condition has no field")
+		}
+		if condition.Present == nil && condition.Equals == nil && condition.In == nil && condition.Min == nil && condition.Max == nil {
+			return invalid("the condition on '%s' has no test", condition.Field)
+		}
+	}
+
+	spec = &PolicyRuleSpec{
+		Name:       spec.Name,
+		Severity:   spec.Severity,
+		Operations: append([]string{}, spec.Operations...),
+		When:       append([]PolicyCondition{}, spec.When...),
+		Require:    append([]PolicyCondition{}, spec.Require...),
+		Message:    spec.Message,
+	}
+	return PolicyRule{
+		Name:     spec.Name,
+		Severity: spec.Severity,
+		Evaluate: spec.evaluate,
+	}, nil
+}
+
+func (spec *PolicyRuleSpec) evaluate(input *PolicyInput) (violations []PolicyViolation) {
+	if len(spec.Operations) > 0 && !containsString(spec.Operations, input.Operation.Name) {
+		return nil
+	}
+	for _, condition := range spec.When {
+		if !condition.met(input, false) {
+			return nil
+		}
+	}
+	for _, condition := range spec.Require {
+		if condition.met(input, true) {
+			continue
+		}
+		message := spec.Message
+		if message == "" {
+			message = condition.String()
+		}
+		violations = append(violations, PolicyViolation{
+			Field:   condition.Field,
+			Message: message,
+		})
+	}
+	return
+}
+
+// met returns true if "input" meets the condition. "vacuous" tells whether the tests of values are met by a field
+// that has no value.
+func (condition PolicyCondition) met(input *PolicyInput, vacuous bool) bool {
+	values := condition.values(input)
+	if condition.Present != nil && *condition.Present != (len(values) > 0) {
+		return false
+	}
+	if condition.Equals == nil && condition.In == nil && condition.Min == nil && condition.Max == nil {
+		return true
+	}
+	if len(values) == 0 {
+		return vacuous
+	}
+	for _, value := range values {
+		if condition.Equals != nil && !reflect.DeepEqual(value, condition.Equals) {
+			return false
+		}
+		if condition.In != nil && !containsValue(condition.In, value) {
+			return false
+		}
+		if condition.Min != nil || condition.Max != nil {
+			number, ok := value.(float64)
+			if !ok || (condition.Min != nil && number < *condition.Min) || (condition.Max != nil && number > *condition.Max) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// values returns the non-empty values of the field of the condition in "input".
+func (condition PolicyCondition) values(input *PolicyInput) []interface{} {
+	if name, ok := strings.CutPrefix(condition.Field, "path."); ok {
+		if value := input.Operation.PathParams[name]; value != "" {
+			return []interface{}{value}
+		}
+		return nil
+	}
+	if input.Body == nil {
+		return nil
+	}
+	return fieldValues(input.Body, strings.Split(condition.Field, "."))
+}
+
+// String describes the condition.
+func (condition PolicyCondition) String() string {
+	var tests []string
+	if condition.Present != nil {
+		if *condition.Present {
+			tests = append(tests, "must be set")
+		} else {
+			tests = append(tests, "must not be set")
+		}
+	}
+	if condition.Equals != nil {
+		tests = append(tests, fmt.Sprintf("must be %v", condition.Equals))
+	}
+	if condition.In != nil {
+		tests = append(tests, fmt.Sprintf("must be one of %v", condition.In))
+	}
+	if condition.Min != nil {
+		tests = append(tests, fmt.Sprintf("must be at least %v", *condition.Min))
+	}
+	if condition.Max != nil {
+		tests = append(tests, fmt.Sprintf("must be at most %v", *condition.Max))
+	}
+	return fmt.Sprintf("'%s' %s", condition.Field, strings.Join(tests, " and "))
+}
+
+// fieldValues returns the non-empty values found at "path" in "value", traversing arrays.
+func fieldValues(value interface{}, path []string) (values []interface{}) {
+	if array, ok := value.([]interface{}); ok {
+		for _, element := range array {
+			values = append(values, fieldValues(element, path)...)
+		}
+		return
+	}
+	if len(path) == 0 {
+		if isEmptyValue(value) {
+			return nil
+		}
+		return []interface{}{value}
+	}
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return fieldValues(object[path[0]], path[1:])
+}
+
+func isEmptyValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+func containsValue(values []interface{}, value interface{}) bool {
+	for _, v := range values {
+		if reflect.DeepEqual(v, value) {
+			return true
+		}
+	}
+	return false
+}
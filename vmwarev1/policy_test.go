@@ -0,0 +1,244 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package vmwarev1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vmware-go-sdk/vmwarev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`PolicyEngine`, func() {
+	var (
+		testServer    *httptest.Server
+		vmwareService *vmwarev1.VmwareV1
+		received      []string
+	)
+
+	newSite := func(consoleConnectionType string, ipAllowList []string, hostCount int64) *vmwarev1.CreateDirectorSitesOptions {
+		cluster, err := vmwareService.NewClusterPrototype("cluster1", hostCount, "BM_2S_20_CORES_192_GB", &vmwarev1.FileSharesPrototype{STORAGETWOIOPSGB: core.Int64Ptr(24000)})
+		Expect(err).To(BeNil())
+		pvdc, err := vmwareService.NewPVDCPrototype("pvdc1", "dal10", []vmwarev1.ClusterPrototype{*cluster})
+		Expect(err).To(BeNil())
+		options := vmwareService.NewCreateDirectorSitesOptions("site1", []vmwarev1.PVDCPrototype{*pvdc})
+		options.SetConsoleConnectionType(consoleConnectionType)
+		options.SetIpAllowList(ipAllowList)
+		return options
+	}
+
+	newVdc := func(resourceGroupID string, privateOnly bool) *vmwarev1.CreateVdcOptions {
+		pvdc, err := vmwareService.NewDirectorSitePVDC("pvdc1")
+		Expect(err).To(BeNil())
+		directorSite, err := vmwareService.NewVDCDirectorSitePrototype("site1", pvdc)
+		Expect(err).To(BeNil())
+		edge, err := vmwareService.NewVDCEdgePrototype(vmwarev1.VDCEdgePrototype_Type_Performance)
+		Expect(err).To(BeNil())
+		edge.PrivateOnly = core.BoolPtr(privateOnly)
+		resourceGroup, err := vmwareService.NewResourceGroupIdentity(resourceGroupID)
+		Expect(err).To(BeNil())
+		options := vmwareService.NewCreateVdcOptions("vdc1", directorSite)
+		options.SetEdge(edge)
+		options.SetResourceGroup(resourceGroup)
+		return options
+	}
+
+	BeforeEach(func() {
+		received = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			received = append(received, req.Method+" "+req.URL.EscapedPath())
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(202)
+			fmt.Fprint(res, `{"id": "id1", "name": "name1"}`)
+		}))
+		var serviceErr error
+		vmwareService, serviceErr = vmwarev1.NewVmwareV1(&vmwarev1.VmwareV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Blocks the requests that violate blocking rules without sending them`, func() {
+		vmwareService.EnableRetries(3, 0)
+		vmwareService.EnablePolicies(vmwarev1.NewPolicyEngine(vmwarev1.RequireIPAllowListForPublicConsole()))
+
+		_, response, err := vmwareService.CreateDirectorSites(newSite(vmwarev1.CreateDirectorSitesOptions_ConsoleConnectionType_Public, nil, 3))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("no IP allow list"))
+		Expect(response.StatusCode).To(Equal(422))
+		Expect(response.Headers.Get(vmwarev1.PolicyHeader)).To(Equal("blocked"))
+		Expect(received).To(BeEmpty())
+
+		violations := vmwarev1.PolicyViolationsFromError(err)
+		Expect(violations).To(Equal([]vmwarev1.PolicyViolation{{
+			Rule:      "public-console-requires-ip-allow-list",
+			Severity:  vmwarev1.PolicyRule_Severity_Block,
+			Operation: "CreateDirectorSites",
+			Field:     "ip_allow_list",
+			Message:   "director site 'site1' has a public console but no IP allow list",
+		}}))
+
+		_, _, err = vmwareService.CreateDirectorSites(newSite(vmwarev1.CreateDirectorSitesOptions_ConsoleConnectionType_Public, []string{"10.0.0.0/8"}, 3))
+		Expect(err).To(BeNil())
+		_, _, err = vmwareService.CreateDirectorSites(newSite(vmwarev1.CreateDirectorSitesOptions_ConsoleConnectionType_Private, nil, 3))
+		Expect(err).To(BeNil())
+		Expect(received).To(Equal([]string{"POST /director_sites", "POST /director_sites"}))
+		Expect(vmwarev1.PolicyViolationsFromError(err)).To(BeNil())
+	})
+
+	It(`Reports the violations of warning rules and sends the requests`, func() {
+		rule := vmwarev1.MinimumClusterHosts(3)
+		rule.Severity = vmwarev1.PolicyRule_Severity_Warn
+		engine := vmwarev1.NewPolicyEngine(rule)
+		var warnings []vmwarev1.PolicyViolation
+		engine.OnWarning = func(operation vmwarev1.RequestOperation, violations []vmwarev1.PolicyViolation) {
+			Expect(operation.Name).To(Equal("CreateDirectorSites"))
+			warnings = append(warnings, violations...)
+		}
+		vmwareService.EnablePolicies(engine)
+
+		_, _, err := vmwareService.CreateDirectorSites(newSite(vmwarev1.CreateDirectorSitesOptions_ConsoleConnectionType_Private, nil, 2))
+		Expect(err).To(BeNil())
+		Expect(received).To(HaveLen(1))
+		Expect(warnings).To(HaveLen(1))
+		Expect(warnings[0].Severity).To(Equal(vmwarev1.PolicyRule_Severity_Warn))
+		Expect(warnings[0].Field).To(Equal("pvdcs[0].clusters[0].host_count"))
+	})
+
+	It(`Evaluates cluster patches`, func() {
+		vmwareService.EnablePolicies(vmwarev1.NewPolicyEngine(vmwarev1.MinimumClusterHosts(3)))
+
+		patch, err := (&vmwarev1.ClusterPatch{HostCount: core.Int64Ptr(2)}).AsPatch()
+		Expect(err).To(BeNil())
+		_, _, err = vmwareService.UpdateDirectorSitesPvdcsCluster(vmwareService.NewUpdateDirectorSitesPvdcsClusterOptions("site1", "cluster1", "pvdc1", patch))
+		Expect(vmwarev1.PolicyViolationsFromError(err)).To(HaveLen(1))
+		Expect(vmwarev1.PolicyViolationsFromError(err)[0].Message).To(Equal("cluster 'cluster1' would have 2 hosts, less than the minimum of 3"))
+
+		patch, err = (&vmwarev1.ClusterPatch{HostCount: core.Int64Ptr(4)}).AsPatch()
+		Expect(err).To(BeNil())
+		_, _, err = vmwareService.UpdateDirectorSitesPvdcsCluster(vmwareService.NewUpdateDirectorSitesPvdcsClusterOptions("site1", "cluster1", "pvdc1", patch))
+		Expect(err).To(BeNil())
+		Expect(received).To(Equal([]string{"PATCH /director_sites/site1/pvdcs/pvdc1/clusters/cluster1"}))
+	})
+
+	It(`Evaluates the edges of new VDCs in the listed resource groups`, func() {
+		vmwareService.EnablePolicies(vmwarev1.NewPolicyEngine(vmwarev1.RequirePrivateOnlyEdges("rg-prod")))
+
+		_, _, err := vmwareService.CreateVdc(newVdc("rg-prod", false))
+		violations := vmwarev1.PolicyViolationsFromError(err)
+		Expect(violations).To(HaveLen(1))
+		Expect(violations[0].Field).To(Equal("edge.private_only"))
+
+		_, _, err = vmwareService.CreateVdc(newVdc("rg-prod", true))
+		Expect(err).To(BeNil())
+		_, _, err = vmwareService.CreateVdc(newVdc("rg-dev", false))
+		Expect(err).To(BeNil())
+		Expect(received).To(HaveLen(2))
+	})
+
+	It(`Loads declarative rules`, func() {
+		rules, err := vmwarev1.LoadPolicyRules(strings.NewReader(`{
+			"rules": [
+				{
+					"name": "public-console-requires-ip-allow-list",
+					"operations": ["CreateDirectorSites"],
+					"when": [{"field": "console_connection_type", "equals": "public"}],
+					"require": [{"field": "ip_allow_list", "present": true}]
+				},
+				{
+					"name": "minimum-cluster-hosts",
+					"severity": "block",
+					"require": [{"field": "pvdcs.clusters.host_count", "min": 3}, {"field": "host_count", "min": 3}],
+					"message": "clusters need at least 3 hosts"
+				},
+				{
+					"name": "known-sites",
+					"severity": "warn",
+					"require": [{"field": "path.site_id", "in": ["site1", "site2"]}]
+				}
+			]
+		}`))
+		Expect(err).To(BeNil())
+		Expect(rules).To(HaveLen(3))
+		engine := vmwarev1.NewPolicyEngine(rules...)
+		var warnings []vmwarev1.PolicyViolation
+		engine.OnWarning = func(operation vmwarev1.RequestOperation, violations []vmwarev1.PolicyViolation) {
+			warnings = append(warnings, violations...)
+		}
+		vmwareService.EnablePolicies(engine)
+
+		_, _, err = vmwareService.CreateDirectorSites(newSite(vmwarev1.CreateDirectorSitesOptions_ConsoleConnectionType_Public, nil, 2))
+		violations := vmwarev1.PolicyViolationsFromError(err)
+		Expect(violations).To(HaveLen(2))
+		Expect(violations[0].Rule).To(Equal("public-console-requires-ip-allow-list"))
+		Expect(violations[0].Message).To(Equal("'ip_allow_list' must be set"))
+		Expect(violations[1].Rule).To(Equal("minimum-cluster-hosts"))
+		Expect(violations[1].Field).To(Equal("pvdcs.clusters.host_count"))
+		Expect(violations[1].Message).To(Equal("clusters need at least 3 hosts"))
+
+		patch, err := (&vmwarev1.ClusterPatch{HostCount: core.Int64Ptr(2)}).AsPatch()
+		Expect(err).To(BeNil())
+		_, _, err = vmwareService.UpdateDirectorSitesPvdcsCluster(vmwareService.NewUpdateDirectorSitesPvdcsClusterOptions("site9", "cluster1", "pvdc1", patch))
+		violations = vmwarev1.PolicyViolationsFromError(err)
+		Expect(violations).To(HaveLen(2))
+		Expect(violations[0].Field).To(Equal("host_count"))
+		Expect(violations[1].Rule).To(Equal("known-sites"))
+
+		_, _, err = vmwareService.CreateDirectorSites(newSite(vmwarev1.CreateDirectorSitesOptions_ConsoleConnectionType_Public, []string{"10.0.0.0/8"}, 3))
+		Expect(err).To(BeNil())
+		_, _, err = vmwareService.UpdateDirectorSitesPvdcsCluster(vmwareService.NewUpdateDirectorSitesPvdcsClusterOptions("site9", "cluster1", "pvdc1", map[string]interface{}{"name": "renamed"}))
+		Expect(err).To(BeNil())
+		Expect(received).To(HaveLen(2))
+		Expect(warnings).To(HaveLen(1))
+		Expect(warnings[0].Message).To(Equal("'path.site_id' must be one of [site1 site2]"))
+	})
+
+	It(`Rejects invalid declarative rules`, func() {
+		_, err := vmwarev1.LoadPolicyRules(strings.NewReader(`{"rules": [{"name": "r1", "require": [{"field": "cpu"}]}]}`))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("the condition on 'cpu' has no test"))
+
+		_, err = vmwarev1.LoadPolicyRules(strings.NewReader(`{"rules": [{"name": "r1", "severity": "fatal", "require": [{"field": "cpu", "min": 1}]}]}`))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("unknown severity 'fatal'"))
+
+		_, err = vmwarev1.LoadPolicyRules(strings.NewReader(`{"rules": [{"name": "r1", "require": [{"field": "cpu", "minimum": 1}]}]}`))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("invalid policy rules"))
+
+		directory, err := os.MkdirTemp("", "policy")
+		Expect(err).To(BeNil())
+		defer os.RemoveAll(directory)
+		path := filepath.Join(directory, "rules.json")
+		Expect(os.WriteFile(path, []byte(`{"rules": [{"name": "r1", "require": [{"field": "cpu", "max": 64}]}]}`), 0600)).To(Succeed())
+		rules, err := vmwarev1.LoadPolicyRulesFile(path)
+		Expect(err).To(BeNil())
+		Expect(rules).To(HaveLen(1))
+		Expect(rules[0].Name).To(Equal("r1"))
+	})
+})
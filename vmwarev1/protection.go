@@ -0,0 +1,373 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vmwarev1
+
+// A ResourceProtection guards against mistakes that are expensive to undo. Director sites, VDCs and clusters can be
+// marked protected by ID or by name pattern, in code or in a protection file, and the requests that delete them are
+// refused. Patches that reduce the host count of a cluster, or the CPU or RAM of a VDC, by more than a threshold are
+// refused as well.
+//
+// A refused request fails without calling the API, with an error that wraps the *ProtectionError of the reason, so
+// that errors.As or ProtectionErrorFromError returns it. To go ahead anyway, send the request again with the override
+// token of the protection in the ProtectionOverrideHeader header. The refusal is returned by the transport of the
+// service, so enable retries with EnableRetries before the protection, or refused requests are retried.
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vmware-go-sdk/common"
+)
+
+// ProtectionOverrideHeader is the request header that carries the override token of a ResourceProtection. It is
+// removed before the request is sent.
+const ProtectionOverrideHeader = "X-Vmware-Sdk-Protection-Override"
+
+// Constants associated with the ProtectionError.Reason property.
+// Why the request was refused.
+const (
+	ProtectionError_Reason_Protected    = "protected"
+	ProtectionError_Reason_Shrink       = "shrink"
+	ProtectionError_Reason_InvalidToken = "invalid_override_token"
+)
+
+// ProtectionError : A request refused by a ResourceProtection.
+type ProtectionError struct {
+	// Why the request was refused.
+	Reason string `json:"reason"`
+
+	// The name of the operation, such as "DeleteVdc".
+	Operation string `json:"operation"`
+
+	// The ID of the resource.
+	ResourceID string `json:"resource_id"`
+
+	// The name of the resource, if it was read.
+	ResourceName string `json:"resource_name,omitempty"`
+
+	// A description of the refusal.
+	Message string `json:"message"`
+}
+
+// Error returns the description of the refusal.
+func (protectionError *ProtectionError) Error() string {
+	return protectionError.Message
+}
+
+// ResourceProtection : Refuses the requests that delete protected resources or shrink resources too much.
+type ResourceProtection struct {
+	overrideToken string
+
+	mutex            sync.RWMutex
+	ids              map[string]bool
+	namePatterns     []string
+	maxShrinkPercent *float64
+}
+
+// NewResourceProtection : Instantiate ResourceProtection
+// The requests that carry "overrideToken" in the ProtectionOverrideHeader header are let through. If "overrideToken"
+// is empty, no request can override the protection.
+func NewResourceProtection(overrideToken string) *ResourceProtection {
+	return &ResourceProtection{
+		overrideToken: overrideToken,
+		ids:           map[string]bool{},
+	}
+}
+
+// EnableResourceProtection installs "protection" on the service.
+func (vmware *VmwareV1) EnableResourceProtection(protection *ResourceProtection) {
+	vmware.Use(protection.Middleware())
+}
+
+// ProtectIDs marks the director sites, VDCs and clusters with the IDs "ids" protected.
+func (protection *ResourceProtection) ProtectIDs(ids ...string) {
+	protection.mutex.Lock()
+	defer protection.mutex.Unlock()
+	for _, id := range ids {
+		protection.ids[id] = true
+	}
+}
+
+// ProtectNames marks the director sites, VDCs and clusters whose name matches one of "patterns" protected. The
+// patterns follow the syntax of path.Match, such as "prod-*".
+func (protection *ResourceProtection) ProtectNames(patterns ...string) error {
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return core.SDKErrorf(err, fmt.Sprintf("invalid name pattern '%s'", pattern), "protection-pattern-invalid", common.GetComponentInfo())
+		}
+	}
+	protection.mutex.Lock()
+	defer protection.mutex.Unlock()
+	protection.namePatterns = append(protection.namePatterns, patterns...)
+	return nil
+}
+
+// SetMaxShrinkPercent refuses the patches that reduce the host count of a cluster, or the CPU or RAM of a VDC, by more
+// than "percent" percent. With 0, every reduction is refused.
+func (protection *ResourceProtection) SetMaxShrinkPercent(percent float64) {
+	protection.mutex.Lock()
+	defer protection.mutex.Unlock()
+	protection.maxShrinkPercent = &percent
+}
+
+// protectionDocument is the format of a protection file.
+type protectionDocument struct {
+	// The IDs of the protected resources.
+	IDs []string `json:"ids"`
+
+	// The name patterns of the protected resources.
+	Names []string `json:"names"`
+
+	// The largest reduction of a patch, in percent.
+	MaxShrinkPercent *float64 `json:"max_shrink_percent"`
+}
+
+// Load adds the protections of a JSON document to the protection, such as:
+//
+//	{"ids": ["site-id"], "names": ["prod-*"], "max_shrink_percent": 25}
+//
+// All the fields are optional.
+func (protection *ResourceProtection) Load(reader io.Reader) error {
+	decoder := json.NewDecoder(reader)
+	decoder.DisallowUnknownFields()
+	document := &protectionDocument{}
+	err := decoder.Decode(document)
+	if err != nil {
+		return core.SDKErrorf(err, fmt.Sprintf("invalid protection file: %s", err.Error()), "protection-decode-error", common.GetComponentInfo())
+	}
+	err = protection.ProtectNames(document.Names...)
+	if err != nil {
+		return err
+	}
+	protection.ProtectIDs(document.IDs...)
+	if document.MaxShrinkPercent != nil {
+		protection.SetMaxShrinkPercent(*document.MaxShrinkPercent)
+	}
+	return nil
+}
+
+// LoadFile adds the protections of the file at "path" to the protection. See Load for its format.
+func (protection *ResourceProtection) LoadFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return core.SDKErrorf(err, "", "protection-read-error", common.GetComponentInfo())
+	}
+	defer file.Close()
+	return protection.Load(file)
+}
+
+// IsProtected returns true if the resource with the ID "id" and the name "name" is protected. The name may be empty
+// if it is not known.
+func (protection *ResourceProtection) IsProtected(id string, name string) bool {
+	protection.mutex.RLock()
+	defer protection.mutex.RUnlock()
+	if protection.ids[id] {
+		return true
+	}
+	if name == "" {
+		return false
+	}
+	for _, pattern := range protection.namePatterns {
+		if matched, _ := path.Match(pattern, name); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// Middleware returns the middleware that refuses the requests that the protection guards against.
+func (protection *ResourceProtection) Middleware() Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			operation, ok := OperationForRequest(req)
+			if !ok || !operation.Mutating {
+				return next.RoundTrip(req)
+			}
+			if override := headerValue(req.Header, ProtectionOverrideHeader); override != "" {
+				req = req.Clone(req.Context())
+				deleteHeader(req.Header, ProtectionOverrideHeader)
+				if protection.overrideToken != "" && subtle.ConstantTimeCompare([]byte(override), []byte(protection.overrideToken)) == 1 {
+					return next.RoundTrip(req)
+				}
+				return nil, &ProtectionError{
+					Reason:     ProtectionError_Reason_InvalidToken,
+					Operation:  operation.Name,
+					ResourceID: operation.PathParams["id"],
+					Message:    fmt.Sprintf("%s was refused: the protection override token is not valid", operation.Name),
+				}
+			}
+
+			var protectionError *ProtectionError
+			var err error
+			switch operation.Name {
+			case "DeleteDirectorSite", "DeleteVdc", "DeleteDirectorSitesPvdcsCluster":
+				protectionError, err = protection.checkDelete(next, req, operation)
+			case "UpdateVdc", "UpdateDirectorSitesPvdcsCluster":
+				protectionError, err = protection.checkShrink(next, req, operation)
+			}
+			if err != nil {
+				return nil, err
+			}
+			if protectionError != nil {
+				return nil, protectionError
+			}
+			return next.RoundTrip(req)
+		})
+	}
+}
+
+// checkDelete refuses the deletion of a protected resource. The resource is only read when its name must be matched.
+func (protection *ResourceProtection) checkDelete(next http.RoundTripper, req *http.Request, operation RequestOperation) (*ProtectionError, error) {
+	id := operation.PathParams["id"]
+	protection.mutex.RLock()
+	needsName := !protection.ids[id] && len(protection.namePatterns) > 0
+	protection.mutex.RUnlock()
+
+	name := ""
+	if needsName {
+		current, err := fetchCurrentResource(next, req)
+		if err != nil || current == nil {
+			return nil, err
+		}
+		name, _ = current["name"].(string)
+	}
+	if !protection.IsProtected(id, name) {
+		return nil, nil
+	}
+	return &ProtectionError{
+		Reason:       ProtectionError_Reason_Protected,
+		Operation:    operation.Name,
+		ResourceID:   id,
+		ResourceName: name,
+		Message:      fmt.Sprintf("%s was refused: resource '%s' is protected", operation.Name, describeResource(id, name)),
+	}, nil
+}
+
+// checkShrink refuses the patches that reduce the size of a resource by more than the threshold.
+func (protection *ResourceProtection) checkShrink(next http.RoundTripper, req *http.Request, operation RequestOperation) (*ProtectionError, error) {
+	protection.mutex.RLock()
+	maxShrinkPercent := protection.maxShrinkPercent
+	protection.mutex.RUnlock()
+	if maxShrinkPercent == nil {
+		return nil, nil
+	}
+
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "protection-read-body-error")
+	}
+	var patch map[string]interface{}
+	if json.Unmarshal(body, &patch) != nil {
+		return nil, nil
+	}
+	fields := []string{"cpu", "ram"}
+	if operation.Name == "UpdateDirectorSitesPvdcsCluster" {
+		fields = []string{"host_count"}
+	}
+	patched := false
+	for _, field := range fields {
+		if _, ok := patch[field].(float64); ok {
+			patched = true
+		}
+	}
+	if !patched {
+		return nil, nil
+	}
+
+	current, err := fetchCurrentResource(next, req)
+	if err != nil || current == nil {
+		return nil, err
+	}
+	id := operation.PathParams["id"]
+	name, _ := current["name"].(string)
+	for _, field := range fields {
+		newValue, ok := patch[field].(float64)
+		if !ok {
+			continue
+		}
+		currentValue, ok := current[field].(float64)
+		if !ok || currentValue <= 0 || newValue >= currentValue {
+			continue
+		}
+		shrinkPercent := (currentValue - newValue) / currentValue * 100
+		if shrinkPercent <= *maxShrinkPercent {
+			continue
+		}
+		return &ProtectionError{
+			Reason:       ProtectionError_Reason_Shrink,
+			Operation:    operation.Name,
+			ResourceID:   id,
+			ResourceName: name,
+			Message: fmt.Sprintf("%s was refused: it reduces the %s of '%s' from %g to %g (%.0f%%), more than the maximum of %g%%",
+				operation.Name, field, describeResource(id, name), currentValue, newValue, shrinkPercent, *maxShrinkPercent),
+		}, nil
+	}
+	return nil, nil
+}
+
+// fetchCurrentResource reads the resource at the URL of "req" with the credentials of "req", and returns it as a
+// generic JSON object, or nil if it does not exist.
+func fetchCurrentResource(next http.RoundTripper, req *http.Request) (map[string]interface{}, error) {
+	get := req.Clone(req.Context())
+	get.Method = http.MethodGet
+	get.Body = http.NoBody
+	get.GetBody = nil
+	get.ContentLength = 0
+	get.Header.Del("Content-Type")
+	response, err := next.RoundTrip(get)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if response.StatusCode >= 300 {
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("the protection could not read '%s': status code %d", req.URL.Path, response.StatusCode), "protection-read-error", common.GetComponentInfo())
+	}
+	var current map[string]interface{}
+	err = json.NewDecoder(response.Body).Decode(&current)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "protection-decode-error", common.GetComponentInfo())
+	}
+	return current, nil
+}
+
+func describeResource(id string, name string) string {
+	if name == "" {
+		return id
+	}
+	return fmt.Sprintf("%s (%s)", name, id)
+}
+
+// ProtectionErrorFromError returns the ProtectionError of the request that failed with "err", or nil if the request
+// was not refused by a ResourceProtection.
+func ProtectionErrorFromError(err error) *ProtectionError {
+	var protectionError *ProtectionError
+	if !errors.As(err, &protectionError) {
+		return nil
+	}
+	return protectionError
+}
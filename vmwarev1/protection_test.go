@@ -0,0 +1,181 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package vmwarev1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vmware-go-sdk/vmwarev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceProtection`, func() {
+	var (
+		testServer    *httptest.Server
+		vmwareService *vmwarev1.VmwareV1
+		protection    *vmwarev1.ResourceProtection
+		received      []string
+	)
+
+	BeforeEach(func() {
+		received = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			Expect(req.Header.Get(vmwarev1.ProtectionOverrideHeader)).To(BeEmpty())
+			received = append(received, req.Method+" "+req.URL.EscapedPath())
+			res.Header().Set("Content-type", "application/json")
+			path := req.URL.EscapedPath()
+			switch {
+			case strings.HasPrefix(path, "/vdcs/"):
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"id": "%s", "name": "%s-vdc", "cpu": 16, "ram": 128, "status": "ready_to_use"}`, strings.TrimPrefix(path, "/vdcs/"), strings.TrimPrefix(path, "/vdcs/"))
+			case strings.HasPrefix(path, "/director_sites/missing"):
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"errors": [{"code": "not_found", "message": "Not found"}]}`)
+			case strings.Contains(path, "/clusters/"):
+				res.WriteHeader(200)
+				fmt.Fprint(res, `{"id": "cluster1", "name": "cluster1", "host_count": 4, "status": "ready_to_use"}`)
+			case strings.HasPrefix(path, "/director_sites/"):
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"id": "%s", "name": "%s", "status": "ready_to_use"}`, strings.TrimPrefix(path, "/director_sites/"), strings.TrimPrefix(path, "/director_sites/"))
+			}
+		}))
+		var serviceErr error
+		vmwareService, serviceErr = vmwarev1.NewVmwareV1(&vmwarev1.VmwareV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		protection = vmwarev1.NewResourceProtection("let-me-through")
+		vmwareService.EnableResourceProtection(protection)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Refuses to delete a resource protected by ID unless the override token is supplied`, func() {
+		protection.ProtectIDs("vdc1")
+
+		_, response, err := vmwareService.DeleteVdc(vmwareService.NewDeleteVdcOptions("vdc1"))
+		Expect(err).ToNot(BeNil())
+		Expect(response).To(BeNil())
+		Expect(received).To(BeEmpty())
+		var protectionError *vmwarev1.ProtectionError
+		Expect(errors.As(err, &protectionError)).To(BeTrue())
+		Expect(vmwarev1.ProtectionErrorFromError(err)).To(BeIdenticalTo(protectionError))
+		Expect(protectionError).To(Equal(&vmwarev1.ProtectionError{
+			Reason:     vmwarev1.ProtectionError_Reason_Protected,
+			Operation:  "DeleteVdc",
+			ResourceID: "vdc1",
+			Message:    "DeleteVdc was refused: resource 'vdc1' is protected",
+		}))
+
+		// Other resources are not protected.
+		_, _, err = vmwareService.DeleteVdc(vmwareService.NewDeleteVdcOptions("vdc2"))
+		Expect(err).To(BeNil())
+		Expect(received).To(Equal([]string{"DELETE /vdcs/vdc2"}))
+
+		options := vmwareService.NewDeleteVdcOptions("vdc1").SetHeaders(map[string]string{vmwarev1.ProtectionOverrideHeader: "guess"})
+		_, _, err = vmwareService.DeleteVdc(options)
+		Expect(vmwarev1.ProtectionErrorFromError(err).Reason).To(Equal(vmwarev1.ProtectionError_Reason_InvalidToken))
+
+		options.SetHeaders(map[string]string{vmwarev1.ProtectionOverrideHeader: "let-me-through"})
+		_, _, err = vmwareService.DeleteVdc(options)
+		Expect(err).To(BeNil())
+		Expect(received).To(Equal([]string{"DELETE /vdcs/vdc2", "DELETE /vdcs/vdc1"}))
+
+		// The header names of the options are not canonicalized.
+		options.SetHeaders(map[string]string{strings.ToLower(vmwarev1.ProtectionOverrideHeader): "let-me-through"})
+		_, _, err = vmwareService.DeleteVdc(options)
+		Expect(err).To(BeNil())
+		Expect(received).To(HaveLen(3))
+	})
+
+	It(`Refuses to delete resources whose name matches a pattern of the protection file`, func() {
+		directory, err := os.MkdirTemp("", "protection")
+		Expect(err).To(BeNil())
+		defer os.RemoveAll(directory)
+		file := filepath.Join(directory, "protection.json")
+		Expect(os.WriteFile(file, []byte(`{"names": ["prod-*"]}`), 0600)).To(Succeed())
+		Expect(protection.LoadFile(file)).To(Succeed())
+
+		_, _, err = vmwareService.DeleteDirectorSite(vmwareService.NewDeleteDirectorSiteOptions("prod-east"))
+		protectionError := vmwarev1.ProtectionErrorFromError(err)
+		Expect(protectionError).ToNot(BeNil())
+		Expect(protectionError.ResourceName).To(Equal("prod-east"))
+		Expect(received).To(Equal([]string{"GET /director_sites/prod-east"}))
+
+		received = nil
+		_, _, err = vmwareService.DeleteDirectorSite(vmwareService.NewDeleteDirectorSiteOptions("dev-east"))
+		Expect(err).To(BeNil())
+		Expect(received).To(Equal([]string{"GET /director_sites/dev-east", "DELETE /director_sites/dev-east"}))
+
+		// A resource that does not exist is not protected: the API reports it.
+		_, _, err = vmwareService.DeleteDirectorSite(vmwareService.NewDeleteDirectorSiteOptions("missing"))
+		Expect(err).ToNot(BeNil())
+		Expect(vmwarev1.ProtectionErrorFromError(err)).To(BeNil())
+
+		Expect(protection.IsProtected("any", "prod-west")).To(BeTrue())
+		Expect(protection.Load(strings.NewReader(`{"patterns": []}`))).ToNot(Succeed())
+		Expect(protection.ProtectNames("[")).ToNot(Succeed())
+	})
+
+	It(`Refuses the patches that shrink resources by more than the threshold`, func() {
+		Expect(protection.Load(strings.NewReader(`{"max_shrink_percent": 25}`))).To(Succeed())
+
+		patch, err := (&vmwarev1.VDCPatch{Cpu: core.Int64Ptr(10)}).AsPatch()
+		Expect(err).To(BeNil())
+		_, _, err = vmwareService.UpdateVdc(vmwareService.NewUpdateVdcOptions("vdc1", patch))
+		protectionError := vmwarev1.ProtectionErrorFromError(err)
+		Expect(protectionError).ToNot(BeNil())
+		Expect(protectionError.Reason).To(Equal(vmwarev1.ProtectionError_Reason_Shrink))
+		Expect(protectionError.Message).To(Equal("UpdateVdc was refused: it reduces the cpu of 'vdc1-vdc (vdc1)' from 16 to 10 (38%), more than the maximum of 25%"))
+		Expect(received).To(Equal([]string{"GET /vdcs/vdc1"}))
+
+		received = nil
+		patch, err = (&vmwarev1.VDCPatch{Cpu: core.Int64Ptr(14), Ram: core.Int64Ptr(256)}).AsPatch()
+		Expect(err).To(BeNil())
+		_, _, err = vmwareService.UpdateVdc(vmwareService.NewUpdateVdcOptions("vdc1", patch))
+		Expect(err).To(BeNil())
+		Expect(received).To(Equal([]string{"GET /vdcs/vdc1", "PATCH /vdcs/vdc1"}))
+
+		// Patches that do not resize are not checked.
+		received = nil
+		patch, err = (&vmwarev1.VDCPatch{FastProvisioningEnabled: core.BoolPtr(true)}).AsPatch()
+		Expect(err).To(BeNil())
+		_, _, err = vmwareService.UpdateVdc(vmwareService.NewUpdateVdcOptions("vdc1", patch))
+		Expect(err).To(BeNil())
+		Expect(received).To(Equal([]string{"PATCH /vdcs/vdc1"}))
+
+		clusterPatch, err := (&vmwarev1.ClusterPatch{HostCount: core.Int64Ptr(2)}).AsPatch()
+		Expect(err).To(BeNil())
+		clusterOptions := vmwareService.NewUpdateDirectorSitesPvdcsClusterOptions("site1", "cluster1", "pvdc1", clusterPatch)
+		_, _, err = vmwareService.UpdateDirectorSitesPvdcsCluster(clusterOptions)
+		Expect(vmwarev1.ProtectionErrorFromError(err).Reason).To(Equal(vmwarev1.ProtectionError_Reason_Shrink))
+
+		clusterOptions.SetHeaders(map[string]string{vmwarev1.ProtectionOverrideHeader: "let-me-through"})
+		_, _, err = vmwareService.UpdateDirectorSitesPvdcsCluster(clusterOptions)
+		Expect(err).To(BeNil())
+	})
+})
@@ -0,0 +1,355 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vmwarev1
+
+// Several models carry secrets: UsageMeterRegistration.AccessToken, LicenseKey.Value and
+// DirectorSite.RhelVmActivationKey, as listed in sensitiveModelFields. Their String, GoString and MarshalLog methods
+// mask those fields, so that formatting a result with %v, %+v, %#v or handing it to a structured logger does not leak
+// them. MarshalJSON is left untouched, so results still round-trip through JSON. The JSON fields masked in request and
+// response bodies are derived from the same list.
+//
+// The go-sdk-core logger is shared by every SDK of the process, so the package does not replace it on its own. Call
+// VmwareV1.EnableLogRedaction to wrap it in a RedactingLogger, so that the request and response bodies logged at debug
+// level are sanitized as well: every request of the service then wraps the logger again if core.SetLogger replaced
+// it. InstallRedactingLogger wraps the current logger once.
+//
+// Redaction is enabled by default. Call SetRedactionEnabled(false) to see the secrets while debugging locally.
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// redactedValue replaces secret values in strings that may end up in logs.
+const redactedValue = "[redacted]"
+
+var redactionDisabled atomic.Bool
+
+// SetRedactionEnabled enables or disables the redaction of secrets in formatted models and in logs.
+func SetRedactionEnabled(enabled bool) {
+	redactionDisabled.Store(!enabled)
+}
+
+// IsRedactionEnabled returns true unless redaction was disabled with SetRedactionEnabled.
+func IsRedactionEnabled() bool {
+	return !redactionDisabled.Load()
+}
+
+// sensitiveModelFields lists the fields of the models that hold secrets, by model.
+var sensitiveModelFields = map[reflect.Type][]string{
+	reflect.TypeOf(UsageMeterRegistration{}): {"AccessToken"},
+	reflect.TypeOf(LicenseKey{}):             {"Value"},
+	reflect.TypeOf(DirectorSite{}):           {"RhelVmActivationKey"},
+}
+
+// The JSON names of the sensitive fields. The values of sensitiveJSONFields are secrets wherever they appear.
+// sensitiveNestedJSONFields has the names that are too common for that, such as "value", by the JSON name of the
+// fields that embed their models, such as "license_keys".
+var (
+	sensitiveJSONFields, sensitiveNestedJSONFields = readSensitiveJSONFields()
+
+	sensitiveJSONFieldsMutex sync.RWMutex
+	reSensitiveJSONField     = compileSensitiveJSONFields()
+	reSensitiveNestedFields  = compileSensitiveNestedJSONFields()
+)
+
+// readSensitiveJSONFields returns the JSON names of the fields listed in sensitiveModelFields. A name with a single
+// word is nested in the JSON names of the fields of allModels that embed its model.
+func readSensitiveJSONFields() (names []string, nestedNames map[string][]string) {
+	nestedNames = map[string][]string{}
+	for modelType, fieldNames := range sensitiveModelFields {
+		var embeddingNames []string
+		for _, model := range allModels {
+			embeddingType := reflect.TypeOf(model)
+			for i := 0; i < embeddingType.NumField(); i++ {
+				field := embeddingType.Field(i)
+				fieldType := field.Type
+				if fieldType.Kind() == reflect.Ptr || fieldType.Kind() == reflect.Slice {
+					fieldType = fieldType.Elem()
+				}
+				if fieldType == modelType {
+					embeddingNames = append(embeddingNames, jsonFieldName(field))
+				}
+			}
+		}
+		for _, fieldName := range fieldNames {
+			field, ok := modelType.FieldByName(fieldName)
+			if !ok {
+				panic(fmt.Sprintf("%s has no field %s", modelType.Name(), fieldName))
+			}
+			name := jsonFieldName(field)
+			if strings.Contains(name, "_") || len(embeddingNames) == 0 {
+				names = append(names, name)
+				continue
+			}
+			for _, embeddingName := range embeddingNames {
+				nestedNames[embeddingName] = append(nestedNames[embeddingName], name)
+			}
+		}
+	}
+	return
+}
+
+// jsonFieldName returns the JSON name of "field".
+func jsonFieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
+
+// AddSensitiveJSONField adds "name" to the JSON fields whose values RedactSensitiveData masks.
+func AddSensitiveJSONField(name string) {
+	sensitiveJSONFieldsMutex.Lock()
+	defer sensitiveJSONFieldsMutex.Unlock()
+	sensitiveJSONFields = append(sensitiveJSONFields, name)
+	reSensitiveJSONField = compileSensitiveJSONFields()
+}
+
+func compileSensitiveJSONFields() *regexp.Regexp {
+	return compileJSONStringFields(sensitiveJSONFields)
+}
+
+// compileSensitiveNestedJSONFields returns the expressions that match the sensitive fields inside the JSON array or
+// object of each embedding field.
+func compileSensitiveNestedJSONFields() map[*regexp.Regexp]*regexp.Regexp {
+	expressions := map[*regexp.Regexp]*regexp.Regexp{}
+	for embeddingName, names := range sensitiveNestedJSONFields {
+		reEmbedding := regexp.MustCompile(`"` + regexp.QuoteMeta(embeddingName) + `"\s*:\s*(?:\[[^\]]*\]|\{[^}]*\})`)
+		expressions[reEmbedding] = compileJSONStringFields(names)
+	}
+	return expressions
+}
+
+// compileJSONStringFields returns an expression that matches the string values of the JSON fields "names".
+func compileJSONStringFields(names []string) *regexp.Regexp {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = regexp.QuoteMeta(name)
+	}
+	return regexp.MustCompile(`("(?:` + strings.Join(quoted, "|") + `)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+}
+
+// RedactSensitiveData returns "input" with the values of the sensitive JSON fields masked. It is meant for request
+// and response bodies, or any text that embeds them. When redaction is disabled, "input" is returned unchanged.
+func RedactSensitiveData(input string) string {
+	if !IsRedactionEnabled() {
+		return input
+	}
+	return redactSensitiveJSON(input)
+}
+
+// redactSensitiveJSON masks the values of the sensitive JSON fields of "input", whether redaction is enabled or not.
+func redactSensitiveJSON(input string) string {
+	sensitiveJSONFieldsMutex.RLock()
+	redacted := reSensitiveJSONField.ReplaceAllString(input, `$1"`+redactedValue+`"`)
+	sensitiveJSONFieldsMutex.RUnlock()
+	for reEmbedding, reNested := range reSensitiveNestedFields {
+		redacted = reEmbedding.ReplaceAllStringFunc(redacted, func(embedded string) string {
+			return reNested.ReplaceAllString(embedded, `$1"`+redactedValue+`"`)
+		})
+	}
+	return redacted
+}
+
+// RedactingLogger : A core.Logger that masks the sensitive JSON fields of the messages before they reach Logger.
+type RedactingLogger struct {
+	// The logger the sanitized messages are written to.
+	Logger core.Logger
+}
+
+// NewRedactingLogger : Instantiate RedactingLogger
+func NewRedactingLogger(logger core.Logger) *RedactingLogger {
+	return &RedactingLogger{
+		Logger: logger,
+	}
+}
+
+// Log logs the sanitized message at "level".
+func (logger *RedactingLogger) Log(level core.LogLevel, format string, inserts ...interface{}) {
+	if logger.Logger.IsLogLevelEnabled(level) {
+		logger.Logger.Log(level, "%s", RedactSensitiveData(fmt.Sprintf(format, inserts...)))
+	}
+}
+
+// Error logs the sanitized message at level "Error".
+func (logger *RedactingLogger) Error(format string, inserts ...interface{}) {
+	if logger.Logger.IsLogLevelEnabled(core.LevelError) {
+		logger.Logger.Error("%s", RedactSensitiveData(fmt.Sprintf(format, inserts...)))
+	}
+}
+
+// Warn logs the sanitized message at level "Warn".
+func (logger *RedactingLogger) Warn(format string, inserts ...interface{}) {
+	if logger.Logger.IsLogLevelEnabled(core.LevelWarn) {
+		logger.Logger.Warn("%s", RedactSensitiveData(fmt.Sprintf(format, inserts...)))
+	}
+}
+
+// Info logs the sanitized message at level "Info".
+func (logger *RedactingLogger) Info(format string, inserts ...interface{}) {
+	if logger.Logger.IsLogLevelEnabled(core.LevelInfo) {
+		logger.Logger.Info("%s", RedactSensitiveData(fmt.Sprintf(format, inserts...)))
+	}
+}
+
+// Debug logs the sanitized message at level "Debug".
+func (logger *RedactingLogger) Debug(format string, inserts ...interface{}) {
+	if logger.Logger.IsLogLevelEnabled(core.LevelDebug) {
+		logger.Logger.Debug("%s", RedactSensitiveData(fmt.Sprintf(format, inserts...)))
+	}
+}
+
+// SetLogLevel sets the logging level of the wrapped logger.
+func (logger *RedactingLogger) SetLogLevel(level core.LogLevel) {
+	logger.Logger.SetLogLevel(level)
+}
+
+// GetLogLevel returns the logging level of the wrapped logger.
+func (logger *RedactingLogger) GetLogLevel() core.LogLevel {
+	return logger.Logger.GetLogLevel()
+}
+
+// IsLogLevelEnabled returns true if "level" is enabled in the wrapped logger.
+func (logger *RedactingLogger) IsLogLevelEnabled(level core.LogLevel) bool {
+	return logger.Logger.IsLogLevelEnabled(level)
+}
+
+var installRedactingLoggerMutex sync.Mutex
+
+// InstallRedactingLogger wraps the current go-sdk-core logger in a RedactingLogger, unless it already is one. Call it
+// again after replacing the logger with core.SetLogger, or call VmwareV1.EnableLogRedaction.
+func InstallRedactingLogger() {
+	installRedactingLoggerMutex.Lock()
+	defer installRedactingLoggerMutex.Unlock()
+	if _, ok := core.GetLogger().(*RedactingLogger); !ok {
+		core.SetLogger(NewRedactingLogger(core.GetLogger()))
+	}
+}
+
+// redactingAuthenticator : A core.Authenticator that installs the RedactingLogger before authenticating a request.
+// go-sdk-core logs a request right after authenticating it, and its response once it is received.
+type redactingAuthenticator struct {
+	core.Authenticator
+}
+
+// Authenticate installs the RedactingLogger, then authenticates "request" with the wrapped authenticator.
+func (authenticator *redactingAuthenticator) Authenticate(request *http.Request) error {
+	InstallRedactingLogger()
+	return authenticator.Authenticator.Authenticate(request)
+}
+
+// EnableLogRedaction wraps the go-sdk-core logger in a RedactingLogger, and makes every request of the service wrap it
+// again if it was replaced with core.SetLogger since. The authenticator of the service is wrapped to do so.
+func (vmware *VmwareV1) EnableLogRedaction() {
+	InstallRedactingLogger()
+	authenticator := vmware.Service.Options.Authenticator
+	if _, ok := authenticator.(*redactingAuthenticator); !ok {
+		vmware.Service.Options.Authenticator = &redactingAuthenticator{Authenticator: authenticator}
+	}
+}
+
+// redactModel masks the sensitive fields of the model "model" points to, unless redaction is disabled.
+func redactModel(model interface{}) {
+	if !IsRedactionEnabled() {
+		return
+	}
+	value := reflect.ValueOf(model).Elem()
+	for _, name := range sensitiveModelFields[value.Type()] {
+		field := value.FieldByName(name)
+		if !field.IsNil() {
+			field.Set(reflect.ValueOf(core.StringPtr(redactedValue)))
+		}
+	}
+}
+
+// redactedString returns the JSON form of "model", or "fallback" if it cannot be marshalled.
+func redactedString(model interface{}, fallback string) string {
+	buf, err := json.Marshal(model)
+	if err != nil {
+		return fallback
+	}
+	return string(buf)
+}
+
+// usageMeterRegistrationLog has the fields of UsageMeterRegistration but none of its methods, so that it can be
+// marshalled without recursing into them.
+type usageMeterRegistrationLog UsageMeterRegistration
+
+// MarshalLog returns a copy of the registration with its access token redacted, suited to structured loggers.
+func (usageMeterRegistration UsageMeterRegistration) MarshalLog() interface{} {
+	redactModel(&usageMeterRegistration)
+	return usageMeterRegistrationLog(usageMeterRegistration)
+}
+
+// String returns the registration as JSON with its access token redacted.
+func (usageMeterRegistration UsageMeterRegistration) String() string {
+	return redactedString(usageMeterRegistration.MarshalLog(), "UsageMeterRegistration{}")
+}
+
+// GoString returns the registration as JSON with its access token redacted.
+func (usageMeterRegistration UsageMeterRegistration) GoString() string {
+	return "vmwarev1.UsageMeterRegistration" + usageMeterRegistration.String()
+}
+
+// licenseKeyLog has the fields of LicenseKey but none of its methods.
+type licenseKeyLog LicenseKey
+
+// MarshalLog returns a copy of the license key with its value redacted, suited to structured loggers.
+func (licenseKey LicenseKey) MarshalLog() interface{} {
+	redactModel(&licenseKey)
+	return licenseKeyLog(licenseKey)
+}
+
+// String returns the license key as JSON with its value redacted.
+func (licenseKey LicenseKey) String() string {
+	return redactedString(licenseKey.MarshalLog(), "LicenseKey{}")
+}
+
+// GoString returns the license key as JSON with its value redacted.
+func (licenseKey LicenseKey) GoString() string {
+	return "vmwarev1.LicenseKey" + licenseKey.String()
+}
+
+// directorSiteLog has the fields of DirectorSite but none of its methods.
+type directorSiteLog DirectorSite
+
+// MarshalLog returns a copy of the Cloud Director site with its RHEL VM activation key redacted, suited to
+// structured loggers.
+func (directorSite DirectorSite) MarshalLog() interface{} {
+	redactModel(&directorSite)
+	return directorSiteLog(directorSite)
+}
+
+// String returns the Cloud Director site as JSON with its RHEL VM activation key redacted.
+func (directorSite DirectorSite) String() string {
+	return redactedString(directorSite.MarshalLog(), "DirectorSite{}")
+}
+
+// GoString returns the Cloud Director site as JSON with its RHEL VM activation key redacted.
+func (directorSite DirectorSite) GoString() string {
+	return "vmwarev1.DirectorSite" + directorSite.String()
+}
@@ -0,0 +1,138 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vmwarev1_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vmware-go-sdk/vmwarev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Redaction`, func() {
+	AfterEach(func() {
+		vmwarev1.SetRedactionEnabled(true)
+	})
+
+	It(`Masks the secrets of models when they are formatted`, func() {
+		licenseKey := vmwarev1.LicenseKey{Name: core.StringPtr("NSX"), Value: core.StringPtr("license-secret")}
+		directorSite := &vmwarev1.DirectorSite{ID: core.StringPtr("site1"), RhelVmActivationKey: core.StringPtr("rhel-secret")}
+		collection := vmwarev1.LicenseCollection{Licenses: []vmwarev1.License{{Version: core.StringPtr("4.1"), LicenseKeys: []vmwarev1.LicenseKey{licenseKey}}}}
+
+		for _, format := range []string{"%v", "%+v", "%#v", "%s"} {
+			Expect(fmt.Sprintf(format, licenseKey)).ToNot(ContainSubstring("license-secret"))
+			Expect(fmt.Sprintf(format, directorSite)).ToNot(ContainSubstring("rhel-secret"))
+			Expect(fmt.Sprintf(format, collection)).ToNot(ContainSubstring("license-secret"))
+		}
+		Expect(licenseKey.String()).To(Equal(`{"value":"[redacted]","name":"NSX"}`))
+		Expect(licenseKey.GoString()).To(HavePrefix("vmwarev1.LicenseKey{"))
+		Expect(directorSite.String()).To(ContainSubstring(`"rhel_vm_activation_key":"[redacted]"`))
+		other := &vmwarev1.License{Version: core.StringPtr("4.1"), LicenseKeys: []vmwarev1.LicenseKey{{Name: core.StringPtr("NSX"), Value: core.StringPtr("other-secret")}}}
+		Expect(collection.Licenses[0].Diff(other)[0].String()).To(Equal("license_keys[0].value: [redacted] -> [redacted]"))
+
+		// JSON still carries the values, so that results round-trip.
+		buf, err := json.Marshal(licenseKey)
+		Expect(err).To(BeNil())
+		Expect(string(buf)).To(ContainSubstring("license-secret"))
+		Expect(*licenseKey.Value).To(Equal("license-secret"))
+	})
+	It(`Shows the secrets when redaction is disabled`, func() {
+		vmwarev1.SetRedactionEnabled(false)
+		Expect(vmwarev1.IsRedactionEnabled()).To(BeFalse())
+		licenseKey := vmwarev1.LicenseKey{Name: core.StringPtr("NSX"), Value: core.StringPtr("license-secret")}
+		Expect(licenseKey.String()).To(ContainSubstring("license-secret"))
+		Expect(vmwarev1.RedactSensitiveData(`{"access_token": "token-secret"}`)).To(ContainSubstring("token-secret"))
+	})
+	It(`Masks the sensitive fields of request and response bodies`, func() {
+		redacted := vmwarev1.RedactSensitiveData(`{"access_token": "token-secret", "rhel_vm_activation_key":"rhel-\"secret", ` +
+			`"licenses": [{"license_keys": [{"value": "license-secret", "name": "NSX"}], "version": "4.1"}], "value": "kept"}`)
+		Expect(redacted).ToNot(ContainSubstring("secret"))
+		Expect(redacted).To(ContainSubstring(`"access_token": "[redacted]"`))
+		Expect(redacted).To(ContainSubstring(`"value": "[redacted]", "name": "NSX"`))
+		Expect(redacted).To(ContainSubstring(`"value": "kept"`))
+
+		vmwarev1.AddSensitiveJSONField("custom_secret")
+		Expect(vmwarev1.RedactSensitiveData(`{"custom_secret":"abc"}`)).To(Equal(`{"custom_secret":"[redacted]"}`))
+	})
+	It(`Sanitizes the debug log of the SDK`, func() {
+		var output bytes.Buffer
+		previousLogger := core.GetLogger()
+		defer core.SetLogger(previousLogger)
+		core.SetLogger(core.NewLogger(core.LevelDebug, log.New(&output, "", 0), log.New(&output, "", 0)))
+		vmwarev1.InstallRedactingLogger()
+		vmwarev1.InstallRedactingLogger()
+		Expect(core.GetLogger().(*vmwarev1.RedactingLogger).Logger).To(BeAssignableToTypeOf(&core.SDKLoggerImpl{}))
+
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprint(res, `{"licenses": [{"license_keys": [{"value": "license-secret", "name": "NSX"}], "version": "4.1"}]}`)
+		}))
+		defer testServer.Close()
+
+		vmwareService, serviceErr := vmwarev1.NewVmwareV1(&vmwarev1.VmwareV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		_, _, err := vmwareService.ListLicenses(vmwareService.NewListLicensesOptions())
+		Expect(err).To(BeNil())
+		Expect(output.String()).To(ContainSubstring("[Debug] Response:"))
+		Expect(output.String()).To(ContainSubstring(`"value": "[redacted]"`))
+		Expect(output.String()).ToNot(ContainSubstring("license-secret"))
+	})
+	It(`Sanitizes the debug log of a service after the logger is replaced`, func() {
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprint(res, `{"licenses": [{"license_keys": [{"value": "license-secret", "name": "NSX"}], "version": "4.1"}]}`)
+		}))
+		defer testServer.Close()
+		vmwareService, serviceErr := vmwarev1.NewVmwareV1(&vmwarev1.VmwareV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+
+		// The package leaves the logger of the process alone until redaction is enabled.
+		var output bytes.Buffer
+		previousLogger := core.GetLogger()
+		defer core.SetLogger(previousLogger)
+		core.SetLogger(core.NewLogger(core.LevelDebug, log.New(&output, "", 0), log.New(&output, "", 0)))
+		Expect(core.GetLogger()).To(BeAssignableToTypeOf(&core.SDKLoggerImpl{}))
+
+		vmwareService.EnableLogRedaction()
+		vmwareService.EnableLogRedaction()
+		Expect(vmwareService.Service.Options.Authenticator.AuthenticationType()).To(Equal(core.AUTHTYPE_NOAUTH))
+		Expect(core.GetLogger()).To(BeAssignableToTypeOf(&vmwarev1.RedactingLogger{}))
+
+		core.SetLogger(core.NewLogger(core.LevelDebug, log.New(&output, "", 0), log.New(&output, "", 0)))
+		_, _, err := vmwareService.ListLicenses(vmwareService.NewListLicensesOptions())
+		Expect(err).To(BeNil())
+		Expect(core.GetLogger()).To(BeAssignableToTypeOf(&vmwarev1.RedactingLogger{}))
+		Expect(output.String()).To(ContainSubstring("[Debug] Response:"))
+		Expect(output.String()).To(ContainSubstring(`"value": "[redacted]"`))
+		Expect(output.String()).ToNot(ContainSubstring("license-secret"))
+	})
+})
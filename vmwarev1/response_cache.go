@@ -0,0 +1,363 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vmwarev1
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vmware-go-sdk/common"
+)
+
+// The headers that report how a response was served by a ResponseCache. They are visible in the Headers of the
+// core.DetailedResponse returned by the operations.
+const (
+	// HIT, MISS or SHARED (the response of a concurrent identical call that was in flight).
+	ResponseCacheHeader = "X-Vmware-Sdk-Cache"
+
+	// The age of a cached response, in seconds.
+	ResponseCacheAgeHeader = "X-Vmware-Sdk-Cache-Age"
+)
+
+// Constants associated with the ResponseCacheHeader header.
+const (
+	ResponseCache_Status_Hit    = "HIT"
+	ResponseCache_Status_Miss   = "MISS"
+	ResponseCache_Status_Shared = "SHARED"
+)
+
+// DefaultResponseCacheTTLs returns the TTLs used when ResponseCacheOptions.TTLs is nil. They cover the catalog and
+// license operations, whose results rarely change.
+func DefaultResponseCacheTTLs() map[string]time.Duration {
+	return map[string]time.Duration{
+		"ListDirectorSiteRegions":      24 * time.Hour,
+		"ListDirectorSiteHostProfiles": 24 * time.Hour,
+		"ListMultitenantDirectorSites": time.Hour,
+		"ListLicenses":                 time.Hour,
+	}
+}
+
+// ResponseCacheOptions : The options of a ResponseCache.
+type ResponseCacheOptions struct {
+	// How long the responses of each operation are cached, by operation name (such as "ListDirectorSiteRegions").
+	// Operations that are not listed are never cached. If nil, DefaultResponseCacheTTLs is used.
+	TTLs map[string]time.Duration
+
+	// An optional directory where responses are also stored, so that they survive the process; useful for CLIs. It
+	// is created with 0700 permissions and its files with 0600 permissions, as they may hold license keys. Use one
+	// directory per account: the cache keys do not include the credentials.
+	Directory string
+}
+
+// ResponseCacheCounts : The number of requests of an operation that a ResponseCache served.
+type ResponseCacheCounts struct {
+	// Requests served from the cache.
+	Hits int64 `json:"hits"`
+
+	// Requests sent to the API.
+	Misses int64 `json:"misses"`
+
+	// Requests that waited for an identical request in flight and shared its response.
+	Shared int64 `json:"shared"`
+}
+
+// ResponseCache : Caches the successful responses of slowly changing operations. Concurrent identical requests are
+// de-duplicated, so that only one of them reaches the API. Install it with VmwareV1.EnableResponseCache or
+// VmwareV1.Use(cache.Middleware()).
+type ResponseCache struct {
+	ttls      map[string]time.Duration
+	directory string
+
+	mutex    sync.Mutex
+	entries  map[string]*cachedResponse
+	inFlight map[string]*cacheCall
+	counts   map[string]*ResponseCacheCounts
+
+	now func() time.Time
+}
+
+// cachedResponse is a response stored in the cache. It is also the format of the files of the cache directory.
+type cachedResponse struct {
+	Key        string      `json:"key"`
+	Operation  string      `json:"operation"`
+	StoredAt   time.Time   `json:"stored_at"`
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	Body       []byte      `json:"body"`
+}
+
+// cacheCall is a request in flight that identical requests wait for.
+type cacheCall struct {
+	done     chan struct{}
+	response *cachedResponse
+	err      error
+}
+
+// NewResponseCache : Instantiate ResponseCache
+func NewResponseCache(options *ResponseCacheOptions) *ResponseCache {
+	if options == nil {
+		options = &ResponseCacheOptions{}
+	}
+	ttls := options.TTLs
+	if ttls == nil {
+		ttls = DefaultResponseCacheTTLs()
+	}
+	return &ResponseCache{
+		ttls:      ttls,
+		directory: options.Directory,
+		entries:   map[string]*cachedResponse{},
+		inFlight:  map[string]*cacheCall{},
+		counts:    map[string]*ResponseCacheCounts{},
+		now:       time.Now,
+	}
+}
+
+// EnableResponseCache installs a ResponseCache on the service and returns it, so that its entries can be invalidated
+// and its counts read.
+func (vmware *VmwareV1) EnableResponseCache(options *ResponseCacheOptions) *ResponseCache {
+	cache := NewResponseCache(options)
+	vmware.Use(cache.Middleware())
+	return cache
+}
+
+// Middleware returns the middleware that serves the requests of the cached operations from the cache.
+func (cache *ResponseCache) Middleware() Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			operation, ok := OperationForRequest(req)
+			if !ok || req.Method != http.MethodGet {
+				return next.RoundTrip(req)
+			}
+			ttl, cached := cache.ttls[operation.Name]
+			if !cached || ttl <= 0 {
+				return next.RoundTrip(req)
+			}
+			return cache.roundTrip(req, operation.Name, ttl, next)
+		})
+	}
+}
+
+func (cache *ResponseCache) roundTrip(req *http.Request, operation string, ttl time.Duration, next http.RoundTripper) (*http.Response, error) {
+	key := cacheKey(req)
+
+	cache.mutex.Lock()
+	counts := cache.countsOf(operation)
+	if entry := cache.lookup(key, operation, ttl); entry != nil {
+		counts.Hits++
+		cache.mutex.Unlock()
+		return cache.response(req, entry, ResponseCache_Status_Hit), nil
+	}
+	if call, ok := cache.inFlight[key]; ok {
+		counts.Shared++
+		cache.mutex.Unlock()
+		select {
+		case <-call.done:
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+		if call.err != nil {
+			return nil, call.err
+		}
+		if call.response == nil {
+			// The response was not cacheable, so send the request ourselves.
+			return next.RoundTrip(req)
+		}
+		return cache.response(req, call.response, ResponseCache_Status_Shared), nil
+	}
+	call := &cacheCall{done: make(chan struct{})}
+	cache.inFlight[key] = call
+	counts.Misses++
+	cache.mutex.Unlock()
+
+	res, err := next.RoundTrip(req)
+	if err == nil && res.StatusCode == http.StatusOK {
+		var body []byte
+		body, err = io.ReadAll(res.Body)
+		res.Body.Close()
+		if err == nil {
+			call.response = &cachedResponse{
+				Key:        key,
+				Operation:  operation,
+				StoredAt:   cache.now(),
+				StatusCode: res.StatusCode,
+				Header:     res.Header.Clone(),
+				Body:       body,
+			}
+		}
+	}
+	call.err = err
+
+	cache.mutex.Lock()
+	delete(cache.inFlight, key)
+	if call.response != nil {
+		cache.entries[key] = call.response
+	}
+	cache.mutex.Unlock()
+	close(call.done)
+
+	if call.response != nil {
+		cache.store(call.response)
+		return cache.response(req, call.response, ResponseCache_Status_Miss), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	res.Header.Set(ResponseCacheHeader, ResponseCache_Status_Miss)
+	return res, nil
+}
+
+// lookup returns the fresh entry of "key" from memory or from the cache directory. The caller must hold the mutex.
+func (cache *ResponseCache) lookup(key string, operation string, ttl time.Duration) *cachedResponse {
+	entry := cache.entries[key]
+	if entry == nil && cache.directory != "" {
+		entry = cache.load(key, operation)
+		if entry != nil {
+			cache.entries[key] = entry
+		}
+	}
+	if entry == nil || cache.now().Sub(entry.StoredAt) >= ttl {
+		return nil
+	}
+	return entry
+}
+
+// response builds the response of "req" from "entry".
+func (cache *ResponseCache) response(req *http.Request, entry *cachedResponse, status string) *http.Response {
+	header := entry.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	header.Set(ResponseCacheHeader, status)
+	header.Set(ResponseCacheAgeHeader, strconv.Itoa(int(cache.now().Sub(entry.StoredAt).Seconds())))
+	return syntheticResponse(req, entry.StatusCode, header, entry.Body)
+}
+
+func (cache *ResponseCache) countsOf(operation string) *ResponseCacheCounts {
+	counts := cache.counts[operation]
+	if counts == nil {
+		counts = &ResponseCacheCounts{}
+		cache.counts[operation] = counts
+	}
+	return counts
+}
+
+// Counts returns the number of hits, misses and shared responses of each cached operation.
+func (cache *ResponseCache) Counts() map[string]ResponseCacheCounts {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	counts := map[string]ResponseCacheCounts{}
+	for operation, c := range cache.counts {
+		counts[operation] = *c
+	}
+	return counts
+}
+
+// Invalidate removes the cached responses of "operations", or of every operation when none is given, from memory and
+// from the cache directory.
+func (cache *ResponseCache) Invalidate(operations ...string) error {
+	matches := func(operation string) bool {
+		if len(operations) == 0 {
+			return true
+		}
+		for _, o := range operations {
+			if o == operation {
+				return true
+			}
+		}
+		return false
+	}
+
+	cache.mutex.Lock()
+	for key, entry := range cache.entries {
+		if matches(entry.Operation) {
+			delete(cache.entries, key)
+		}
+	}
+	cache.mutex.Unlock()
+
+	if cache.directory == "" {
+		return nil
+	}
+	files, err := os.ReadDir(cache.directory)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return core.SDKErrorf(err, "", "cache-invalidate-error", common.GetComponentInfo())
+	}
+	for _, file := range files {
+		name := file.Name()
+		separator := strings.LastIndex(name, "-")
+		if separator < 0 || !strings.HasSuffix(name, ".json") || !matches(name[:separator]) {
+			continue
+		}
+		err = os.Remove(filepath.Join(cache.directory, name))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return core.SDKErrorf(err, "", "cache-invalidate-error", common.GetComponentInfo())
+		}
+	}
+	return nil
+}
+
+// store writes "entry" to the cache directory. Failures are ignored: the directory is only an optimization.
+func (cache *ResponseCache) store(entry *cachedResponse) {
+	if cache.directory == "" {
+		return
+	}
+	content, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	if os.MkdirAll(cache.directory, 0700) != nil {
+		return
+	}
+	_ = writeSecretFile(cache.path(entry.Key, entry.Operation), content)
+}
+
+// load reads the entry of "key" from the cache directory, or returns nil.
+func (cache *ResponseCache) load(key string, operation string) *cachedResponse {
+	content, err := os.ReadFile(cache.path(key, operation))
+	if err != nil {
+		return nil
+	}
+	entry := &cachedResponse{}
+	if json.Unmarshal(content, entry) != nil || entry.Key != key {
+		return nil
+	}
+	return entry
+}
+
+func (cache *ResponseCache) path(key string, operation string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(cache.directory, operation+"-"+hex.EncodeToString(sum[:16])+".json")
+}
+
+// cacheKey identifies the requests that get the same response: same URL and same language.
+func cacheKey(req *http.Request) string {
+	return req.Method + " " + req.URL.String() + " " + req.Header.Get("Accept-Language")
+}
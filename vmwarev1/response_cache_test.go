@@ -0,0 +1,189 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package vmwarev1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vmware-go-sdk/vmwarev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResponseCache`, func() {
+	var (
+		testServer  *httptest.Server
+		regionCalls int32
+		vdcCalls    int32
+		release     chan struct{}
+		newService  func() *vmwarev1.VmwareV1
+	)
+
+	BeforeEach(func() {
+		atomic.StoreInt32(&regionCalls, 0)
+		atomic.StoreInt32(&vdcCalls, 0)
+		release = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			res.Header().Set("Content-type", "application/json")
+			switch req.URL.EscapedPath() {
+			case "/director_site_regions":
+				calls := atomic.AddInt32(&regionCalls, 1)
+				if release != nil {
+					<-release
+				}
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"director_site_regions": [{"name": "region%d"}]}`, calls)
+			case "/vdcs":
+				atomic.AddInt32(&vdcCalls, 1)
+				res.WriteHeader(200)
+				fmt.Fprint(res, `{"vdcs": []}`)
+			default:
+				res.WriteHeader(404)
+			}
+		}))
+		newService = func() *vmwarev1.VmwareV1 {
+			vmwareService, serviceErr := vmwarev1.NewVmwareV1(&vmwarev1.VmwareV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+			return vmwareService
+		}
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	listRegions := func(vmwareService *vmwarev1.VmwareV1) (string, *core.DetailedResponse) {
+		result, response, err := vmwareService.ListDirectorSiteRegions(vmwareService.NewListDirectorSiteRegionsOptions())
+		Expect(err).To(BeNil())
+		Expect(result.DirectorSiteRegions).To(HaveLen(1))
+		return *result.DirectorSiteRegions[0].Name, response
+	}
+
+	It(`Serves the cached operations from the cache`, func() {
+		vmwareService := newService()
+		cache := vmwareService.EnableResponseCache(nil)
+
+		name, response := listRegions(vmwareService)
+		Expect(name).To(Equal("region1"))
+		Expect(response.Headers.Get(vmwarev1.ResponseCacheHeader)).To(Equal(vmwarev1.ResponseCache_Status_Miss))
+
+		name, response = listRegions(vmwareService)
+		Expect(name).To(Equal("region1"))
+		Expect(response.Headers.Get(vmwarev1.ResponseCacheHeader)).To(Equal(vmwarev1.ResponseCache_Status_Hit))
+		Expect(response.Headers.Get(vmwarev1.ResponseCacheAgeHeader)).To(Equal("0"))
+		Expect(atomic.LoadInt32(&regionCalls)).To(Equal(int32(1)))
+
+		// Operations without a TTL are not cached.
+		for i := 0; i < 2; i++ {
+			_, response, err := vmwareService.ListVdcs(vmwareService.NewListVdcsOptions())
+			Expect(err).To(BeNil())
+			Expect(response.Headers.Get(vmwarev1.ResponseCacheHeader)).To(BeEmpty())
+		}
+		Expect(atomic.LoadInt32(&vdcCalls)).To(Equal(int32(2)))
+
+		Expect(cache.Invalidate("ListDirectorSiteRegions")).To(Succeed())
+		name, _ = listRegions(vmwareService)
+		Expect(name).To(Equal("region2"))
+		Expect(cache.Counts()).To(Equal(map[string]vmwarev1.ResponseCacheCounts{
+			"ListDirectorSiteRegions": {Hits: 1, Misses: 2},
+		}))
+	})
+	It(`Expires the responses after their TTL`, func() {
+		vmwareService := newService()
+		vmwareService.EnableResponseCache(&vmwarev1.ResponseCacheOptions{
+			TTLs: map[string]time.Duration{"ListDirectorSiteRegions": 50 * time.Millisecond},
+		})
+
+		name, _ := listRegions(vmwareService)
+		Expect(name).To(Equal("region1"))
+		time.Sleep(60 * time.Millisecond)
+		name, response := listRegions(vmwareService)
+		Expect(name).To(Equal("region2"))
+		Expect(response.Headers.Get(vmwarev1.ResponseCacheHeader)).To(Equal(vmwarev1.ResponseCache_Status_Miss))
+	})
+	It(`Sends concurrent identical requests once`, func() {
+		release = make(chan struct{})
+		vmwareService := newService()
+		cache := vmwareService.EnableResponseCache(nil)
+
+		var wg sync.WaitGroup
+		names := make([]string, 5)
+		for i := range names {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				defer GinkgoRecover()
+				names[i], _ = listRegions(vmwareService)
+			}(i)
+		}
+		Eventually(func() int64 {
+			counts := cache.Counts()["ListDirectorSiteRegions"]
+			return counts.Misses + counts.Shared
+		}).Should(Equal(int64(5)))
+		close(release)
+		wg.Wait()
+
+		Expect(atomic.LoadInt32(&regionCalls)).To(Equal(int32(1)))
+		Expect(names).To(Equal([]string{"region1", "region1", "region1", "region1", "region1"}))
+		Expect(cache.Counts()["ListDirectorSiteRegions"]).To(Equal(vmwarev1.ResponseCacheCounts{Misses: 1, Shared: 4}))
+	})
+	It(`Keeps the responses in the cache directory`, func() {
+		parent, err := os.MkdirTemp("", "response-cache")
+		Expect(err).To(BeNil())
+		defer os.RemoveAll(parent)
+		directory := filepath.Join(parent, "cache")
+		options := &vmwarev1.ResponseCacheOptions{Directory: directory}
+
+		listRegions(newService())
+		first := newService()
+		first.EnableResponseCache(options)
+		name, _ := listRegions(first)
+		Expect(name).To(Equal("region2"))
+
+		info, err := os.Stat(directory)
+		Expect(err).To(BeNil())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0700)))
+		files, err := filepath.Glob(filepath.Join(directory, "ListDirectorSiteRegions-*.json"))
+		Expect(err).To(BeNil())
+		Expect(files).To(HaveLen(1))
+		info, err = os.Stat(files[0])
+		Expect(err).To(BeNil())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+
+		second := newService()
+		cache := second.EnableResponseCache(options)
+		name, response := listRegions(second)
+		Expect(name).To(Equal("region2"))
+		Expect(response.Headers.Get(vmwarev1.ResponseCacheHeader)).To(Equal(vmwarev1.ResponseCache_Status_Hit))
+
+		Expect(cache.Invalidate()).To(Succeed())
+		files, _ = filepath.Glob(filepath.Join(directory, "*.json"))
+		Expect(files).To(BeEmpty())
+		name, _ = listRegions(second)
+		Expect(name).To(Equal("region3"))
+	})
+})
@@ -0,0 +1,442 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vmwarev1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vmware-go-sdk/common"
+)
+
+// ReconcileTransitGatewayConnections : Reconcile the IBM Transit Gateway connections of virtual data center edges
+// Compare the desired IBM Transit Gateways of each listed edge with the gateways that are connected to it, then add
+// the missing gateways and remove the unwanted ones. Gateways with a detached connection are removed and added again.
+// The call returns after every connection that it touched has settled.
+func (vmware *VmwareV1) ReconcileTransitGatewayConnections(reconcileTransitGatewayConnectionsOptions *ReconcileTransitGatewayConnectionsOptions) (result *TransitGatewayReconcileResult, err error) {
+	result, err = vmware.ReconcileTransitGatewayConnectionsWithContext(context.Background(), reconcileTransitGatewayConnectionsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ReconcileTransitGatewayConnectionsWithContext is an alternate form of the ReconcileTransitGatewayConnections method which supports a Context parameter
+func (vmware *VmwareV1) ReconcileTransitGatewayConnectionsWithContext(ctx context.Context, reconcileTransitGatewayConnectionsOptions *ReconcileTransitGatewayConnectionsOptions) (result *TransitGatewayReconcileResult, err error) {
+	err = core.ValidateNotNil(reconcileTransitGatewayConnectionsOptions, "reconcileTransitGatewayConnectionsOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(reconcileTransitGatewayConnectionsOptions, "reconcileTransitGatewayConnectionsOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	options := reconcileTransitGatewayConnectionsOptions
+	vdcID := *options.VdcID
+
+	vdc, err := vmware.getVdcForReconcile(ctx, options)
+	if err != nil {
+		return
+	}
+
+	edgeIDs := make([]string, 0, len(options.Edges))
+	for edgeID := range options.Edges {
+		edgeIDs = append(edgeIDs, edgeID)
+	}
+	sort.Strings(edgeIDs)
+
+	result = &TransitGatewayReconcileResult{
+		VdcID: vdcID,
+	}
+	for _, edgeID := range edgeIDs {
+		edge := findEdge(vdc, edgeID)
+		if edge == nil {
+			err = core.SDKErrorf(nil, fmt.Sprintf("edge '%s' was not found on virtual data center '%s'", edgeID, vdcID), "edge-not-found", common.GetComponentInfo())
+			return
+		}
+
+		actions := planTransitGatewayActions(edge, options.Edges[edgeID])
+		err = vmware.applyTransitGatewayActions(ctx, options, edgeID, actions)
+		result.Actions = append(result.Actions, actions...)
+		if err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// planTransitGatewayActions diffs the gateways connected to "edge" against the desired gateways.
+func planTransitGatewayActions(edge *Edge, desired []TransitGatewayTarget) (actions []TransitGatewayAction) {
+	current := make(map[string]*TransitGateway, len(edge.TransitGateways))
+	for i := range edge.TransitGateways {
+		transitGateway := &edge.TransitGateways[i]
+		if transitGateway.ID != nil {
+			current[*transitGateway.ID] = transitGateway
+		}
+	}
+
+	wanted := make(map[string]bool, len(desired))
+	for _, target := range desired {
+		id := *target.ID
+		if wanted[id] {
+			continue
+		}
+		wanted[id] = true
+
+		region := ""
+		if target.Region != nil {
+			region = *target.Region
+		}
+
+		transitGateway, connected := current[id]
+		switch {
+		case !connected:
+			actions = append(actions, TransitGatewayAction{
+				EdgeID:           *edge.ID,
+				TransitGatewayID: id,
+				Region:           region,
+				Type:             TransitGatewayAction_Type_Add,
+			})
+		case hasDetachedConnection(transitGateway):
+			if region == "" && transitGateway.Region != nil {
+				region = *transitGateway.Region
+			}
+			actions = append(actions, TransitGatewayAction{
+				EdgeID:           *edge.ID,
+				TransitGatewayID: id,
+				Region:           region,
+				Type:             TransitGatewayAction_Type_Reattach,
+			})
+		}
+	}
+
+	removals := []TransitGatewayAction{}
+	for id, transitGateway := range current {
+		if wanted[id] {
+			continue
+		}
+		if transitGateway.Status != nil && *transitGateway.Status == TransitGateway_Status_Deleting {
+			continue
+		}
+		region := ""
+		if transitGateway.Region != nil {
+			region = *transitGateway.Region
+		}
+		removals = append(removals, TransitGatewayAction{
+			EdgeID:           *edge.ID,
+			TransitGatewayID: id,
+			Region:           region,
+			Type:             TransitGatewayAction_Type_Remove,
+		})
+	}
+	sort.Slice(removals, func(i, j int) bool {
+		return removals[i].TransitGatewayID < removals[j].TransitGatewayID
+	})
+
+	return append(actions, removals...)
+}
+
+// applyTransitGatewayActions removes the gateways that must go away (including the ones to re-attach), waits for
+// them to disappear, then adds the missing gateways and waits for their connections to settle. The final status of
+// each gateway is recorded in "actions".
+func (vmware *VmwareV1) applyTransitGatewayActions(ctx context.Context, options *ReconcileTransitGatewayConnectionsOptions, edgeID string, actions []TransitGatewayAction) (err error) {
+	removed := map[string]bool{}
+	for _, action := range actions {
+		if action.Type == TransitGatewayAction_Type_Add {
+			continue
+		}
+		removeOptions := vmware.NewRemoveTransitGatewayConnectionsOptions(*options.VdcID, edgeID, action.TransitGatewayID)
+		removeOptions.AcceptLanguage = options.AcceptLanguage
+		removeOptions.Headers = options.Headers
+		_, _, err = vmware.RemoveTransitGatewayConnectionsWithContext(ctx, removeOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "remove-transit-gateway-error")
+			return
+		}
+		removed[action.TransitGatewayID] = true
+	}
+
+	if len(removed) > 0 {
+		err = waitUntil(ctx, options.WaitOptions, fmt.Sprintf("transit gateways to be removed from edge '%s'", edgeID), func(ctx context.Context) (bool, error) {
+			vdc, err := vmware.getVdcForReconcile(ctx, options)
+			if err != nil {
+				return false, err
+			}
+			edge := findEdge(vdc, edgeID)
+			if edge == nil {
+				return true, nil
+			}
+			for _, transitGateway := range edge.TransitGateways {
+				if transitGateway.ID != nil && removed[*transitGateway.ID] {
+					return false, nil
+				}
+			}
+			return true, nil
+		})
+		if err != nil {
+			return
+		}
+	}
+
+	added := map[string]bool{}
+	for _, action := range actions {
+		if action.Type == TransitGatewayAction_Type_Remove {
+			continue
+		}
+		addOptions := vmware.NewAddTransitGatewayConnectionsOptions(*options.VdcID, edgeID, action.TransitGatewayID)
+		if action.Region != "" {
+			addOptions.SetRegion(action.Region)
+		}
+		addOptions.AcceptLanguage = options.AcceptLanguage
+		addOptions.Headers = options.Headers
+		_, _, err = vmware.AddTransitGatewayConnectionsWithContext(ctx, addOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "add-transit-gateway-error")
+			return
+		}
+		added[action.TransitGatewayID] = true
+	}
+
+	var settled map[string]*TransitGateway
+	if len(added) > 0 {
+		err = waitUntil(ctx, options.WaitOptions, fmt.Sprintf("transit gateway connections to settle on edge '%s'", edgeID), func(ctx context.Context) (bool, error) {
+			vdc, err := vmware.getVdcForReconcile(ctx, options)
+			if err != nil {
+				return false, err
+			}
+			edge := findEdge(vdc, edgeID)
+			if edge == nil {
+				return false, core.SDKErrorf(nil, fmt.Sprintf("edge '%s' disappeared from virtual data center '%s'", edgeID, *options.VdcID), "edge-not-found", common.GetComponentInfo())
+			}
+			settled = map[string]*TransitGateway{}
+			for i := range edge.TransitGateways {
+				transitGateway := &edge.TransitGateways[i]
+				if transitGateway.ID == nil || !added[*transitGateway.ID] {
+					continue
+				}
+				if !isTransitGatewaySettled(transitGateway) {
+					return false, nil
+				}
+				settled[*transitGateway.ID] = transitGateway
+			}
+			return len(settled) == len(added), nil
+		})
+		if err != nil {
+			return
+		}
+	}
+
+	for i := range actions {
+		action := &actions[i]
+		if action.Type == TransitGatewayAction_Type_Remove {
+			action.Status = TransitGatewayAction_Status_Removed
+			continue
+		}
+		transitGateway := settled[action.TransitGatewayID]
+		if transitGateway != nil && transitGateway.Status != nil {
+			action.Status = *transitGateway.Status
+		}
+		if transitGateway != nil && hasDetachedConnection(transitGateway) {
+			action.Status = TransitGatewayConnection_Status_Detached
+		}
+	}
+	return
+}
+
+func (vmware *VmwareV1) getVdcForReconcile(ctx context.Context, options *ReconcileTransitGatewayConnectionsOptions) (vdc *VDC, err error) {
+	getVdcOptions := vmware.NewGetVdcOptions(*options.VdcID)
+	getVdcOptions.AcceptLanguage = options.AcceptLanguage
+	getVdcOptions.Headers = options.Headers
+	vdc, _, err = vmware.GetVdcWithContext(ctx, getVdcOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-vdc-error")
+	}
+	return
+}
+
+func findEdge(vdc *VDC, edgeID string) *Edge {
+	for i := range vdc.Edges {
+		if vdc.Edges[i].ID != nil && *vdc.Edges[i].ID == edgeID {
+			return &vdc.Edges[i]
+		}
+	}
+	return nil
+}
+
+func hasDetachedConnection(transitGateway *TransitGateway) bool {
+	for _, connection := range transitGateway.Connections {
+		if connection.Status != nil && *connection.Status == TransitGatewayConnection_Status_Detached {
+			return true
+		}
+	}
+	return false
+}
+
+// isTransitGatewaySettled reports whether a gateway and all of its connections are out of the creating and deleting
+// states. A pending connection is settled: it is waiting for approval on the IBM Transit Gateway side.
+func isTransitGatewaySettled(transitGateway *TransitGateway) bool {
+	if transitGateway.Status != nil {
+		switch *transitGateway.Status {
+		case TransitGateway_Status_Creating, TransitGateway_Status_Deleting:
+			return false
+		}
+	}
+	for _, connection := range transitGateway.Connections {
+		if connection.Status == nil {
+			continue
+		}
+		switch *connection.Status {
+		case TransitGatewayConnection_Status_Creating, TransitGatewayConnection_Status_Deleting:
+			return false
+		}
+	}
+	return true
+}
+
+// ReconcileTransitGatewayConnectionsOptions : The ReconcileTransitGatewayConnections options.
+type ReconcileTransitGatewayConnectionsOptions struct {
+	// A unique ID for a virtual data center.
+	VdcID *string `json:"vdc_id" validate:"required,ne="`
+
+	// The IBM Transit Gateways that must be connected to each edge, keyed by edge ID. Edges that are not listed are
+	// left untouched. An edge listed with no gateways has all of its gateways removed.
+	Edges map[string][]TransitGatewayTarget `json:"edges" validate:"required,dive,dive"`
+
+	// Controls how long to wait for the connections to settle.
+	WaitOptions *WaitOptions `json:"-"`
+
+	// Language.
+	AcceptLanguage *string `json:"Accept-Language,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewReconcileTransitGatewayConnectionsOptions : Instantiate ReconcileTransitGatewayConnectionsOptions
+func (*VmwareV1) NewReconcileTransitGatewayConnectionsOptions(vdcID string) *ReconcileTransitGatewayConnectionsOptions {
+	return &ReconcileTransitGatewayConnectionsOptions{
+		VdcID: core.StringPtr(vdcID),
+		Edges: map[string][]TransitGatewayTarget{},
+	}
+}
+
+// SetVdcID : Allow user to set VdcID
+func (_options *ReconcileTransitGatewayConnectionsOptions) SetVdcID(vdcID string) *ReconcileTransitGatewayConnectionsOptions {
+	_options.VdcID = core.StringPtr(vdcID)
+	return _options
+}
+
+// SetEdge : Allow user to set the desired IBM Transit Gateways of one edge
+func (_options *ReconcileTransitGatewayConnectionsOptions) SetEdge(edgeID string, transitGateways []TransitGatewayTarget) *ReconcileTransitGatewayConnectionsOptions {
+	if _options.Edges == nil {
+		_options.Edges = map[string][]TransitGatewayTarget{}
+	}
+	_options.Edges[edgeID] = transitGateways
+	return _options
+}
+
+// AddTransitGateway : Allow user to add one desired IBM Transit Gateway to an edge
+func (_options *ReconcileTransitGatewayConnectionsOptions) AddTransitGateway(edgeID string, id string, region string) *ReconcileTransitGatewayConnectionsOptions {
+	target := TransitGatewayTarget{
+		ID: core.StringPtr(id),
+	}
+	if region != "" {
+		target.Region = core.StringPtr(region)
+	}
+	return _options.SetEdge(edgeID, append(_options.Edges[edgeID], target))
+}
+
+// SetWaitOptions : Allow user to set WaitOptions
+func (_options *ReconcileTransitGatewayConnectionsOptions) SetWaitOptions(waitOptions *WaitOptions) *ReconcileTransitGatewayConnectionsOptions {
+	_options.WaitOptions = waitOptions
+	return _options
+}
+
+// SetAcceptLanguage : Allow user to set AcceptLanguage
+func (_options *ReconcileTransitGatewayConnectionsOptions) SetAcceptLanguage(acceptLanguage string) *ReconcileTransitGatewayConnectionsOptions {
+	_options.AcceptLanguage = core.StringPtr(acceptLanguage)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *ReconcileTransitGatewayConnectionsOptions) SetHeaders(param map[string]string) *ReconcileTransitGatewayConnectionsOptions {
+	options.Headers = param
+	return options
+}
+
+// TransitGatewayTarget : An IBM Transit Gateway that must be connected to an edge.
+type TransitGatewayTarget struct {
+	// A unique ID for an IBM Transit Gateway.
+	ID *string `json:"id" validate:"required,ne="`
+
+	// The region where the IBM Transit Gateway is deployed.
+	Region *string `json:"region,omitempty"`
+}
+
+// TransitGatewayReconcileResult : The actions taken to reconcile the IBM Transit Gateway connections of a virtual data
+// center.
+type TransitGatewayReconcileResult struct {
+	// A unique ID for the virtual data center.
+	VdcID string `json:"vdc_id"`
+
+	// The actions taken, in the order of the edges and then removals last. Empty when nothing had to change.
+	Actions []TransitGatewayAction `json:"actions"`
+}
+
+// TransitGatewayAction : A change made to the IBM Transit Gateways of an edge.
+type TransitGatewayAction struct {
+	// A unique ID for the edge.
+	EdgeID string `json:"edge_id"`
+
+	// A unique ID for the IBM Transit Gateway.
+	TransitGatewayID string `json:"transit_gateway_id"`
+
+	// The region where the IBM Transit Gateway is deployed, when known.
+	Region string `json:"region,omitempty"`
+
+	// The kind of change.
+	Type string `json:"type"`
+
+	// The status of the IBM Transit Gateway once the change settled, or "removed".
+	Status string `json:"status,omitempty"`
+}
+
+// Constants associated with the TransitGatewayAction.Type property.
+// The kind of change.
+const (
+	TransitGatewayAction_Type_Add      = "add"
+	TransitGatewayAction_Type_Reattach = "reattach"
+	TransitGatewayAction_Type_Remove   = "remove"
+)
+
+// Constants associated with the TransitGatewayAction.Status property.
+// The status of the IBM Transit Gateway once it has been removed.
+const (
+	TransitGatewayAction_Status_Removed = "removed"
+)
+
+// Counts returns the number of actions of each type.
+func (result *TransitGatewayReconcileResult) Counts() map[string]int {
+	counts := map[string]int{}
+	for _, action := range result.Actions {
+		counts[action.Type]++
+	}
+	return counts
+}
@@ -0,0 +1,189 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vmwarev1_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vmware-go-sdk/vmwarev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// fakeTransitGatewayEdge simulates the IBM Transit Gateways of one edge. Added gateways report "creating" on the
+// first read and "ready_to_use" afterwards; removed gateways disappear on the first read after the removal.
+type fakeTransitGatewayEdge struct {
+	sync.Mutex
+	gateways map[string]string
+	reads    map[string]int
+	calls    []string
+}
+
+func (edge *fakeTransitGatewayEdge) vdcJSON() string {
+	edge.Lock()
+	defer edge.Unlock()
+	gateways := []string{}
+	for id, status := range edge.gateways {
+		edge.reads[id]++
+		if status == "creating" && edge.reads[id] > 1 {
+			edge.gateways[id] = "ready_to_use"
+		}
+		gateways = append(gateways, fmt.Sprintf(`{"id": "%s", "region": "us-south", "status": "%s", "connections": [{"name": "conn-%s", "status": "%s", "network_account_id": "NetworkAccountID", "network_type": "unbound_gre_tunnel", "base_network_type": "classic", "zone": "Zone"}]}`, id, gatewayStatus(status), id, status))
+	}
+	return fmt.Sprintf(`{"href": "Href", "id": "vdc_id", "crn": "Crn", "director_site": {"id": "site_id", "pvdc": {"id": "pvdc_id"}, "url": "URL"}, "edges": [{"id": "edge_id", "public_ips": [], "private_ips": [], "size": "medium", "status": "ready_to_use", "type": "performance", "version": "Version", "transit_gateways": [%s]}], "status_reasons": [], "name": "Name", "ordered_at": "2019-01-01T12:00:00.000Z", "org_href": "OrgHref", "org_name": "OrgName", "status": "ready_to_use", "type": "single_tenant", "fast_provisioning_enabled": false, "rhel_byol": false, "windows_byol": false}`, strings.Join(gateways, ","))
+}
+
+func gatewayStatus(connectionStatus string) string {
+	if connectionStatus == "detached" {
+		return "ready_to_use"
+	}
+	return connectionStatus
+}
+
+func (edge *fakeTransitGatewayEdge) handler() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		defer GinkgoRecover()
+		res.Header().Set("Content-type", "application/json")
+		if req.Method == http.MethodGet {
+			Expect(req.URL.EscapedPath()).To(Equal("/vdcs/vdc_id"))
+			res.WriteHeader(200)
+			fmt.Fprint(res, edge.vdcJSON())
+			return
+		}
+
+		Expect(req.URL.EscapedPath()).To(HavePrefix("/vdcs/vdc_id/edges/edge_id/transit_gateways/"))
+		id := strings.TrimPrefix(req.URL.EscapedPath(), "/vdcs/vdc_id/edges/edge_id/transit_gateways/")
+		edge.Lock()
+		defer edge.Unlock()
+		edge.calls = append(edge.calls, req.Method+" "+id)
+		switch req.Method {
+		case http.MethodPut:
+			var body map[string]interface{}
+			Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+			Expect(body["region"]).To(Equal("us-south"))
+			edge.gateways[id] = "creating"
+			edge.reads[id] = 0
+			res.WriteHeader(201)
+			fmt.Fprintf(res, `{"id": "%s", "connections": [], "status": "creating", "region": "us-south"}`, id)
+		case http.MethodDelete:
+			delete(edge.gateways, id)
+			res.WriteHeader(202)
+			fmt.Fprintf(res, `{"id": "%s", "connections": [], "status": "deleting", "region": "us-south"}`, id)
+		}
+	}
+}
+
+var _ = Describe(`Transit gateway reconciler`, func() {
+	var (
+		testServer    *httptest.Server
+		edge          *fakeTransitGatewayEdge
+		vmwareService *vmwarev1.VmwareV1
+		waitOptions   *vmwarev1.WaitOptions
+	)
+
+	BeforeEach(func() {
+		edge = &fakeTransitGatewayEdge{
+			gateways: map[string]string{
+				"tgw-keep":     "ready_to_use",
+				"tgw-detached": "detached",
+				"tgw-extra":    "ready_to_use",
+			},
+			reads: map[string]int{},
+		}
+		testServer = httptest.NewServer(edge.handler())
+
+		var serviceErr error
+		vmwareService, serviceErr = vmwarev1.NewVmwareV1(&vmwarev1.VmwareV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		waitOptions = vmwareService.NewWaitOptions(time.Millisecond, 5*time.Second)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	Describe(`ReconcileTransitGatewayConnections(reconcileTransitGatewayConnectionsOptions *ReconcileTransitGatewayConnectionsOptions)`, func() {
+		It(`Invoke ReconcileTransitGatewayConnections with nil options (negative test)`, func() {
+			result, operationErr := vmwareService.ReconcileTransitGatewayConnections(nil)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(result).To(BeNil())
+		})
+		It(`Adds, re-attaches and removes only what differs`, func() {
+			reconcileOptions := vmwareService.NewReconcileTransitGatewayConnectionsOptions("vdc_id")
+			reconcileOptions.AddTransitGateway("edge_id", "tgw-keep", "us-south")
+			reconcileOptions.AddTransitGateway("edge_id", "tgw-detached", "")
+			reconcileOptions.AddTransitGateway("edge_id", "tgw-new", "us-south")
+			reconcileOptions.SetWaitOptions(waitOptions)
+
+			result, operationErr := vmwareService.ReconcileTransitGatewayConnections(reconcileOptions)
+			Expect(operationErr).To(BeNil())
+			Expect(result).ToNot(BeNil())
+			Expect(result.VdcID).To(Equal("vdc_id"))
+			Expect(result.Actions).To(Equal([]vmwarev1.TransitGatewayAction{
+				{EdgeID: "edge_id", TransitGatewayID: "tgw-detached", Region: "us-south", Type: vmwarev1.TransitGatewayAction_Type_Reattach, Status: vmwarev1.TransitGateway_Status_ReadyToUse},
+				{EdgeID: "edge_id", TransitGatewayID: "tgw-new", Region: "us-south", Type: vmwarev1.TransitGatewayAction_Type_Add, Status: vmwarev1.TransitGateway_Status_ReadyToUse},
+				{EdgeID: "edge_id", TransitGatewayID: "tgw-extra", Region: "us-south", Type: vmwarev1.TransitGatewayAction_Type_Remove, Status: vmwarev1.TransitGatewayAction_Status_Removed},
+			}))
+			Expect(result.Counts()).To(Equal(map[string]int{"add": 1, "reattach": 1, "remove": 1}))
+			Expect(edge.calls).To(ConsistOf("DELETE tgw-detached", "DELETE tgw-extra", "PUT tgw-detached", "PUT tgw-new"))
+			Expect(edge.gateways).To(HaveKey("tgw-keep"))
+			Expect(edge.gateways).ToNot(HaveKey("tgw-extra"))
+		})
+		It(`Does nothing when the edge already matches`, func() {
+			edge.gateways = map[string]string{"tgw-keep": "ready_to_use"}
+			reconcileOptions := vmwareService.NewReconcileTransitGatewayConnectionsOptions("vdc_id")
+			reconcileOptions.AddTransitGateway("edge_id", "tgw-keep", "us-south")
+			reconcileOptions.SetWaitOptions(waitOptions)
+
+			result, operationErr := vmwareService.ReconcileTransitGatewayConnections(reconcileOptions)
+			Expect(operationErr).To(BeNil())
+			Expect(result.Actions).To(BeEmpty())
+			Expect(edge.calls).To(BeEmpty())
+		})
+		It(`Rejects targets without an ID before any request`, func() {
+			for _, target := range []vmwarev1.TransitGatewayTarget{{}, {ID: core.StringPtr("")}} {
+				reconcileOptions := vmwareService.NewReconcileTransitGatewayConnectionsOptions("vdc_id")
+				reconcileOptions.SetEdge("edge_id", []vmwarev1.TransitGatewayTarget{target})
+
+				result, operationErr := vmwareService.ReconcileTransitGatewayConnections(reconcileOptions)
+				Expect(operationErr).ToNot(BeNil())
+				Expect(result).To(BeNil())
+			}
+			Expect(edge.reads).To(BeEmpty())
+			Expect(edge.calls).To(BeEmpty())
+		})
+		It(`Fails when the edge does not exist`, func() {
+			reconcileOptions := vmwareService.NewReconcileTransitGatewayConnectionsOptions("vdc_id")
+			reconcileOptions.SetEdge("missing_edge", nil)
+
+			result, operationErr := vmwareService.ReconcileTransitGatewayConnections(reconcileOptions)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(operationErr.Error()).To(ContainSubstring("missing_edge"))
+			Expect(result).ToNot(BeNil())
+			Expect(result.Actions).To(BeEmpty())
+		})
+	})
+})
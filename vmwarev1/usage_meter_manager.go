@@ -0,0 +1,337 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vmwarev1
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vmware-go-sdk/common"
+)
+
+// UsageMeterSecretSink : Stores the access tokens returned when Usage Meters are registered.
+type UsageMeterSecretSink interface {
+	// StoreAccessToken stores the access token of "registration".
+	StoreAccessToken(registration *UsageMeterRegistration, accessToken string) error
+
+	// DeleteAccessToken removes the access token of "registration". It succeeds when no token is stored.
+	DeleteAccessToken(registration *UsageMeterRegistration) error
+}
+
+// FileSecretSink : A UsageMeterSecretSink that writes each access token to its own file, readable by the owner only.
+// The file of a Usage Meter is named after its ID with a ".token" extension.
+type FileSecretSink struct {
+	// The directory that holds the token files. It is created with 0700 permissions when missing.
+	Directory string
+}
+
+// NewFileSecretSink : Instantiate FileSecretSink
+func NewFileSecretSink(directory string) *FileSecretSink {
+	return &FileSecretSink{
+		Directory: directory,
+	}
+}
+
+// TokenPath returns the path of the file that holds the access token of "registration".
+func (sink *FileSecretSink) TokenPath(registration *UsageMeterRegistration) string {
+	return filepath.Join(sink.Directory, secretName(usageMeterID(registration))+".token")
+}
+
+// StoreAccessToken writes the access token of "registration" to its file with 0600 permissions.
+func (sink *FileSecretSink) StoreAccessToken(registration *UsageMeterRegistration, accessToken string) error {
+	err := os.MkdirAll(sink.Directory, 0700)
+	if err != nil {
+		return core.SDKErrorf(err, "", "secret-dir-error", common.GetComponentInfo())
+	}
+	return writeSecretFile(sink.TokenPath(registration), []byte(accessToken+"\n"))
+}
+
+// DeleteAccessToken removes the file that holds the access token of "registration".
+func (sink *FileSecretSink) DeleteAccessToken(registration *UsageMeterRegistration) error {
+	err := os.Remove(sink.TokenPath(registration))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return core.SDKErrorf(err, "", "secret-delete-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// EnvFileSecretSink : A UsageMeterSecretSink that keeps the access tokens as KEY=value lines of an env file, readable by
+// the owner only. The variable of a Usage Meter is named after its ID, upper-cased and prefixed with VariablePrefix.
+// Lines of the file that belong to other variables are preserved.
+type EnvFileSecretSink struct {
+	// The path of the env file.
+	Path string
+
+	// The prefix of the variable names. If empty, "VMWARE_USAGE_METER_TOKEN_" is used.
+	VariablePrefix string
+}
+
+// NewEnvFileSecretSink : Instantiate EnvFileSecretSink
+func NewEnvFileSecretSink(path string) *EnvFileSecretSink {
+	return &EnvFileSecretSink{
+		Path: path,
+	}
+}
+
+// VariableName returns the name of the variable that holds the access token of "registration".
+func (sink *EnvFileSecretSink) VariableName(registration *UsageMeterRegistration) string {
+	prefix := sink.VariablePrefix
+	if prefix == "" {
+		prefix = "VMWARE_USAGE_METER_TOKEN_"
+	}
+	return prefix + strings.ToUpper(strings.ReplaceAll(secretName(usageMeterID(registration)), "-", "_"))
+}
+
+// StoreAccessToken sets the variable of "registration" in the env file.
+func (sink *EnvFileSecretSink) StoreAccessToken(registration *UsageMeterRegistration, accessToken string) error {
+	return sink.update(sink.VariableName(registration), &accessToken)
+}
+
+// DeleteAccessToken removes the variable of "registration" from the env file.
+func (sink *EnvFileSecretSink) DeleteAccessToken(registration *UsageMeterRegistration) error {
+	return sink.update(sink.VariableName(registration), nil)
+}
+
+// update replaces, appends or (when "value" is nil) removes the line of "name" in the env file.
+func (sink *EnvFileSecretSink) update(name string, value *string) error {
+	content, err := os.ReadFile(sink.Path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return core.SDKErrorf(err, "", "secret-read-error", common.GetComponentInfo())
+	}
+
+	var updated bytes.Buffer
+	found := false
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(strings.TrimSpace(line), name+"=") {
+			found = true
+			if value != nil {
+				fmt.Fprintf(&updated, "%s=%s\n", name, *value)
+			}
+			continue
+		}
+		fmt.Fprintln(&updated, line)
+	}
+	if !found && value != nil {
+		fmt.Fprintf(&updated, "%s=%s\n", name, *value)
+	}
+	if !found && value == nil {
+		return nil
+	}
+
+	dir := filepath.Dir(sink.Path)
+	err = os.MkdirAll(dir, 0700)
+	if err != nil {
+		return core.SDKErrorf(err, "", "secret-dir-error", common.GetComponentInfo())
+	}
+	return writeSecretFile(sink.Path, updated.Bytes())
+}
+
+// writeSecretFile replaces the content of "path" atomically with a file that only its owner can read and write.
+func writeSecretFile(path string, content []byte) (err error) {
+	file, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return core.SDKErrorf(err, "", "secret-write-error", common.GetComponentInfo())
+	}
+	defer func() {
+		if err != nil {
+			_ = os.Remove(file.Name())
+		}
+	}()
+
+	err = file.Chmod(0600)
+	if err == nil {
+		_, err = file.Write(content)
+	}
+	closeErr := file.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), path)
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "secret-write-error", common.GetComponentInfo())
+	}
+	return
+}
+
+var unsafeSecretNameChars = regexp.MustCompile(`[^A-Za-z0-9_-]`)
+
+func secretName(id string) string {
+	return unsafeSecretNameChars.ReplaceAllString(id, "_")
+}
+
+func usageMeterID(registration *UsageMeterRegistration) string {
+	if registration.UsageMeter != nil && registration.UsageMeter.ID != nil {
+		return *registration.UsageMeter.ID
+	}
+	return stringValue(registration.ID)
+}
+
+// UsageMeterManager : Registers and deregisters Usage Meters while keeping their access tokens out of memory dumps
+// and logs.
+type UsageMeterManager struct {
+	// The service client used to call the API.
+	Service *VmwareV1
+
+	// Where the access tokens of new registrations are stored.
+	Sink UsageMeterSecretSink
+}
+
+// NewUsageMeterManager : Instantiate UsageMeterManager
+func NewUsageMeterManager(vmware *VmwareV1, sink UsageMeterSecretSink) *UsageMeterManager {
+	return &UsageMeterManager{
+		Service: vmware,
+		Sink:    sink,
+	}
+}
+
+// Register registers the Usage Meter identified by "usageMeterID" unless a registration already exists for it, in
+// which case the existing registration is returned and "created" is false. The access token of a new registration
+// is handed to the secret sink and cleared from the returned registration. If the sink fails, the registration is
+// returned with its access token so that it is not lost, together with the error.
+func (manager *UsageMeterManager) Register(ctx context.Context, name string, usageMeterID string) (registration *UsageMeterRegistration, created bool, err error) {
+	if core.IsNil(manager.Sink) {
+		err = core.SDKErrorf(nil, "a secret sink is required to register Usage Meters", "missing-secret-sink", common.GetComponentInfo())
+		return
+	}
+
+	registration, err = manager.FindByUsageMeterID(ctx, usageMeterID)
+	if err != nil || registration != nil {
+		return
+	}
+
+	createOptions := manager.Service.NewCreateUsageMeterRegistrationOptions(name, &UsageMeterIdentity{
+		ID: core.StringPtr(usageMeterID),
+	})
+	registration, _, err = manager.Service.CreateUsageMeterRegistrationWithContext(ctx, createOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "create-registration-error")
+		return
+	}
+	created = true
+
+	if registration.AccessToken != nil {
+		err = manager.Sink.StoreAccessToken(registration, *registration.AccessToken)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "store-token-error")
+			return
+		}
+		registration.AccessToken = nil
+	}
+	return
+}
+
+// FindByUsageMeterID returns the registration of the Usage Meter identified by "usageMeterID", or nil.
+func (manager *UsageMeterManager) FindByUsageMeterID(ctx context.Context, usageMeterID string) (*UsageMeterRegistration, error) {
+	registrations, _, err := manager.Service.ListUsageMeterRegistrationsWithContext(ctx, manager.Service.NewListUsageMeterRegistrationsOptions())
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "list-registrations-error")
+	}
+	for i := range registrations.UsageMeterRegistrations {
+		registration := &registrations.UsageMeterRegistrations[i]
+		if registration.UsageMeter != nil && registration.UsageMeter.ID != nil && *registration.UsageMeter.ID == usageMeterID {
+			return registration, nil
+		}
+	}
+	return nil, nil
+}
+
+// Deregister deletes the registration identified by "registrationID" and removes its access token from the secret
+// sink. Locked registrations cannot be deleted, so they are refused before any request to delete them is sent.
+func (manager *UsageMeterManager) Deregister(ctx context.Context, registrationID string) (err error) {
+	registration, _, err := manager.Service.GetUsageMeterRegistrationWithContext(ctx, manager.Service.NewGetUsageMeterRegistrationOptions(registrationID))
+	if err != nil {
+		return core.RepurposeSDKProblem(err, "get-registration-error")
+	}
+	if registration.Locked != nil && *registration.Locked {
+		return core.SDKErrorf(nil, fmt.Sprintf("the Usage Meter registration '%s' is locked and cannot be deleted", registrationID), "registration-locked", common.GetComponentInfo())
+	}
+
+	_, err = manager.Service.DeleteUsageMeterRegistrationWithContext(ctx, manager.Service.NewDeleteUsageMeterRegistrationOptions(registrationID))
+	if err != nil {
+		return core.RepurposeSDKProblem(err, "delete-registration-error")
+	}
+
+	if !core.IsNil(manager.Sink) {
+		err = manager.Sink.DeleteAccessToken(registration)
+		if err != nil {
+			return core.RepurposeSDKProblem(err, "delete-token-error")
+		}
+	}
+	return nil
+}
+
+// UsageMeterIssue : A Usage Meter registration that needs attention.
+type UsageMeterIssue struct {
+	// The registration.
+	Registration UsageMeterRegistration `json:"registration"`
+
+	// Why the registration needs attention.
+	Reasons []string `json:"reasons"`
+}
+
+// Constants associated with the UsageMeterIssue.Reasons property.
+// Why the registration needs attention.
+const (
+	UsageMeterIssue_Reasons_HealthError   = "health_error"
+	UsageMeterIssue_Reasons_HealthUnknown = "health_unknown"
+	UsageMeterIssue_Reasons_Locked        = "locked"
+)
+
+// Issues returns the registrations whose Usage Meter health is "error" or "unknown", or that are locked.
+func (manager *UsageMeterManager) Issues(ctx context.Context) (issues []UsageMeterIssue, err error) {
+	registrations, _, err := manager.Service.ListUsageMeterRegistrationsWithContext(ctx, manager.Service.NewListUsageMeterRegistrationsOptions())
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-registrations-error")
+		return
+	}
+
+	issues = []UsageMeterIssue{}
+	for _, registration := range registrations.UsageMeterRegistrations {
+		reasons := []string{}
+		if registration.UsageMeter != nil && registration.UsageMeter.Health != nil {
+			switch *registration.UsageMeter.Health {
+			case UsageMeter_Health_Error:
+				reasons = append(reasons, UsageMeterIssue_Reasons_HealthError)
+			case UsageMeter_Health_Unknown:
+				reasons = append(reasons, UsageMeterIssue_Reasons_HealthUnknown)
+			}
+		}
+		if registration.Locked != nil && *registration.Locked {
+			reasons = append(reasons, UsageMeterIssue_Reasons_Locked)
+		}
+		if len(reasons) > 0 {
+			registration.AccessToken = nil
+			issues = append(issues, UsageMeterIssue{
+				Registration: registration,
+				Reasons:      reasons,
+			})
+		}
+	}
+	return
+}
@@ -0,0 +1,191 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vmwarev1_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vmware-go-sdk/vmwarev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func usageMeterRegistrationJSON(id string, usageMeterID string, health string, locked bool, accessToken string) string {
+	token := ""
+	if accessToken != "" {
+		token = fmt.Sprintf(`"access_token": "%s", `, accessToken)
+	}
+	return fmt.Sprintf(`{"id": "%s", "crn": "Crn", %s"name": "name-%s", "status": "active", "usage_meter": {"id": "%s", "health": "%s", "version": "4.7"}, "locked": %t, "created_at": "2019-01-01T12:00:00.000Z", "href": "Href"}`, id, token, id, usageMeterID, health, locked)
+}
+
+var _ = Describe(`Usage Meter manager`, func() {
+	var (
+		testServer    *httptest.Server
+		registrations map[string]string
+		requests      []string
+		vmwareService *vmwarev1.VmwareV1
+		tokenDir      string
+	)
+
+	BeforeEach(func() {
+		registrations = map[string]string{
+			"reg-ok":     usageMeterRegistrationJSON("reg-ok", "um-ok", "ok", false, ""),
+			"reg-error":  usageMeterRegistrationJSON("reg-error", "um-error", "error", false, ""),
+			"reg-locked": usageMeterRegistrationJSON("reg-locked", "um-locked", "unknown", true, ""),
+		}
+		requests = []string{}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			requests = append(requests, req.Method+" "+req.URL.EscapedPath())
+			res.Header().Set("Content-type", "application/json")
+			id := strings.TrimPrefix(req.URL.EscapedPath(), "/usage_meter_registrations/")
+			switch {
+			case req.Method == http.MethodGet && req.URL.EscapedPath() == "/usage_meter_registrations":
+				items := []string{}
+				for _, registration := range registrations {
+					items = append(items, registration)
+				}
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"usage_meter_registrations": [%s]}`, strings.Join(items, ","))
+			case req.Method == http.MethodPost:
+				var body map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				usageMeterID := body["usage_meter"].(map[string]interface{})["id"].(string)
+				registrations["reg-new"] = usageMeterRegistrationJSON("reg-new", usageMeterID, "ok", false, "")
+				res.WriteHeader(201)
+				fmt.Fprint(res, usageMeterRegistrationJSON("reg-new", usageMeterID, "ok", false, "s3cr3t-token"))
+			case req.Method == http.MethodGet:
+				res.WriteHeader(200)
+				fmt.Fprint(res, registrations[id])
+			case req.Method == http.MethodDelete:
+				delete(registrations, id)
+				res.WriteHeader(204)
+			}
+		}))
+
+		var serviceErr error
+		vmwareService, serviceErr = vmwarev1.NewVmwareV1(&vmwarev1.VmwareV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+
+		tokenDir, serviceErr = os.MkdirTemp("", "usage-meter")
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+		os.RemoveAll(tokenDir)
+	})
+
+	It(`Registers a new Usage Meter and stores its token in a private file`, func() {
+		sink := vmwarev1.NewFileSecretSink(filepath.Join(tokenDir, "tokens"))
+		manager := vmwarev1.NewUsageMeterManager(vmwareService, sink)
+
+		registration, created, err := manager.Register(context.Background(), "meter", "um-new")
+		Expect(err).To(BeNil())
+		Expect(created).To(BeTrue())
+		Expect(*registration.ID).To(Equal("reg-new"))
+		Expect(registration.AccessToken).To(BeNil())
+
+		tokenPath := sink.TokenPath(registration)
+		Expect(tokenPath).To(Equal(filepath.Join(tokenDir, "tokens", "um-new.token")))
+		content, err := os.ReadFile(tokenPath)
+		Expect(err).To(BeNil())
+		Expect(string(content)).To(Equal("s3cr3t-token\n"))
+		info, err := os.Stat(tokenPath)
+		Expect(err).To(BeNil())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+
+		// Registering the same Usage Meter again returns the existing registration.
+		registration, created, err = manager.Register(context.Background(), "meter", "um-new")
+		Expect(err).To(BeNil())
+		Expect(created).To(BeFalse())
+		Expect(*registration.ID).To(Equal("reg-new"))
+		Expect(requests).To(HaveLen(3))
+	})
+	It(`Refuses to register without a secret sink`, func() {
+		manager := vmwarev1.NewUsageMeterManager(vmwareService, nil)
+		_, _, err := manager.Register(context.Background(), "meter", "um-new")
+		Expect(err).ToNot(BeNil())
+		Expect(requests).To(BeEmpty())
+	})
+	It(`Maintains the tokens in an env file`, func() {
+		envPath := filepath.Join(tokenDir, "usage-meters.env")
+		Expect(os.WriteFile(envPath, []byte("OTHER=value\n"), 0600)).To(Succeed())
+		sink := vmwarev1.NewEnvFileSecretSink(envPath)
+		manager := vmwarev1.NewUsageMeterManager(vmwareService, sink)
+
+		registration, _, err := manager.Register(context.Background(), "meter", "um-new")
+		Expect(err).To(BeNil())
+		Expect(sink.VariableName(registration)).To(Equal("VMWARE_USAGE_METER_TOKEN_UM_NEW"))
+		content, err := os.ReadFile(envPath)
+		Expect(err).To(BeNil())
+		Expect(string(content)).To(Equal("OTHER=value\nVMWARE_USAGE_METER_TOKEN_UM_NEW=s3cr3t-token\n"))
+
+		Expect(manager.Deregister(context.Background(), "reg-new")).To(Succeed())
+		content, err = os.ReadFile(envPath)
+		Expect(err).To(BeNil())
+		Expect(string(content)).To(Equal("OTHER=value\n"))
+		Expect(registrations).ToNot(HaveKey("reg-new"))
+	})
+	It(`Refuses to deregister a locked Usage Meter`, func() {
+		manager := vmwarev1.NewUsageMeterManager(vmwareService, vmwarev1.NewFileSecretSink(tokenDir))
+		err := manager.Deregister(context.Background(), "reg-locked")
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("locked"))
+		Expect(requests).To(Equal([]string{"GET /usage_meter_registrations/reg-locked"}))
+	})
+	It(`Reports unhealthy and locked Usage Meters`, func() {
+		manager := vmwarev1.NewUsageMeterManager(vmwareService, nil)
+		issues, err := manager.Issues(context.Background())
+		Expect(err).To(BeNil())
+		Expect(issues).To(HaveLen(2))
+		reasons := map[string][]string{}
+		for _, issue := range issues {
+			reasons[*issue.Registration.ID] = issue.Reasons
+		}
+		Expect(reasons).To(Equal(map[string][]string{
+			"reg-error":  {vmwarev1.UsageMeterIssue_Reasons_HealthError},
+			"reg-locked": {vmwarev1.UsageMeterIssue_Reasons_HealthUnknown, vmwarev1.UsageMeterIssue_Reasons_Locked},
+		}))
+	})
+	It(`Redacts the access token when a registration is printed`, func() {
+		registration := &vmwarev1.UsageMeterRegistration{
+			ID:          core.StringPtr("reg-new"),
+			AccessToken: core.StringPtr("s3cr3t-token"),
+		}
+		for _, format := range []string{"%v", "%+v", "%#v", "%s"} {
+			Expect(fmt.Sprintf(format, registration)).ToNot(ContainSubstring("s3cr3t-token"))
+			Expect(fmt.Sprintf(format, *registration)).ToNot(ContainSubstring("s3cr3t-token"))
+		}
+		Expect(registration.String()).To(ContainSubstring(`"access_token":"[redacted]"`))
+		logged, err := json.Marshal(registration.MarshalLog())
+		Expect(err).To(BeNil())
+		Expect(string(logged)).ToNot(ContainSubstring("s3cr3t-token"))
+		Expect(*registration.AccessToken).To(Equal("s3cr3t-token"))
+	})
+})
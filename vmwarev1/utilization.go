@@ -0,0 +1,484 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vmwarev1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vmware-go-sdk/common"
+)
+
+// EdgeOverhead : The vCPUs and RAM that one node of an edge consumes in its resource pool.
+type EdgeOverhead struct {
+	// The vCPUs of the edge node.
+	Cpu int64 `json:"cpu"`
+
+	// The RAM of the edge node in GB.
+	Ram int64 `json:"ram"`
+}
+
+// defaultEdgeOverheads are the sizes of the NSX edge nodes of performance edges, by edge size. A performance edge runs
+// an active and a standby node: both in the resource pool of its virtual data center, or one in each resource pool of
+// a network HA edge. Efficiency edges do not consume capacity of the resource pool.
+var defaultEdgeOverheads = map[string]EdgeOverhead{
+	Edge_Size_Medium:     {Cpu: 4, Ram: 8},
+	Edge_Size_Large:      {Cpu: 8, Ram: 32},
+	Edge_Size_ExtraLarge: {Cpu: 16, Ram: 64},
+}
+
+// DefaultEdgeOverheads returns a copy of the overheads of edge nodes by edge size that are used when none are set.
+// Change the copy and set it in the options to override some of them.
+func DefaultEdgeOverheads() map[string]EdgeOverhead {
+	edgeOverheads := make(map[string]EdgeOverhead, len(defaultEdgeOverheads))
+	for size, overhead := range defaultEdgeOverheads {
+		edgeOverheads[size] = overhead
+	}
+	return edgeOverheads
+}
+
+// GetUtilizationReport : Report the committed capacity of the resource pools
+// Collect the Cloud Director sites, virtual data centers and host profiles of the account and report, for each
+// resource pool, data center and single-tenant Cloud Director site, the capacity of the clusters, the part that the
+// virtual data centers and their edges commit, and the headroom. Use NewUtilizationReport to work from a saved
+// inventory.
+func (vmware *VmwareV1) GetUtilizationReport(getUtilizationReportOptions *GetUtilizationReportOptions) (result *UtilizationReport, err error) {
+	result, err = vmware.GetUtilizationReportWithContext(context.Background(), getUtilizationReportOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetUtilizationReportWithContext is an alternate form of the GetUtilizationReport method which supports a Context parameter
+func (vmware *VmwareV1) GetUtilizationReportWithContext(ctx context.Context, getUtilizationReportOptions *GetUtilizationReportOptions) (result *UtilizationReport, err error) {
+	if getUtilizationReportOptions == nil {
+		getUtilizationReportOptions = vmware.NewGetUtilizationReportOptions()
+	}
+
+	collectInventoryOptions := vmware.NewCollectInventoryOptions()
+	collectInventoryOptions.AcceptLanguage = getUtilizationReportOptions.AcceptLanguage
+	collectInventoryOptions.XGlobalTransactionID = getUtilizationReportOptions.XGlobalTransactionID
+	collectInventoryOptions.SetHeaders(getUtilizationReportOptions.Headers)
+	inventory, err := vmware.CollectInventoryWithContext(ctx, collectInventoryOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "collect-inventory-error")
+		return
+	}
+	result = NewUtilizationReport(inventory, getUtilizationReportOptions.EdgeOverheads)
+	return
+}
+
+// GetUtilizationReportOptions : The GetUtilizationReport options.
+type GetUtilizationReportOptions struct {
+	// The overhead of an edge node by edge size. DefaultEdgeOverheads() is used when it is not set.
+	EdgeOverheads map[string]EdgeOverhead
+
+	// Language.
+	AcceptLanguage *string `json:"Accept-Language,omitempty"`
+
+	// Transaction ID.
+	XGlobalTransactionID *string `json:"X-Global-Transaction-ID,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewGetUtilizationReportOptions : Instantiate GetUtilizationReportOptions
+func (*VmwareV1) NewGetUtilizationReportOptions() *GetUtilizationReportOptions {
+	return &GetUtilizationReportOptions{}
+}
+
+// SetEdgeOverheads : Allow user to set EdgeOverheads
+func (_options *GetUtilizationReportOptions) SetEdgeOverheads(edgeOverheads map[string]EdgeOverhead) *GetUtilizationReportOptions {
+	_options.EdgeOverheads = edgeOverheads
+	return _options
+}
+
+// SetAcceptLanguage : Allow user to set AcceptLanguage
+func (_options *GetUtilizationReportOptions) SetAcceptLanguage(acceptLanguage string) *GetUtilizationReportOptions {
+	_options.AcceptLanguage = core.StringPtr(acceptLanguage)
+	return _options
+}
+
+// SetXGlobalTransactionID : Allow user to set XGlobalTransactionID
+func (_options *GetUtilizationReportOptions) SetXGlobalTransactionID(xGlobalTransactionID string) *GetUtilizationReportOptions {
+	_options.XGlobalTransactionID = core.StringPtr(xGlobalTransactionID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *GetUtilizationReportOptions) SetHeaders(param map[string]string) *GetUtilizationReportOptions {
+	options.Headers = param
+	return options
+}
+
+// Utilization : The capacity of a resource pool, or of all the resource pools of a data center or Cloud Director
+// site, and the part of it that is committed.
+type Utilization struct {
+	// The vCPUs of the hosts of the clusters.
+	Cpu int64 `json:"cpu"`
+
+	// The RAM of the hosts of the clusters in GB.
+	Ram int64 `json:"ram"`
+
+	// The vCPUs reserved by the virtual data centers.
+	ReservedCpu int64 `json:"reserved_cpu"`
+
+	// The RAM reserved by the virtual data centers in GB.
+	ReservedRam int64 `json:"reserved_ram"`
+
+	// The vCPUs consumed by the edge nodes.
+	EdgeCpu int64 `json:"edge_cpu"`
+
+	// The RAM consumed by the edge nodes in GB.
+	EdgeRam int64 `json:"edge_ram"`
+
+	// The vCPUs that are not committed. It is negative when the resource pools are overcommitted.
+	AvailableCpu int64 `json:"available_cpu"`
+
+	// The RAM in GB that is not committed.
+	AvailableRam int64 `json:"available_ram"`
+
+	// The committed fraction of the vCPUs, from 0 to 1 unless overcommitted.
+	CommittedCpu float64 `json:"committed_cpu"`
+
+	// The committed fraction of the RAM.
+	CommittedRam float64 `json:"committed_ram"`
+}
+
+func (utilization *Utilization) add(other Utilization) {
+	utilization.Cpu += other.Cpu
+	utilization.Ram += other.Ram
+	utilization.ReservedCpu += other.ReservedCpu
+	utilization.ReservedRam += other.ReservedRam
+	utilization.EdgeCpu += other.EdgeCpu
+	utilization.EdgeRam += other.EdgeRam
+}
+
+// finish computes the headroom and the committed fractions from the capacity and the commitments.
+func (utilization *Utilization) finish() {
+	utilization.AvailableCpu = utilization.Cpu - utilization.ReservedCpu - utilization.EdgeCpu
+	utilization.AvailableRam = utilization.Ram - utilization.ReservedRam - utilization.EdgeRam
+	utilization.CommittedCpu = committedFraction(utilization.ReservedCpu+utilization.EdgeCpu, utilization.Cpu)
+	utilization.CommittedRam = committedFraction(utilization.ReservedRam+utilization.EdgeRam, utilization.Ram)
+}
+
+func committedFraction(committed int64, capacity int64) float64 {
+	if capacity <= 0 {
+		return 0
+	}
+	return float64(committed) / float64(capacity)
+}
+
+// UtilizationReport : The committed capacity of the single-tenant Cloud Director sites of an account.
+type UtilizationReport struct {
+	// When the inventory that the report is computed from was collected.
+	CollectedAt time.Time `json:"collected_at"`
+
+	// The Cloud Director sites, in the order of the inventory.
+	DirectorSites []DirectorSiteUtilization `json:"director_sites"`
+}
+
+// DirectorSiteUtilization : The committed capacity of a Cloud Director site.
+type DirectorSiteUtilization struct {
+	// The ID of the Cloud Director site.
+	DirectorSiteID string `json:"director_site_id"`
+
+	// The name of the Cloud Director site.
+	DirectorSiteName string `json:"director_site_name"`
+
+	Utilization
+
+	// The data centers of the resource pools, sorted by name.
+	DataCenters []DataCenterUtilization `json:"data_centers"`
+}
+
+// DataCenterUtilization : The committed capacity of the resource pools of a Cloud Director site in a data center.
+type DataCenterUtilization struct {
+	// The name of the data center.
+	DataCenterName string `json:"data_center_name"`
+
+	Utilization
+
+	// The resource pools in the data center.
+	Pvdcs []PvdcUtilization `json:"pvdcs"`
+}
+
+// PvdcUtilization : The committed capacity of a resource pool.
+type PvdcUtilization struct {
+	// The ID of the resource pool.
+	PvdcID string `json:"pvdc_id"`
+
+	// The name of the resource pool.
+	PvdcName string `json:"pvdc_name"`
+
+	// The name of the data center of the resource pool.
+	DataCenterName string `json:"data_center_name"`
+
+	Utilization
+
+	// The number of virtual data centers that reserve capacity of the resource pool.
+	VdcCount int `json:"vdc_count"`
+
+	// The number of edge nodes in the resource pool.
+	EdgeNodeCount int `json:"edge_node_count"`
+
+	// The host profiles of clusters that the inventory does not describe. The capacity of these clusters is not
+	// counted.
+	UnknownHostProfiles []string `json:"unknown_host_profiles,omitempty"`
+}
+
+// NewUtilizationReport computes the committed capacity of the single-tenant Cloud Director sites of "inventory", which
+// must include the host profiles. The overhead of edges is taken from "edgeOverheads", or DefaultEdgeOverheads() when
+// it is nil.
+func NewUtilizationReport(inventory *Inventory, edgeOverheads map[string]EdgeOverhead) *UtilizationReport {
+	report := &UtilizationReport{
+		CollectedAt:   inventory.CollectedAt,
+		DirectorSites: []DirectorSiteUtilization{},
+	}
+	for i := range inventory.DirectorSites {
+		site := &inventory.DirectorSites[i]
+		if stringValue(site.Type) == DirectorSite_Type_Multitenant {
+			// IBM Cloud manages the capacity of multitenant sites.
+			continue
+		}
+		siteUtilization := DirectorSiteUtilization{
+			DirectorSiteID:   stringValue(site.ID),
+			DirectorSiteName: stringValue(site.Name),
+			DataCenters:      []DataCenterUtilization{},
+		}
+		dataCenters := map[string]*DataCenterUtilization{}
+		for j := range site.Pvdcs {
+			pvdcUtilization := inventory.pvdcUtilization(siteUtilization.DirectorSiteID, &site.Pvdcs[j], edgeOverheads)
+			dataCenter := dataCenters[pvdcUtilization.DataCenterName]
+			if dataCenter == nil {
+				dataCenter = &DataCenterUtilization{
+					DataCenterName: pvdcUtilization.DataCenterName,
+					Pvdcs:          []PvdcUtilization{},
+				}
+				dataCenters[pvdcUtilization.DataCenterName] = dataCenter
+			}
+			dataCenter.Pvdcs = append(dataCenter.Pvdcs, pvdcUtilization)
+			dataCenter.add(pvdcUtilization.Utilization)
+			siteUtilization.add(pvdcUtilization.Utilization)
+		}
+		for _, dataCenter := range dataCenters {
+			dataCenter.finish()
+			siteUtilization.DataCenters = append(siteUtilization.DataCenters, *dataCenter)
+		}
+		sort.Slice(siteUtilization.DataCenters, func(i, j int) bool {
+			return siteUtilization.DataCenters[i].DataCenterName < siteUtilization.DataCenters[j].DataCenterName
+		})
+		siteUtilization.finish()
+		report.DirectorSites = append(report.DirectorSites, siteUtilization)
+	}
+	return report
+}
+
+// pvdcUtilization computes the capacity of the clusters of a resource pool of the Cloud Director site "siteID" from
+// their host profiles, and the reservations of the virtual data centers and edge nodes placed on it.
+func (inventory *Inventory) pvdcUtilization(siteID string, pvdc *PVDC, edgeOverheads map[string]EdgeOverhead) PvdcUtilization {
+	if edgeOverheads == nil {
+		edgeOverheads = defaultEdgeOverheads
+	}
+	pvdcID := stringValue(pvdc.ID)
+	utilization := PvdcUtilization{
+		PvdcID:         pvdcID,
+		PvdcName:       stringValue(pvdc.Name),
+		DataCenterName: stringValue(pvdc.DataCenterName),
+	}
+	for _, cluster := range pvdc.Clusters {
+		status := stringValue(cluster.Status)
+		if status == "deleting" || status == "deleted" {
+			continue
+		}
+		profile := inventory.GetHostProfile(stringValue(cluster.HostProfile))
+		if profile == nil {
+			if !containsString(utilization.UnknownHostProfiles, stringValue(cluster.HostProfile)) {
+				utilization.UnknownHostProfiles = append(utilization.UnknownHostProfiles, stringValue(cluster.HostProfile))
+			}
+			continue
+		}
+		utilization.Cpu += int64Value(cluster.HostCount) * int64Value(profile.Cpu)
+		utilization.Ram += int64Value(cluster.HostCount) * int64Value(profile.Ram)
+	}
+	for _, vdc := range inventory.VdcsOfDirectorSite(siteID) {
+		status := stringValue(vdc.Status)
+		if status == VDC_Status_Deleted || status == VDC_Status_Failed {
+			continue
+		}
+		onPvdc := vdc.DirectorSite.Pvdc != nil && stringValue(vdc.DirectorSite.Pvdc.ID) == pvdcID
+		if onPvdc {
+			utilization.VdcCount++
+			utilization.ReservedCpu += int64Value(vdc.Cpu)
+			utilization.ReservedRam += int64Value(vdc.Ram)
+		}
+		for _, edge := range vdc.Edges {
+			if stringValue(edge.Type) != Edge_Type_Performance || stringValue(edge.Status) == Edge_Status_Deleted {
+				continue
+			}
+			nodes := 0
+			if edge.PrimaryPvdcID != nil || edge.SecondaryPvdcID != nil {
+				if stringValue(edge.PrimaryPvdcID) == pvdcID {
+					nodes++
+				}
+				if stringValue(edge.SecondaryPvdcID) == pvdcID {
+					nodes++
+				}
+			} else if onPvdc {
+				nodes = 2
+			}
+			overhead := edgeOverheads[stringValue(edge.Size)]
+			utilization.EdgeNodeCount += nodes
+			utilization.EdgeCpu += int64(nodes) * overhead.Cpu
+			utilization.EdgeRam += int64(nodes) * overhead.Ram
+		}
+	}
+	utilization.finish()
+	return utilization
+}
+
+// WriteJSON writes the report as indented JSON.
+func (report *UtilizationReport) WriteJSON(writer io.Writer) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	err := encoder.Encode(report)
+	if err != nil {
+		return core.SDKErrorf(err, "", "utilization-encode-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// WriteTable writes the report as an aligned text table with a row per Cloud Director site, followed by a row per
+// data center and per resource pool.
+func (report *UtilizationReport) WriteTable(writer io.Writer) error {
+	tableWriter := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tableWriter, "SCOPE\tNAME\tCPU\tRESERVED\tEDGE\tAVAILABLE\tCOMMITTED\tRAM\tRESERVED\tEDGE\tAVAILABLE\tCOMMITTED")
+	row := func(scope string, name string, utilization Utilization) {
+		fmt.Fprintf(tableWriter, "%s\t%s\t%d\t%d\t%d\t%d\t%.1f%%\t%d\t%d\t%d\t%d\t%.1f%%\n", scope, name,
+			utilization.Cpu, utilization.ReservedCpu, utilization.EdgeCpu, utilization.AvailableCpu, utilization.CommittedCpu*100,
+			utilization.Ram, utilization.ReservedRam, utilization.EdgeRam, utilization.AvailableRam, utilization.CommittedRam*100)
+	}
+	for _, site := range report.DirectorSites {
+		row(UtilizationAlert_Scope_DirectorSite, site.DirectorSiteName, site.Utilization)
+		for _, dataCenter := range site.DataCenters {
+			row(UtilizationAlert_Scope_DataCenter, dataCenter.DataCenterName, dataCenter.Utilization)
+			for _, pvdc := range dataCenter.Pvdcs {
+				row(UtilizationAlert_Scope_Pvdc, pvdc.PvdcName, pvdc.Utilization)
+			}
+		}
+	}
+	err := tableWriter.Flush()
+	if err != nil {
+		return core.SDKErrorf(err, "", "utilization-write-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// UtilizationAlert : A Cloud Director site, data center or resource pool whose committed capacity crosses a threshold.
+type UtilizationAlert struct {
+	// The scope of the alert.
+	Scope string `json:"scope"`
+
+	// The ID of the Cloud Director site.
+	DirectorSiteID string `json:"director_site_id"`
+
+	// The name of the data center, for the data center and resource pool scopes.
+	DataCenterName string `json:"data_center_name,omitempty"`
+
+	// The ID of the resource pool, for the resource pool scope.
+	PvdcID string `json:"pvdc_id,omitempty"`
+
+	// The resource whose committed fraction crosses the threshold.
+	Resource string `json:"resource"`
+
+	// The committed fraction of the resource.
+	Committed float64 `json:"committed"`
+
+	// The threshold that is crossed.
+	Threshold float64 `json:"threshold"`
+}
+
+// Constants associated with the UtilizationAlert.Scope property.
+// The scope of the alert.
+const (
+	UtilizationAlert_Scope_DataCenter   = "data_center"
+	UtilizationAlert_Scope_DirectorSite = "director_site"
+	UtilizationAlert_Scope_Pvdc         = "pvdc"
+)
+
+// Constants associated with the UtilizationAlert.Resource property.
+// The resource whose committed fraction crosses the threshold.
+const (
+	UtilizationAlert_Resource_Cpu = "cpu"
+	UtilizationAlert_Resource_Ram = "ram"
+)
+
+// String describes the alert.
+func (alert UtilizationAlert) String() string {
+	var subject string
+	switch alert.Scope {
+	case UtilizationAlert_Scope_DirectorSite:
+		subject = fmt.Sprintf("Cloud Director site %s", alert.DirectorSiteID)
+	case UtilizationAlert_Scope_DataCenter:
+		subject = fmt.Sprintf("data center %s of Cloud Director site %s", alert.DataCenterName, alert.DirectorSiteID)
+	default:
+		subject = fmt.Sprintf("resource pool %s of Cloud Director site %s", alert.PvdcID, alert.DirectorSiteID)
+	}
+	return fmt.Sprintf("%s has %.1f%% of its %s committed (threshold %.1f%%)", subject, alert.Committed*100, alert.Resource, alert.Threshold*100)
+}
+
+// Alerts returns an alert for each Cloud Director site, data center and resource pool whose committed fraction of CPU
+// or RAM is at least "threshold", such as 0.8 for 80%.
+func (report *UtilizationReport) Alerts(threshold float64) []UtilizationAlert {
+	alerts := []UtilizationAlert{}
+	check := func(alert UtilizationAlert, utilization Utilization) {
+		alert.Threshold = threshold
+		if utilization.Cpu > 0 && utilization.CommittedCpu >= threshold {
+			alert.Resource, alert.Committed = UtilizationAlert_Resource_Cpu, utilization.CommittedCpu
+			alerts = append(alerts, alert)
+		}
+		if utilization.Ram > 0 && utilization.CommittedRam >= threshold {
+			alert.Resource, alert.Committed = UtilizationAlert_Resource_Ram, utilization.CommittedRam
+			alerts = append(alerts, alert)
+		}
+	}
+	for _, site := range report.DirectorSites {
+		check(UtilizationAlert{Scope: UtilizationAlert_Scope_DirectorSite, DirectorSiteID: site.DirectorSiteID}, site.Utilization)
+		for _, dataCenter := range site.DataCenters {
+			check(UtilizationAlert{
+				Scope:          UtilizationAlert_Scope_DataCenter,
+				DirectorSiteID: site.DirectorSiteID,
+				DataCenterName: dataCenter.DataCenterName,
+			}, dataCenter.Utilization)
+			for _, pvdc := range dataCenter.Pvdcs {
+				check(UtilizationAlert{
+					Scope:          UtilizationAlert_Scope_Pvdc,
+					DirectorSiteID: site.DirectorSiteID,
+					DataCenterName: dataCenter.DataCenterName,
+					PvdcID:         pvdc.PvdcID,
+				}, pvdc.Utilization)
+			}
+		}
+	}
+	return alerts
+}
@@ -0,0 +1,172 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package vmwarev1_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vmware-go-sdk/vmwarev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Utilization report`, func() {
+	const (
+		directorSitesJSON = `[{"id": "site1", "name": "dedicated", "type": "single_tenant", "status": "ready_to_use", "pvdcs": [
+			{"id": "pvdc1", "name": "pvdc1", "data_center_name": "dal10", "status": "ready_to_use", "clusters": [
+				{"id": "cluster1", "name": "cluster1", "host_count": 2, "host_profile": "BM_2S_20_CORES_192_GB", "status": "ready_to_use"},
+				{"id": "cluster2", "name": "cluster2", "host_count": 4, "host_profile": "BM_2S_20_CORES_192_GB", "status": "deleting"}]},
+			{"id": "pvdc2", "name": "pvdc2", "data_center_name": "dal12", "status": "ready_to_use", "clusters": [
+				{"id": "cluster3", "name": "cluster3", "host_count": 1, "host_profile": "BM_2S_20_CORES_192_GB", "status": "ready_to_use"},
+				{"id": "cluster4", "name": "cluster4", "host_count": 3, "host_profile": "BM_UNKNOWN", "status": "ready_to_use"}]}]},
+			{"id": "site2", "name": "shared", "type": "multitenant", "status": "ready_to_use", "pvdcs": []}]`
+		vdcsJSON = `[
+			{"id": "vdc1", "cpu": 50, "ram": 200, "status": "ready_to_use", "director_site": {"id": "site1", "pvdc": {"id": "pvdc1"}, "url": "URL"},
+				"edges": [{"id": "edge1", "type": "performance", "size": "large", "status": "ready_to_use"}]},
+			{"id": "vdc2", "cpu": 10, "ram": 40, "status": "ready_to_use", "director_site": {"id": "site1", "pvdc": {"id": "pvdc2"}, "url": "URL"},
+				"edges": [{"id": "edge2", "type": "performance", "size": "medium", "status": "ready_to_use", "primary_pvdc_id": "pvdc2", "secondary_pvdc_id": "pvdc1"}]},
+			{"id": "vdc3", "cpu": 100, "ram": 100, "status": "failed", "director_site": {"id": "site1", "pvdc": {"id": "pvdc1"}, "url": "URL"}, "edges": []},
+			{"id": "vdc4", "cpu": 4, "ram": 8, "status": "ready_to_use", "director_site": {"id": "site1", "pvdc": {"id": "pvdc1"}, "url": "URL"},
+				"edges": [{"id": "edge4", "type": "efficiency", "size": "medium", "status": "ready_to_use"}]}]`
+		hostProfilesJSON = `[{"id": "BM_2S_20_CORES_192_GB", "cpu": 40, "ram": 192}]`
+	)
+
+	readInventory := func() *vmwarev1.Inventory {
+		inventory, err := vmwarev1.ReadInventory(strings.NewReader(fmt.Sprintf(
+			`{"collected_at": "2026-10-18T08:00:00Z", "director_sites": %s, "vdcs": %s, "director_site_host_profiles": %s}`,
+			directorSitesJSON, vdcsJSON, hostProfilesJSON)))
+		Expect(err).To(BeNil())
+		return inventory
+	}
+
+	It(`Computes the committed capacity of the resource pools, data centers and sites`, func() {
+		report := vmwarev1.NewUtilizationReport(readInventory(), nil)
+		Expect(report.DirectorSites).To(HaveLen(1))
+		site := report.DirectorSites[0]
+		Expect(site.DirectorSiteName).To(Equal("dedicated"))
+		Expect(site.DataCenters).To(HaveLen(2))
+
+		// The large edge runs two nodes in pvdc1, and the network HA edge one node in each resource pool.
+		pvdc1 := site.DataCenters[0].Pvdcs[0]
+		Expect(pvdc1.Utilization).To(Equal(vmwarev1.Utilization{
+			Cpu: 80, Ram: 384,
+			ReservedCpu: 54, ReservedRam: 208,
+			EdgeCpu: 20, EdgeRam: 72,
+			AvailableCpu: 6, AvailableRam: 104,
+			CommittedCpu: 74.0 / 80, CommittedRam: 280.0 / 384,
+		}))
+		Expect(pvdc1.VdcCount).To(Equal(2))
+		Expect(pvdc1.EdgeNodeCount).To(Equal(3))
+		pvdc2 := site.DataCenters[1].Pvdcs[0]
+		Expect(site.DataCenters[1].DataCenterName).To(Equal("dal12"))
+		Expect(pvdc2.Cpu).To(Equal(int64(40)))
+		Expect(pvdc2.EdgeCpu).To(Equal(int64(4)))
+		Expect(pvdc2.AvailableRam).To(Equal(int64(144)))
+		Expect(pvdc2.UnknownHostProfiles).To(Equal([]string{"BM_UNKNOWN"}))
+		Expect(site.Cpu).To(Equal(int64(120)))
+		Expect(site.AvailableCpu).To(Equal(int64(32)))
+		Expect(site.CommittedRam).To(Equal(328.0 / 576))
+
+		report = vmwarev1.NewUtilizationReport(readInventory(), map[string]vmwarev1.EdgeOverhead{"large": {Cpu: 1, Ram: 1}})
+		Expect(report.DirectorSites[0].DataCenters[0].Pvdcs[0].EdgeCpu).To(Equal(int64(2)))
+
+		edgeOverheads := vmwarev1.DefaultEdgeOverheads()
+		Expect(edgeOverheads).To(HaveKeyWithValue("large", vmwarev1.EdgeOverhead{Cpu: 8, Ram: 32}))
+		edgeOverheads["large"] = vmwarev1.EdgeOverhead{Cpu: 1, Ram: 1}
+		Expect(vmwarev1.DefaultEdgeOverheads()).To(HaveKeyWithValue("large", vmwarev1.EdgeOverhead{Cpu: 8, Ram: 32}))
+		report = vmwarev1.NewUtilizationReport(readInventory(), nil)
+		Expect(report.DirectorSites[0].DataCenters[0].Pvdcs[0].EdgeCpu).To(Equal(pvdc1.EdgeCpu))
+	})
+
+	It(`Writes tables and JSON and raises alerts`, func() {
+		report := vmwarev1.NewUtilizationReport(readInventory(), nil)
+
+		var table bytes.Buffer
+		Expect(report.WriteTable(&table)).To(Succeed())
+		lines := strings.Split(strings.TrimSpace(table.String()), "\n")
+		Expect(lines).To(HaveLen(6))
+		Expect(strings.Fields(lines[0])).To(Equal([]string{"SCOPE", "NAME", "CPU", "RESERVED", "EDGE", "AVAILABLE", "COMMITTED", "RAM", "RESERVED", "EDGE", "AVAILABLE", "COMMITTED"}))
+		Expect(strings.Fields(lines[3])).To(Equal([]string{"pvdc", "pvdc1", "80", "54", "20", "6", "92.5%", "384", "208", "72", "104", "72.9%"}))
+
+		var buffer bytes.Buffer
+		Expect(report.WriteJSON(&buffer)).To(Succeed())
+		var decoded map[string]interface{}
+		Expect(json.Unmarshal(buffer.Bytes(), &decoded)).To(Succeed())
+		Expect(decoded["collected_at"]).To(Equal("2026-10-18T08:00:00Z"))
+		dataCenter := decoded["director_sites"].([]interface{})[0].(map[string]interface{})["data_centers"].([]interface{})[0].(map[string]interface{})
+		Expect(dataCenter["data_center_name"]).To(Equal("dal10"))
+		Expect(dataCenter["available_cpu"]).To(Equal(float64(6)))
+
+		alerts := report.Alerts(0.8)
+		Expect(alerts).To(HaveLen(2))
+		Expect(alerts[0].Scope).To(Equal(vmwarev1.UtilizationAlert_Scope_DataCenter))
+		Expect(alerts[1]).To(Equal(vmwarev1.UtilizationAlert{
+			Scope:          vmwarev1.UtilizationAlert_Scope_Pvdc,
+			DirectorSiteID: "site1",
+			DataCenterName: "dal10",
+			PvdcID:         "pvdc1",
+			Resource:       vmwarev1.UtilizationAlert_Resource_Cpu,
+			Committed:      74.0 / 80,
+			Threshold:      0.8,
+		}))
+		Expect(alerts[1].String()).To(Equal("resource pool pvdc1 of Cloud Director site site1 has 92.5% of its cpu committed (threshold 80.0%)"))
+		Expect(report.Alerts(0.7)).To(HaveLen(5))
+		Expect(report.Alerts(1)).To(BeEmpty())
+	})
+
+	It(`Reports from live calls`, func() {
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			switch req.URL.Path {
+			case "/director_sites":
+				fmt.Fprintf(res, `{"director_sites": %s}`, directorSitesJSON)
+			case "/vdcs":
+				fmt.Fprintf(res, `{"vdcs": %s}`, vdcsJSON)
+			case "/director_site_regions":
+				fmt.Fprint(res, `{"director_site_regions": []}`)
+			case "/multitenant_director_sites":
+				fmt.Fprint(res, `{"multitenant_director_sites": []}`)
+			case "/director_site_host_profiles":
+				fmt.Fprintf(res, `{"director_site_host_profiles": %s}`, hostProfilesJSON)
+			default:
+				Fail("unexpected request " + req.URL.Path)
+			}
+		}))
+		defer testServer.Close()
+		vmwareService, serviceErr := vmwarev1.NewVmwareV1(&vmwarev1.VmwareV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+
+		report, err := vmwareService.GetUtilizationReport(nil)
+		Expect(err).To(BeNil())
+		Expect(report.DirectorSites[0].AvailableCpu).To(Equal(int64(32)))
+
+		options := vmwareService.NewGetUtilizationReportOptions().SetEdgeOverheads(map[string]vmwarev1.EdgeOverhead{})
+		report, err = vmwareService.GetUtilizationReport(options)
+		Expect(err).To(BeNil())
+		Expect(report.DirectorSites[0].EdgeCpu).To(BeZero())
+	})
+})
@@ -0,0 +1,330 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vmwarev1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vmware-go-sdk/common"
+)
+
+// CreateVdcWithHealing : Create a virtual data center, scaling its cluster when capacity is insufficient
+// Create a virtual data center and wait until it is ready to use. If it fails because the resource pool lacks CPU or
+// RAM, add the hosts that the requested capacity needs to a cluster of the resource pool, without exceeding the
+// configured ceiling, wait for the cluster, delete the failed virtual data center and create it again with the same
+// options.
+func (vmware *VmwareV1) CreateVdcWithHealing(createVdcWithHealingOptions *CreateVdcWithHealingOptions) (result *VdcHealingResult, err error) {
+	result, err = vmware.CreateVdcWithHealingWithContext(context.Background(), createVdcWithHealingOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateVdcWithHealingWithContext is an alternate form of the CreateVdcWithHealing method which supports a Context parameter
+func (vmware *VmwareV1) CreateVdcWithHealingWithContext(ctx context.Context, createVdcWithHealingOptions *CreateVdcWithHealingOptions) (result *VdcHealingResult, err error) {
+	err = core.ValidateNotNil(createVdcWithHealingOptions, "createVdcWithHealingOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(createVdcWithHealingOptions, "createVdcWithHealingOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	options := createVdcWithHealingOptions
+	maxHealings := int64(1)
+	if options.MaxHealings != nil {
+		maxHealings = *options.MaxHealings
+	}
+
+	result = &VdcHealingResult{}
+	for {
+		var operation *Operation
+		operation, _, err = vmware.StartCreateVdcWithContext(ctx, options.CreateVdcOptions)
+		if err != nil {
+			return
+		}
+		operation.WaitOptions = options.WaitOptions
+		err = operation.Wait(ctx)
+		result.Vdc, _ = operation.Result().(*VDC)
+		if err == nil || operation.Status() != Operation_Status_Failed || result.Vdc == nil {
+			return
+		}
+
+		reasons := insufficientCapacityReasons(result.Vdc)
+		if len(reasons) == 0 {
+			return
+		}
+		if int64(len(result.Healings)) >= maxHealings {
+			err = core.SDKErrorf(err, fmt.Sprintf("virtual data center '%s' still lacks capacity after %d healing attempts: %s",
+				stringValue(result.Vdc.ID), len(result.Healings), err.Error()), "vdc-healing-exhausted", common.GetComponentInfo())
+			return
+		}
+
+		var healing *VdcHealing
+		healing, err = vmware.healVdcCapacity(ctx, options, result.Vdc, reasons)
+		if healing != nil {
+			result.Healings = append(result.Healings, *healing)
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// insufficientCapacityReasons returns the codes of the status reasons of "vdc" that more hosts can fix.
+func insufficientCapacityReasons(vdc *VDC) (reasons []string) {
+	for _, reason := range vdc.StatusReasons {
+		switch code := stringValue(reason.Code); code {
+		case StatusReason_Code_InsufficentCpu, StatusReason_Code_InsufficentRam, StatusReason_Code_InsufficentCpuAndRam:
+			reasons = append(reasons, code)
+		}
+	}
+	return
+}
+
+// healVdcCapacity scales a cluster of the resource pool of the failed virtual data center "vdc", then deletes it.
+func (vmware *VmwareV1) healVdcCapacity(ctx context.Context, options *CreateVdcWithHealingOptions, vdc *VDC, reasons []string) (healing *VdcHealing, err error) {
+	createVdcOptions := options.CreateVdcOptions
+	siteID := stringValue(createVdcOptions.DirectorSite.ID)
+	pvdcID := ""
+	if createVdcOptions.DirectorSite.Pvdc != nil {
+		pvdcID = stringValue(createVdcOptions.DirectorSite.Pvdc.ID)
+	}
+
+	cluster, err := vmware.getClusterForHealing(ctx, options, siteID, pvdcID)
+	if err != nil {
+		return
+	}
+	profile, err := vmware.getHostProfileForHealing(ctx, options, stringValue(cluster.HostProfile))
+	if err != nil {
+		return
+	}
+
+	healing = &VdcHealing{
+		FailedVdcID:       stringValue(vdc.ID),
+		Reasons:           reasons,
+		ClusterID:         stringValue(cluster.ID),
+		HostProfile:       stringValue(profile.ID),
+		PreviousHostCount: *cluster.HostCount,
+	}
+	increment := hostIncrement(createVdcOptions, profile, reasons)
+	healing.HostCount = healing.PreviousHostCount + increment
+	if healing.HostCount > *options.MaxHostCount {
+		healing.HostCount = *options.MaxHostCount
+	}
+	if healing.HostCount <= healing.PreviousHostCount {
+		err = core.SDKErrorf(nil, fmt.Sprintf("cluster '%s' needs %d more hosts but already has %d hosts, the maximum",
+			healing.ClusterID, increment, healing.PreviousHostCount), "vdc-healing-ceiling", common.GetComponentInfo())
+		return
+	}
+
+	patch, err := (&ClusterPatch{HostCount: core.Int64Ptr(healing.HostCount)}).AsPatch()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "cluster-patch-error", common.GetComponentInfo())
+		return
+	}
+	updateClusterOptions := vmware.NewUpdateDirectorSitesPvdcsClusterOptions(siteID, healing.ClusterID, pvdcID, patch)
+	updateClusterOptions.AcceptLanguage = createVdcOptions.AcceptLanguage
+	updateClusterOptions.Headers = createVdcOptions.Headers
+	operation, _, err := vmware.StartUpdateDirectorSitesPvdcsClusterWithContext(ctx, updateClusterOptions)
+	if err != nil {
+		return
+	}
+	operation.WaitOptions = options.WaitOptions
+	err = operation.Wait(ctx)
+	if err != nil {
+		return
+	}
+
+	deleteVdcOptions := vmware.NewDeleteVdcOptions(healing.FailedVdcID)
+	deleteVdcOptions.AcceptLanguage = createVdcOptions.AcceptLanguage
+	deleteVdcOptions.Headers = createVdcOptions.Headers
+	operation, _, err = vmware.StartDeleteVdcWithContext(ctx, deleteVdcOptions)
+	if err != nil {
+		return
+	}
+	operation.WaitOptions = options.WaitOptions
+	err = operation.Wait(ctx)
+	return
+}
+
+// hostIncrement returns the number of hosts of "profile" that provide all the CPU or RAM that the virtual data center
+// reserves, as if the resource pool had none to spare, or one host when the virtual data center does not reserve a
+// fixed amount.
+func hostIncrement(createVdcOptions *CreateVdcOptions, profile *DirectorSiteHostProfile, reasons []string) int64 {
+	lacksCpu, lacksRam := false, false
+	for _, reason := range reasons {
+		lacksCpu = lacksCpu || reason == StatusReason_Code_InsufficentCpu || reason == StatusReason_Code_InsufficentCpuAndRam
+		lacksRam = lacksRam || reason == StatusReason_Code_InsufficentRam || reason == StatusReason_Code_InsufficentCpuAndRam
+	}
+	increment := int64(1)
+	if lacksCpu && createVdcOptions.Cpu != nil && profile.Cpu != nil && *profile.Cpu > 0 {
+		if hosts := ceilDiv(*createVdcOptions.Cpu, *profile.Cpu); hosts > increment {
+			increment = hosts
+		}
+	}
+	if lacksRam && createVdcOptions.Ram != nil && profile.Ram != nil && *profile.Ram > 0 {
+		if hosts := ceilDiv(*createVdcOptions.Ram, *profile.Ram); hosts > increment {
+			increment = hosts
+		}
+	}
+	return increment
+}
+
+func ceilDiv(a int64, b int64) int64 {
+	return (a + b - 1) / b
+}
+
+// getClusterForHealing returns the cluster to scale: the configured cluster, or the first cluster of the resource
+// pool that is ready to use.
+func (vmware *VmwareV1) getClusterForHealing(ctx context.Context, options *CreateVdcWithHealingOptions, siteID string, pvdcID string) (cluster *ClusterSummary, err error) {
+	getPvdcOptions := vmware.NewGetDirectorSitesPvdcsOptions(siteID, pvdcID)
+	getPvdcOptions.AcceptLanguage = options.CreateVdcOptions.AcceptLanguage
+	getPvdcOptions.Headers = options.CreateVdcOptions.Headers
+	pvdc, _, err := vmware.GetDirectorSitesPvdcsWithContext(ctx, getPvdcOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-pvdc-error")
+		return
+	}
+	for i := range pvdc.Clusters {
+		candidate := &pvdc.Clusters[i]
+		if candidate.HostCount == nil {
+			continue
+		}
+		if options.ClusterID != nil {
+			if stringValue(candidate.ID) == *options.ClusterID {
+				return candidate, nil
+			}
+		} else if stringValue(candidate.Status) == "ready_to_use" {
+			return candidate, nil
+		}
+	}
+	description := "no cluster that is ready to use"
+	if options.ClusterID != nil {
+		description = fmt.Sprintf("no cluster '%s'", *options.ClusterID)
+	}
+	err = core.SDKErrorf(nil, fmt.Sprintf("resource pool '%s' of Cloud Director site '%s' has %s", pvdcID, siteID, description),
+		"vdc-healing-cluster-not-found", common.GetComponentInfo())
+	return
+}
+
+// getHostProfileForHealing returns the host profile with ID "id".
+func (vmware *VmwareV1) getHostProfileForHealing(ctx context.Context, options *CreateVdcWithHealingOptions, id string) (profile *DirectorSiteHostProfile, err error) {
+	listProfilesOptions := vmware.NewListDirectorSiteHostProfilesOptions()
+	listProfilesOptions.AcceptLanguage = options.CreateVdcOptions.AcceptLanguage
+	listProfilesOptions.Headers = options.CreateVdcOptions.Headers
+	profiles, _, err := vmware.ListDirectorSiteHostProfilesWithContext(ctx, listProfilesOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-host-profiles-error")
+		return
+	}
+	for i := range profiles.DirectorSiteHostProfiles {
+		if stringValue(profiles.DirectorSiteHostProfiles[i].ID) == id {
+			return &profiles.DirectorSiteHostProfiles[i], nil
+		}
+	}
+	err = core.SDKErrorf(nil, fmt.Sprintf("host profile '%s' was not found", id), "host-profile-not-found", common.GetComponentInfo())
+	return
+}
+
+// CreateVdcWithHealingOptions : The CreateVdcWithHealing options.
+type CreateVdcWithHealingOptions struct {
+	// The options of the virtual data center to create. Its language and headers apply to every request.
+	CreateVdcOptions *CreateVdcOptions `json:"create_vdc_options" validate:"required"`
+
+	// The maximum host count of the scaled cluster.
+	MaxHostCount *int64 `json:"max_host_count" validate:"required,gt=0"`
+
+	// The cluster of the resource pool to scale. If nil, the first cluster that is ready to use is scaled.
+	ClusterID *string `json:"cluster_id,omitempty"`
+
+	// The maximum number of times the cluster is scaled and the virtual data center created again. If nil, 1.
+	MaxHealings *int64 `json:"max_healings,omitempty"`
+
+	// Controls how long to wait for the virtual data center, the cluster and the deletion of the failed virtual data
+	// center.
+	WaitOptions *WaitOptions `json:"-"`
+}
+
+// NewCreateVdcWithHealingOptions : Instantiate CreateVdcWithHealingOptions
+func (*VmwareV1) NewCreateVdcWithHealingOptions(createVdcOptions *CreateVdcOptions, maxHostCount int64) *CreateVdcWithHealingOptions {
+	return &CreateVdcWithHealingOptions{
+		CreateVdcOptions: createVdcOptions,
+		MaxHostCount:     core.Int64Ptr(maxHostCount),
+	}
+}
+
+// SetCreateVdcOptions : Allow user to set CreateVdcOptions
+func (_options *CreateVdcWithHealingOptions) SetCreateVdcOptions(createVdcOptions *CreateVdcOptions) *CreateVdcWithHealingOptions {
+	_options.CreateVdcOptions = createVdcOptions
+	return _options
+}
+
+// SetMaxHostCount : Allow user to set MaxHostCount
+func (_options *CreateVdcWithHealingOptions) SetMaxHostCount(maxHostCount int64) *CreateVdcWithHealingOptions {
+	_options.MaxHostCount = core.Int64Ptr(maxHostCount)
+	return _options
+}
+
+// SetClusterID : Allow user to set ClusterID
+func (_options *CreateVdcWithHealingOptions) SetClusterID(clusterID string) *CreateVdcWithHealingOptions {
+	_options.ClusterID = core.StringPtr(clusterID)
+	return _options
+}
+
+// SetMaxHealings : Allow user to set MaxHealings
+func (_options *CreateVdcWithHealingOptions) SetMaxHealings(maxHealings int64) *CreateVdcWithHealingOptions {
+	_options.MaxHealings = core.Int64Ptr(maxHealings)
+	return _options
+}
+
+// SetWaitOptions : Allow user to set WaitOptions
+func (_options *CreateVdcWithHealingOptions) SetWaitOptions(waitOptions *WaitOptions) *CreateVdcWithHealingOptions {
+	_options.WaitOptions = waitOptions
+	return _options
+}
+
+// VdcHealingResult : The outcome of CreateVdcWithHealing.
+type VdcHealingResult struct {
+	// The virtual data center as last observed: ready to use, or failed when the healing did not succeed.
+	Vdc *VDC `json:"vdc,omitempty"`
+
+	// The healings, in order.
+	Healings []VdcHealing `json:"healings"`
+}
+
+// VdcHealing : A cluster scaled for a virtual data center that lacked capacity.
+type VdcHealing struct {
+	// The ID of the failed virtual data center, which was deleted once the cluster was scaled.
+	FailedVdcID string `json:"failed_vdc_id"`
+
+	// The status reasons of the failed virtual data center, such as "insufficent_cpu".
+	Reasons []string `json:"reasons"`
+
+	// The ID of the scaled cluster.
+	ClusterID string `json:"cluster_id"`
+
+	// The host profile of the cluster.
+	HostProfile string `json:"host_profile"`
+
+	// The host count of the cluster before and after it was scaled.
+	PreviousHostCount int64 `json:"previous_host_count"`
+	HostCount         int64 `json:"host_count"`
+}
@@ -0,0 +1,185 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package vmwarev1_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vmware-go-sdk/vmwarev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CreateVdcWithHealing`, func() {
+	var (
+		testServer    *httptest.Server
+		vmwareService *vmwarev1.VmwareV1
+		waitOptions   *vmwarev1.WaitOptions
+
+		mutex sync.Mutex
+		// The status reasons of the VDCs that fail, in order of creation; the others become ready to use.
+		failures  []string
+		created   int
+		deleted   map[string]bool
+		hostCount int
+		received  []string
+	)
+
+	healingOptions := func(cpu int64, maxHostCount int64) *vmwarev1.CreateVdcWithHealingOptions {
+		directorSite := &vmwarev1.VDCDirectorSitePrototype{ID: core.StringPtr("site1"), Pvdc: &vmwarev1.DirectorSitePVDC{ID: core.StringPtr("pvdc1")}}
+		createVdcOptions := vmwareService.NewCreateVdcOptions("prod", directorSite).SetCpu(cpu).SetRam(256)
+		return vmwareService.NewCreateVdcWithHealingOptions(createVdcOptions, maxHostCount).SetWaitOptions(waitOptions)
+	}
+
+	BeforeEach(func() {
+		failures = nil
+		created = 0
+		deleted = map[string]bool{}
+		hostCount = 2
+		received = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			mutex.Lock()
+			defer mutex.Unlock()
+			res.Header().Set("Content-type", "application/json")
+			path := req.URL.Path
+			if req.Method != http.MethodGet {
+				received = append(received, req.Method+" "+path)
+			}
+			vdcJSON := func(id string) string {
+				n := 0
+				fmt.Sscanf(id, "vdc%d", &n)
+				status, reasons := "ready_to_use", ""
+				if n <= len(failures) {
+					status, reasons = "failed", fmt.Sprintf(`{"code": "%s", "message": "Not enough capacity."}`, failures[n-1])
+				}
+				return fmt.Sprintf(`{"id": "%s", "name": "prod", "cpu": 100, "ram": 256, "director_site": {"id": "site1", "pvdc": {"id": "pvdc1"}, "url": "URL"}, "status": "%s", "status_reasons": [%s]}`,
+					id, status, reasons)
+			}
+			switch {
+			case req.Method == http.MethodPost && path == "/vdcs":
+				created++
+				res.WriteHeader(202)
+				fmt.Fprint(res, vdcJSON(fmt.Sprintf("vdc%d", created)))
+			case strings.HasPrefix(path, "/vdcs/"):
+				id := strings.TrimPrefix(path, "/vdcs/")
+				if req.Method == http.MethodDelete {
+					deleted[id] = true
+					res.WriteHeader(202)
+				} else if deleted[id] {
+					res.WriteHeader(404)
+					fmt.Fprint(res, `{"errors": [{"code": "not_found", "message": "Not found"}]}`)
+					return
+				} else {
+					res.WriteHeader(200)
+				}
+				fmt.Fprint(res, vdcJSON(id))
+			case path == "/director_sites/site1/pvdcs/pvdc1":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"id": "pvdc1", "name": "pvdc1", "status": "ready_to_use", "clusters": [
+					{"id": "cluster0", "name": "cluster0", "host_count": 2, "host_profile": "BM_2S_20_CORES_192_GB", "status": "creating"},
+					{"id": "cluster1", "name": "cluster1", "host_count": %d, "host_profile": "BM_2S_20_CORES_192_GB", "status": "ready_to_use"}]}`, hostCount)
+			case path == "/director_site_host_profiles":
+				res.WriteHeader(200)
+				fmt.Fprint(res, `{"director_site_host_profiles": [{"id": "BM_2S_20_CORES_192_GB", "cpu": 40, "ram": 192}]}`)
+			case path == "/director_sites/site1/pvdcs/pvdc1/clusters/cluster1":
+				if req.Method == http.MethodPatch {
+					var patch map[string]interface{}
+					Expect(json.NewDecoder(req.Body).Decode(&patch)).To(Succeed())
+					hostCount = int(patch["host_count"].(float64))
+				}
+				res.WriteHeader(200)
+				fmt.Fprint(res, operationClusterJSON("ready_to_use", hostCount))
+			default:
+				Fail(fmt.Sprintf("unexpected request %s %s", req.Method, path))
+			}
+		}))
+		var serviceErr error
+		vmwareService, serviceErr = vmwarev1.NewVmwareV1(&vmwarev1.VmwareV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		waitOptions = vmwareService.NewWaitOptions(time.Millisecond, 5*time.Second)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Scales the cluster for the missing capacity and creates the VDC again`, func() {
+		failures = []string{vmwarev1.StatusReason_Code_InsufficentCpu}
+
+		result, err := vmwareService.CreateVdcWithHealing(healingOptions(100, 10))
+		Expect(err).To(BeNil())
+		Expect(*result.Vdc.ID).To(Equal("vdc2"))
+		Expect(*result.Vdc.Status).To(Equal("ready_to_use"))
+		// 100 vCPUs need 3 hosts of 40 cores.
+		Expect(result.Healings).To(Equal([]vmwarev1.VdcHealing{{
+			FailedVdcID:       "vdc1",
+			Reasons:           []string{"insufficent_cpu"},
+			ClusterID:         "cluster1",
+			HostProfile:       "BM_2S_20_CORES_192_GB",
+			PreviousHostCount: 2,
+			HostCount:         5,
+		}}))
+		Expect(received).To(Equal([]string{
+			"POST /vdcs",
+			"PATCH /director_sites/site1/pvdcs/pvdc1/clusters/cluster1",
+			"DELETE /vdcs/vdc1",
+			"POST /vdcs",
+		}))
+	})
+
+	It(`Stays within the ceiling and the number of healings`, func() {
+		failures = []string{vmwarev1.StatusReason_Code_InsufficentCpuAndRam, vmwarev1.StatusReason_Code_InsufficentRam, vmwarev1.StatusReason_Code_InsufficentRam}
+
+		options := healingOptions(100, 4).SetClusterID("cluster1")
+		result, err := vmwareService.CreateVdcWithHealing(options)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("after 1 healing attempts"))
+		Expect(result.Healings).To(HaveLen(1))
+		Expect(result.Healings[0].HostCount).To(Equal(int64(4)))
+		Expect(*result.Vdc.ID).To(Equal("vdc2"))
+
+		// The cluster is at the ceiling.
+		_, err = vmwareService.CreateVdcWithHealing(options.SetMaxHealings(2))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("already has 4 hosts, the maximum"))
+		Expect(hostCount).To(Equal(4))
+	})
+
+	It(`Does not heal the failures that more hosts cannot fix`, func() {
+		failures = []string{"unknown_error", vmwarev1.StatusReason_Code_InsufficentRam}
+
+		result, err := vmwareService.CreateVdcWithHealing(healingOptions(100, 10))
+		Expect(err).ToNot(BeNil())
+		Expect(result.Healings).To(BeEmpty())
+		Expect(*result.Vdc.Status).To(Equal("failed"))
+		Expect(received).To(Equal([]string{"POST /vdcs"}))
+
+		_, err = vmwareService.CreateVdcWithHealing(healingOptions(100, 10).SetClusterID("cluster9"))
+		Expect(err).ToNot(BeNil())
+		_, err = vmwareService.CreateVdcWithHealing(nil)
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,396 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vmwarev1
+
+// This file contains the backup-focused helpers built on top of the Veeam service of Cloud Director sites.
+//
+// Version 1.2.0 of the API reports the scale-out backup repositories (SOBRs) of a Veeam service instance through
+// Service.Sobrs but does not offer endpoints to create, update or delete them. Repositories are managed from the Veeam
+// console (Service.ConsoleURL), so the helpers below only report on them.
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vmware-go-sdk/common"
+)
+
+// ListSobrs : List the scale-out backup repositories of all Cloud Director sites
+// List the scale-out backup repositories of the Veeam service instances of every Cloud Director site in the account.
+func (vmware *VmwareV1) ListSobrs(listSobrsOptions *ListSobrsOptions) (result *SobrInventory, err error) {
+	result, err = vmware.ListSobrsWithContext(context.Background(), listSobrsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ListSobrsWithContext is an alternate form of the ListSobrs method which supports a Context parameter
+func (vmware *VmwareV1) ListSobrsWithContext(ctx context.Context, listSobrsOptions *ListSobrsOptions) (result *SobrInventory, err error) {
+	listDirectorSitesOptions := vmware.NewListDirectorSitesOptions()
+	if listSobrsOptions != nil {
+		listDirectorSitesOptions.AcceptLanguage = listSobrsOptions.AcceptLanguage
+		listDirectorSitesOptions.XGlobalTransactionID = listSobrsOptions.XGlobalTransactionID
+		listDirectorSitesOptions.Headers = listSobrsOptions.Headers
+	}
+
+	directorSites, _, err := vmware.ListDirectorSitesWithContext(ctx, listDirectorSitesOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-director-sites-error")
+		return
+	}
+
+	result = NewSobrInventory(directorSites.DirectorSites)
+	return
+}
+
+// NewSobrInventory builds the inventory of the scale-out backup repositories of the Veeam service instances of
+// "directorSites".
+func NewSobrInventory(directorSites []DirectorSite) *SobrInventory {
+	inventory := &SobrInventory{
+		Sobrs: []SobrEntry{},
+	}
+	for _, directorSite := range directorSites {
+		for _, service := range directorSite.Services {
+			if service.Name == nil || *service.Name != Service_Name_Veeam {
+				continue
+			}
+			for _, sobr := range service.Sobrs {
+				inventory.Sobrs = append(inventory.Sobrs, SobrEntry{
+					DirectorSiteID:   stringValue(directorSite.ID),
+					DirectorSiteName: stringValue(directorSite.Name),
+					ServiceID:        stringValue(service.ID),
+					ServiceStatus:    stringValue(service.Status),
+					Sobr:             sobr,
+				})
+			}
+		}
+	}
+	return inventory
+}
+
+// SobrInventory : The scale-out backup repositories of the Veeam service instances of several Cloud Director sites.
+type SobrInventory struct {
+	// The scale-out backup repositories, in the order of their Cloud Director sites.
+	Sobrs []SobrEntry `json:"sobrs"`
+}
+
+// SobrEntry : A scale-out backup repository together with the Veeam service instance that owns it.
+type SobrEntry struct {
+	// ID of the Cloud Director site.
+	DirectorSiteID string `json:"director_site_id"`
+
+	// The name of the Cloud Director site.
+	DirectorSiteName string `json:"director_site_name"`
+
+	// A unique ID for the Veeam service instance.
+	ServiceID string `json:"service_id"`
+
+	// The status of the Veeam service instance.
+	ServiceStatus string `json:"service_status"`
+
+	// The scale-out backup repository.
+	Sobr Sobr `json:"sobr"`
+}
+
+// SobrCapacity : The number and the total size of a group of scale-out backup repositories.
+type SobrCapacity struct {
+	// The number of scale-out backup repositories.
+	Count int `json:"count"`
+
+	// The sum of the sizes of the scale-out backup repositories.
+	Size int64 `json:"size"`
+}
+
+func (capacity *SobrCapacity) add(sobr *Sobr) {
+	capacity.Count++
+	if sobr.Size != nil {
+		capacity.Size += *sobr.Size
+	}
+}
+
+// SobrCapacitySummary : The capacity of scale-out backup repositories per storage type and per data center.
+type SobrCapacitySummary struct {
+	// The capacity of all scale-out backup repositories.
+	Total SobrCapacity `json:"total"`
+
+	// The capacity per storage type ("cos", "hybrid" or "vsan").
+	ByStorageType map[string]SobrCapacity `json:"by_storage_type"`
+
+	// The capacity per data center. Repositories without a data center are grouped under the empty name.
+	ByDataCenter map[string]SobrCapacity `json:"by_data_center"`
+
+	// The capacity per Cloud Director site ID.
+	ByDirectorSite map[string]SobrCapacity `json:"by_director_site"`
+}
+
+// Summarize returns the capacity of the scale-out backup repositories per storage type, data center and Cloud
+// Director site. Deleted repositories are ignored.
+func (inventory *SobrInventory) Summarize() *SobrCapacitySummary {
+	summary := &SobrCapacitySummary{
+		ByStorageType:  map[string]SobrCapacity{},
+		ByDataCenter:   map[string]SobrCapacity{},
+		ByDirectorSite: map[string]SobrCapacity{},
+	}
+	for i := range inventory.Sobrs {
+		entry := &inventory.Sobrs[i]
+		sobr := &entry.Sobr
+		if sobr.Status != nil && *sobr.Status == Sobr_Status_Deleted {
+			continue
+		}
+		summary.Total.add(sobr)
+
+		byStorageType := summary.ByStorageType[stringValue(sobr.StorageType)]
+		byStorageType.add(sobr)
+		summary.ByStorageType[stringValue(sobr.StorageType)] = byStorageType
+
+		byDataCenter := summary.ByDataCenter[stringValue(sobr.DataCenter)]
+		byDataCenter.add(sobr)
+		summary.ByDataCenter[stringValue(sobr.DataCenter)] = byDataCenter
+
+		byDirectorSite := summary.ByDirectorSite[entry.DirectorSiteID]
+		byDirectorSite.add(sobr)
+		summary.ByDirectorSite[entry.DirectorSiteID] = byDirectorSite
+	}
+	return summary
+}
+
+// WithoutImmutability returns the scale-out backup repositories that have no immutability time configured, which
+// leaves their backup files open to deletion or encryption by an attacker. Deleted repositories are ignored.
+func (inventory *SobrInventory) WithoutImmutability() []SobrEntry {
+	entries := []SobrEntry{}
+	for _, entry := range inventory.Sobrs {
+		if entry.Sobr.Status != nil && *entry.Sobr.Status == Sobr_Status_Deleted {
+			continue
+		}
+		if entry.Sobr.ImmutabilityTime == nil || *entry.Sobr.ImmutabilityTime <= 0 {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// DataCenters returns the sorted names of the data centers that host at least one scale-out backup repository.
+func (inventory *SobrInventory) DataCenters() []string {
+	seen := map[string]bool{}
+	dataCenters := []string{}
+	for _, entry := range inventory.Sobrs {
+		name := stringValue(entry.Sobr.DataCenter)
+		if name != "" && !seen[name] {
+			seen[name] = true
+			dataCenters = append(dataCenters, name)
+		}
+	}
+	sort.Strings(dataCenters)
+	return dataCenters
+}
+
+// ListSobrsOptions : The ListSobrs options.
+type ListSobrsOptions struct {
+	// Language.
+	AcceptLanguage *string `json:"Accept-Language,omitempty"`
+
+	// Transaction ID.
+	XGlobalTransactionID *string `json:"X-Global-Transaction-ID,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewListSobrsOptions : Instantiate ListSobrsOptions
+func (*VmwareV1) NewListSobrsOptions() *ListSobrsOptions {
+	return &ListSobrsOptions{}
+}
+
+// SetAcceptLanguage : Allow user to set AcceptLanguage
+func (_options *ListSobrsOptions) SetAcceptLanguage(acceptLanguage string) *ListSobrsOptions {
+	_options.AcceptLanguage = core.StringPtr(acceptLanguage)
+	return _options
+}
+
+// SetXGlobalTransactionID : Allow user to set XGlobalTransactionID
+func (_options *ListSobrsOptions) SetXGlobalTransactionID(xGlobalTransactionID string) *ListSobrsOptions {
+	_options.XGlobalTransactionID = core.StringPtr(xGlobalTransactionID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *ListSobrsOptions) SetHeaders(param map[string]string) *ListSobrsOptions {
+	options.Headers = param
+	return options
+}
+
+// EnableVeeam : Enable Veeam on a Cloud Director site and wait for it
+// Enable the Veeam service on a Cloud Director site, then wait until the Veeam service instance is ready to use. When
+// the service is already ready to use, no request to enable it is sent. The wait fails when the service instance is
+// deleted, or disappears after it appeared.
+func (vmware *VmwareV1) EnableVeeam(enableVeeamOptions *EnableVeeamOptions) (result *Service, err error) {
+	result, err = vmware.EnableVeeamWithContext(context.Background(), enableVeeamOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// EnableVeeamWithContext is an alternate form of the EnableVeeam method which supports a Context parameter
+func (vmware *VmwareV1) EnableVeeamWithContext(ctx context.Context, enableVeeamOptions *EnableVeeamOptions) (result *Service, err error) {
+	err = core.ValidateNotNil(enableVeeamOptions, "enableVeeamOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(enableVeeamOptions, "enableVeeamOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	getVeeamService := func(ctx context.Context) (*Service, error) {
+		getDirectorSiteOptions := vmware.NewGetDirectorSiteOptions(*enableVeeamOptions.SiteID)
+		getDirectorSiteOptions.AcceptLanguage = enableVeeamOptions.AcceptLanguage
+		getDirectorSiteOptions.XGlobalTransactionID = enableVeeamOptions.XGlobalTransactionID
+		getDirectorSiteOptions.Headers = enableVeeamOptions.Headers
+		directorSite, _, err := vmware.GetDirectorSiteWithContext(ctx, getDirectorSiteOptions)
+		if err != nil {
+			return nil, core.RepurposeSDKProblem(err, "get-director-site-error")
+		}
+		return findService(directorSite, Service_Name_Veeam), nil
+	}
+
+	result, err = getVeeamService(ctx)
+	if err != nil {
+		return
+	}
+	if result != nil && result.Status != nil && *result.Status == Service_Status_ReadyToUse {
+		return
+	}
+	// A service instance left deleted by an earlier disablement is replaced, not a sign that the enablement failed.
+	staleID := ""
+	if result != nil && stringValue(result.Status) == Service_Status_Deleted {
+		staleID = stringValue(result.ID)
+	}
+
+	enableVeeamOnPvdcsListOptions := vmware.NewEnableVeeamOnPvdcsListOptions(*enableVeeamOptions.SiteID, true)
+	enableVeeamOnPvdcsListOptions.AcceptLanguage = enableVeeamOptions.AcceptLanguage
+	enableVeeamOnPvdcsListOptions.XGlobalTransactionID = enableVeeamOptions.XGlobalTransactionID
+	enableVeeamOnPvdcsListOptions.Headers = enableVeeamOptions.Headers
+	_, _, err = vmware.EnableVeeamOnPvdcsListWithContext(ctx, enableVeeamOnPvdcsListOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "enable-veeam-error")
+		return
+	}
+
+	description := fmt.Sprintf("the Veeam service of Cloud Director site '%s' to be ready to use", *enableVeeamOptions.SiteID)
+	seen := false
+	err = waitUntil(ctx, enableVeeamOptions.WaitOptions, description, func(ctx context.Context) (bool, error) {
+		service, err := getVeeamService(ctx)
+		if err != nil {
+			return false, err
+		}
+		if service == nil {
+			if seen {
+				message := fmt.Sprintf("the Veeam service of Cloud Director site '%s' disappeared", *enableVeeamOptions.SiteID)
+				return false, core.SDKErrorf(nil, message, "veeam-service-missing", common.GetComponentInfo())
+			}
+			return false, nil
+		}
+		result = service
+		switch stringValue(service.Status) {
+		case Service_Status_ReadyToUse:
+			return true, nil
+		case Service_Status_Deleted:
+			if stringValue(service.ID) != staleID {
+				message := fmt.Sprintf("the Veeam service of Cloud Director site '%s' was deleted", *enableVeeamOptions.SiteID)
+				return false, core.SDKErrorf(nil, message, "veeam-service-deleted", common.GetComponentInfo())
+			}
+		default:
+			seen = true
+		}
+		return false, nil
+	})
+	return
+}
+
+// EnableVeeamOptions : The EnableVeeam options.
+type EnableVeeamOptions struct {
+	// A unique ID for the Cloud Director site in which the virtual data center was created.
+	SiteID *string `json:"site_id" validate:"required,ne="`
+
+	// Controls how long to wait for the Veeam service instance to be ready to use.
+	WaitOptions *WaitOptions `json:"-"`
+
+	// Language.
+	AcceptLanguage *string `json:"Accept-Language,omitempty"`
+
+	// Transaction ID.
+	XGlobalTransactionID *string `json:"X-Global-Transaction-ID,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewEnableVeeamOptions : Instantiate EnableVeeamOptions
+func (*VmwareV1) NewEnableVeeamOptions(siteID string) *EnableVeeamOptions {
+	return &EnableVeeamOptions{
+		SiteID: core.StringPtr(siteID),
+	}
+}
+
+// SetSiteID : Allow user to set SiteID
+func (_options *EnableVeeamOptions) SetSiteID(siteID string) *EnableVeeamOptions {
+	_options.SiteID = core.StringPtr(siteID)
+	return _options
+}
+
+// SetWaitOptions : Allow user to set WaitOptions
+func (_options *EnableVeeamOptions) SetWaitOptions(waitOptions *WaitOptions) *EnableVeeamOptions {
+	_options.WaitOptions = waitOptions
+	return _options
+}
+
+// SetAcceptLanguage : Allow user to set AcceptLanguage
+func (_options *EnableVeeamOptions) SetAcceptLanguage(acceptLanguage string) *EnableVeeamOptions {
+	_options.AcceptLanguage = core.StringPtr(acceptLanguage)
+	return _options
+}
+
+// SetXGlobalTransactionID : Allow user to set XGlobalTransactionID
+func (_options *EnableVeeamOptions) SetXGlobalTransactionID(xGlobalTransactionID string) *EnableVeeamOptions {
+	_options.XGlobalTransactionID = core.StringPtr(xGlobalTransactionID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *EnableVeeamOptions) SetHeaders(param map[string]string) *EnableVeeamOptions {
+	options.Headers = param
+	return options
+}
+
+// findService returns the service named "name" of "directorSite", or nil.
+func findService(directorSite *DirectorSite, name string) *Service {
+	for i := range directorSite.Services {
+		if directorSite.Services[i].Name != nil && *directorSite.Services[i].Name == name {
+			return &directorSite.Services[i]
+		}
+	}
+	return nil
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,238 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vmwarev1_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vmware-go-sdk/vmwarev1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func directorSiteJSON(id string, services string) string {
+	return fmt.Sprintf(`{"crn": "Crn", "href": "Href", "id": "%s", "ordered_at": "2019-01-01T12:00:00.000Z", "name": "name-%s", "status": "ready_to_use", "resource_group": {"id": "rg_id", "name": "Default", "crn": "Crn"}, "pvdcs": [], "type": "single_tenant", "services": [%s], "console_connection_type": "private", "console_connection_status": "ready_to_use", "ip_allow_list": []}`, id, id, services)
+}
+
+func veeamServiceJSON(status string, sobrs string) string {
+	return fmt.Sprintf(`{"name": "veeam", "id": "veeam_id", "ordered_at": "2019-01-01T12:00:00.000Z", "status": "%s", "console_url": "ConsoleURL", "connections": [], "sobrs": [%s]}`, status, sobrs)
+}
+
+var _ = Describe(`Veeam helpers`, func() {
+	var testServer *httptest.Server
+
+	Describe(`ListSobrs(listSobrsOptions *ListSobrsOptions)`, func() {
+		BeforeEach(func() {
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.EscapedPath()).To(Equal("/director_sites"))
+				Expect(req.Method).To(Equal("GET"))
+				Expect(req.Header["X-Global-Transaction-Id"][0]).To(Equal("transaction1"))
+
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"director_sites": [%s, %s, %s]}`,
+					directorSiteJSON("site1", veeamServiceJSON("ready_to_use", `
+						{"id": "s1", "name": "cos-repo", "size": 100, "data_center": "dal10", "immutability_time": 7, "storage_type": "cos", "type": "default", "status": "ready_to_use"},
+						{"id": "s2", "name": "vsan-repo", "size": 50, "data_center": "dal10", "storage_type": "vsan", "type": "custom", "status": "ready_to_use"}`)),
+					directorSiteJSON("site2", veeamServiceJSON("ready_to_use", `
+						{"id": "s3", "name": "hybrid-repo", "size": 25, "data_center": "dal12", "immutability_time": 0, "storage_type": "hybrid", "type": "custom", "status": "ready_to_use"},
+						{"id": "s4", "name": "old-repo", "size": 1000, "data_center": "dal12", "storage_type": "cos", "type": "custom", "status": "deleted"}`)),
+					directorSiteJSON("site3", ""))
+			}))
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+		It(`Lists the repositories of every site and summarizes their capacity`, func() {
+			vmwareService, serviceErr := vmwarev1.NewVmwareV1(&vmwarev1.VmwareV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			listSobrsOptions := vmwareService.NewListSobrsOptions().SetXGlobalTransactionID("transaction1")
+			inventory, operationErr := vmwareService.ListSobrs(listSobrsOptions)
+			Expect(operationErr).To(BeNil())
+			Expect(inventory.Sobrs).To(HaveLen(4))
+			Expect(inventory.Sobrs[0].DirectorSiteID).To(Equal("site1"))
+			Expect(inventory.Sobrs[0].DirectorSiteName).To(Equal("name-site1"))
+			Expect(inventory.Sobrs[0].ServiceID).To(Equal("veeam_id"))
+			Expect(inventory.DataCenters()).To(Equal([]string{"dal10", "dal12"}))
+
+			summary := inventory.Summarize()
+			Expect(summary.Total).To(Equal(vmwarev1.SobrCapacity{Count: 3, Size: 175}))
+			Expect(summary.ByStorageType).To(Equal(map[string]vmwarev1.SobrCapacity{
+				"cos":    {Count: 1, Size: 100},
+				"vsan":   {Count: 1, Size: 50},
+				"hybrid": {Count: 1, Size: 25},
+			}))
+			Expect(summary.ByDataCenter).To(Equal(map[string]vmwarev1.SobrCapacity{
+				"dal10": {Count: 2, Size: 150},
+				"dal12": {Count: 1, Size: 25},
+			}))
+			Expect(summary.ByDirectorSite["site2"]).To(Equal(vmwarev1.SobrCapacity{Count: 1, Size: 25}))
+
+			unprotected := inventory.WithoutImmutability()
+			Expect(unprotected).To(HaveLen(2))
+			Expect(*unprotected[0].Sobr.ID).To(Equal("s2"))
+			Expect(*unprotected[1].Sobr.ID).To(Equal("s3"))
+
+			_, err := json.Marshal(summary)
+			Expect(err).To(BeNil())
+		})
+	})
+
+	Describe(`EnableVeeam(enableVeeamOptions *EnableVeeamOptions)`, func() {
+		var (
+			enableRequests int
+			siteReads      int
+			veeamStatus    string
+			// The status of the service once it is no longer creating, or "" when it disappears.
+			createdStatus string
+		)
+		BeforeEach(func() {
+			enableRequests = 0
+			siteReads = 0
+			createdStatus = "ready_to_use"
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				res.Header().Set("Content-type", "application/json")
+				switch req.Method {
+				case http.MethodPost:
+					Expect(req.URL.EscapedPath()).To(Equal("/director_sites/site1/action/enable_veeam"))
+					var body map[string]interface{}
+					Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+					Expect(body["enable"]).To(Equal(true))
+					enableRequests++
+					// A deleted service instance is replaced on the third read.
+					if veeamStatus != "deleted" {
+						veeamStatus = "creating"
+					}
+					res.WriteHeader(202)
+					fmt.Fprint(res, `{"message": "The request has been accepted."}`)
+				case http.MethodGet:
+					Expect(req.URL.EscapedPath()).To(Equal("/director_sites/site1"))
+					siteReads++
+					services := ""
+					if veeamStatus == "deleted" && enableRequests > 0 && siteReads == 3 {
+						veeamStatus = "creating"
+					}
+					if veeamStatus != "" {
+						if veeamStatus == "creating" && siteReads > 3 {
+							veeamStatus = createdStatus
+						}
+						services = veeamServiceJSON(veeamStatus, "")
+					}
+					res.WriteHeader(200)
+					fmt.Fprint(res, directorSiteJSON("site1", services))
+				}
+			}))
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+		It(`Enables Veeam and waits for the service to be ready to use`, func() {
+			veeamStatus = ""
+			vmwareService, serviceErr := vmwarev1.NewVmwareV1(&vmwarev1.VmwareV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			result, operationErr := vmwareService.EnableVeeam(nil)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(result).To(BeNil())
+
+			enableVeeamOptions := vmwareService.NewEnableVeeamOptions("site1")
+			enableVeeamOptions.SetWaitOptions(vmwareService.NewWaitOptions(time.Millisecond, 5*time.Second))
+			result, operationErr = vmwareService.EnableVeeam(enableVeeamOptions)
+			Expect(operationErr).To(BeNil())
+			Expect(result).ToNot(BeNil())
+			Expect(*result.Status).To(Equal(vmwarev1.Service_Status_ReadyToUse))
+			Expect(enableRequests).To(Equal(1))
+			Expect(siteReads).To(BeNumerically(">", 3))
+		})
+		It(`Fails when the service is deleted or disappears`, func() {
+			vmwareService, serviceErr := vmwarev1.NewVmwareV1(&vmwarev1.VmwareV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+			enableVeeamOptions := vmwareService.NewEnableVeeamOptions("site1")
+			enableVeeamOptions.SetWaitOptions(vmwareService.NewWaitOptions(time.Millisecond, 5*time.Second))
+
+			veeamStatus = ""
+			createdStatus = "deleted"
+			result, operationErr := vmwareService.EnableVeeam(enableVeeamOptions)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(operationErr.Error()).To(Equal("the Veeam service of Cloud Director site 'site1' was deleted"))
+			Expect(*result.Status).To(Equal(vmwarev1.Service_Status_Deleted))
+
+			veeamStatus = ""
+			siteReads = 0
+			createdStatus = ""
+			_, operationErr = vmwareService.EnableVeeam(enableVeeamOptions)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(operationErr.Error()).To(Equal("the Veeam service of Cloud Director site 'site1' disappeared"))
+
+			// The service left deleted by an earlier disablement does not fail the enablement.
+			veeamStatus = "deleted"
+			siteReads = 0
+			createdStatus = "ready_to_use"
+			result, operationErr = vmwareService.EnableVeeam(enableVeeamOptions)
+			Expect(operationErr).To(BeNil())
+			Expect(*result.Status).To(Equal(vmwarev1.Service_Status_ReadyToUse))
+			Expect(enableRequests).To(Equal(3))
+			Expect(siteReads).To(BeNumerically(">", 3))
+		})
+		It(`Does not enable Veeam again when it is ready to use`, func() {
+			veeamStatus = "ready_to_use"
+			vmwareService, serviceErr := vmwarev1.NewVmwareV1(&vmwarev1.VmwareV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			result, operationErr := vmwareService.EnableVeeam(vmwareService.NewEnableVeeamOptions("site1"))
+			Expect(operationErr).To(BeNil())
+			Expect(*result.ID).To(Equal("veeam_id"))
+			Expect(enableRequests).To(Equal(0))
+		})
+		It(`Times out when the service does not become ready`, func() {
+			veeamStatus = ""
+			vmwareService, serviceErr := vmwarev1.NewVmwareV1(&vmwarev1.VmwareV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			enableVeeamOptions := vmwareService.NewEnableVeeamOptions("site1")
+			enableVeeamOptions.SetWaitOptions(vmwareService.NewWaitOptions(time.Second, 10*time.Millisecond))
+			_, operationErr := vmwareService.EnableVeeam(enableVeeamOptions)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(operationErr.Error()).To(ContainSubstring("timed out waiting for the Veeam service"))
+		})
+	})
+})
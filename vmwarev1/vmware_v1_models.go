@@ -0,0 +1,2828 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by modelgen from vmware_v1.go. DO NOT EDIT.
+
+package vmwarev1
+
+// DeepCopy returns a copy of the Cluster that shares no memory with it.
+func (cluster *Cluster) DeepCopy() *Cluster {
+	if cluster == nil {
+		return nil
+	}
+	out := new(Cluster)
+	out.ID = copyPointer(cluster.ID)
+	out.Name = copyPointer(cluster.Name)
+	out.Href = copyPointer(cluster.Href)
+	out.OrderedAt = copyPointer(cluster.OrderedAt)
+	out.ProvisionedAt = copyPointer(cluster.ProvisionedAt)
+	out.HostCount = copyPointer(cluster.HostCount)
+	out.Status = copyPointer(cluster.Status)
+	out.DataCenterName = copyPointer(cluster.DataCenterName)
+	out.DirectorSite = cluster.DirectorSite.DeepCopy()
+	out.HostProfile = copyPointer(cluster.HostProfile)
+	out.StorageType = copyPointer(cluster.StorageType)
+	out.BillingPlan = copyPointer(cluster.BillingPlan)
+	out.FileShares = cluster.FileShares.DeepCopy()
+	return out
+}
+
+// Equal returns true if the Cluster and "other" have the same fields, apart from those ignored by "options".
+func (cluster *Cluster) Equal(other *Cluster, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	cluster.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the Cluster that differ in "other", apart from those ignored by "options".
+func (cluster *Cluster) Diff(other *Cluster, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	cluster.diff(d, "", other)
+	return d.changes
+}
+
+func (cluster *Cluster) diff(d *differ, path string, other *Cluster) {
+	if diffNil(d, path, cluster, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "id", false); ok {
+		diffPointers(d, fieldPath, cluster.ID, other.ID)
+	}
+	if fieldPath, ok := d.field(path, "name", false); ok {
+		diffPointers(d, fieldPath, cluster.Name, other.Name)
+	}
+	if fieldPath, ok := d.field(path, "href", true); ok {
+		diffPointers(d, fieldPath, cluster.Href, other.Href)
+	}
+	if fieldPath, ok := d.field(path, "ordered_at", true); ok {
+		diffDateTimes(d, fieldPath, cluster.OrderedAt, other.OrderedAt)
+	}
+	if fieldPath, ok := d.field(path, "provisioned_at", true); ok {
+		diffDateTimes(d, fieldPath, cluster.ProvisionedAt, other.ProvisionedAt)
+	}
+	if fieldPath, ok := d.field(path, "host_count", false); ok {
+		diffPointers(d, fieldPath, cluster.HostCount, other.HostCount)
+	}
+	if fieldPath, ok := d.field(path, "status", false); ok {
+		diffPointers(d, fieldPath, cluster.Status, other.Status)
+	}
+	if fieldPath, ok := d.field(path, "data_center_name", false); ok {
+		diffPointers(d, fieldPath, cluster.DataCenterName, other.DataCenterName)
+	}
+	if fieldPath, ok := d.field(path, "director_site", false); ok {
+		cluster.DirectorSite.diff(d, fieldPath, other.DirectorSite)
+	}
+	if fieldPath, ok := d.field(path, "host_profile", false); ok {
+		diffPointers(d, fieldPath, cluster.HostProfile, other.HostProfile)
+	}
+	if fieldPath, ok := d.field(path, "storage_type", false); ok {
+		diffPointers(d, fieldPath, cluster.StorageType, other.StorageType)
+	}
+	if fieldPath, ok := d.field(path, "billing_plan", false); ok {
+		diffPointers(d, fieldPath, cluster.BillingPlan, other.BillingPlan)
+	}
+	if fieldPath, ok := d.field(path, "file_shares", false); ok {
+		cluster.FileShares.diff(d, fieldPath, other.FileShares)
+	}
+}
+
+// DeepCopy returns a copy of the ClusterCollection that shares no memory with it.
+func (clusterCollection *ClusterCollection) DeepCopy() *ClusterCollection {
+	if clusterCollection == nil {
+		return nil
+	}
+	out := new(ClusterCollection)
+	out.Clusters = copyModels(clusterCollection.Clusters, (*Cluster).DeepCopy)
+	return out
+}
+
+// Equal returns true if the ClusterCollection and "other" have the same fields, apart from those ignored by "options".
+func (clusterCollection *ClusterCollection) Equal(other *ClusterCollection, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	clusterCollection.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the ClusterCollection that differ in "other", apart from those ignored by "options".
+func (clusterCollection *ClusterCollection) Diff(other *ClusterCollection, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	clusterCollection.diff(d, "", other)
+	return d.changes
+}
+
+func (clusterCollection *ClusterCollection) diff(d *differ, path string, other *ClusterCollection) {
+	if diffNil(d, path, clusterCollection, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "clusters", false); ok {
+		diffModels(d, fieldPath, clusterCollection.Clusters, other.Clusters, (*Cluster).diff)
+	}
+}
+
+// DeepCopy returns a copy of the ClusterPatch that shares no memory with it.
+func (clusterPatch *ClusterPatch) DeepCopy() *ClusterPatch {
+	if clusterPatch == nil {
+		return nil
+	}
+	out := new(ClusterPatch)
+	out.FileShares = clusterPatch.FileShares.DeepCopy()
+	out.HostCount = copyPointer(clusterPatch.HostCount)
+	return out
+}
+
+// Equal returns true if the ClusterPatch and "other" have the same fields, apart from those ignored by "options".
+func (clusterPatch *ClusterPatch) Equal(other *ClusterPatch, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	clusterPatch.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the ClusterPatch that differ in "other", apart from those ignored by "options".
+func (clusterPatch *ClusterPatch) Diff(other *ClusterPatch, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	clusterPatch.diff(d, "", other)
+	return d.changes
+}
+
+func (clusterPatch *ClusterPatch) diff(d *differ, path string, other *ClusterPatch) {
+	if diffNil(d, path, clusterPatch, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "file_shares", false); ok {
+		clusterPatch.FileShares.diff(d, fieldPath, other.FileShares)
+	}
+	if fieldPath, ok := d.field(path, "host_count", false); ok {
+		diffPointers(d, fieldPath, clusterPatch.HostCount, other.HostCount)
+	}
+}
+
+// DeepCopy returns a copy of the ClusterPrototype that shares no memory with it.
+func (clusterPrototype *ClusterPrototype) DeepCopy() *ClusterPrototype {
+	if clusterPrototype == nil {
+		return nil
+	}
+	out := new(ClusterPrototype)
+	out.Name = copyPointer(clusterPrototype.Name)
+	out.HostCount = copyPointer(clusterPrototype.HostCount)
+	out.HostProfile = copyPointer(clusterPrototype.HostProfile)
+	out.FileShares = clusterPrototype.FileShares.DeepCopy()
+	return out
+}
+
+// Equal returns true if the ClusterPrototype and "other" have the same fields, apart from those ignored by "options".
+func (clusterPrototype *ClusterPrototype) Equal(other *ClusterPrototype, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	clusterPrototype.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the ClusterPrototype that differ in "other", apart from those ignored by "options".
+func (clusterPrototype *ClusterPrototype) Diff(other *ClusterPrototype, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	clusterPrototype.diff(d, "", other)
+	return d.changes
+}
+
+func (clusterPrototype *ClusterPrototype) diff(d *differ, path string, other *ClusterPrototype) {
+	if diffNil(d, path, clusterPrototype, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "name", false); ok {
+		diffPointers(d, fieldPath, clusterPrototype.Name, other.Name)
+	}
+	if fieldPath, ok := d.field(path, "host_count", false); ok {
+		diffPointers(d, fieldPath, clusterPrototype.HostCount, other.HostCount)
+	}
+	if fieldPath, ok := d.field(path, "host_profile", false); ok {
+		diffPointers(d, fieldPath, clusterPrototype.HostProfile, other.HostProfile)
+	}
+	if fieldPath, ok := d.field(path, "file_shares", false); ok {
+		clusterPrototype.FileShares.diff(d, fieldPath, other.FileShares)
+	}
+}
+
+// DeepCopy returns a copy of the ClusterSummary that shares no memory with it.
+func (clusterSummary *ClusterSummary) DeepCopy() *ClusterSummary {
+	if clusterSummary == nil {
+		return nil
+	}
+	out := new(ClusterSummary)
+	out.Name = copyPointer(clusterSummary.Name)
+	out.HostCount = copyPointer(clusterSummary.HostCount)
+	out.HostProfile = copyPointer(clusterSummary.HostProfile)
+	out.ID = copyPointer(clusterSummary.ID)
+	out.DataCenterName = copyPointer(clusterSummary.DataCenterName)
+	out.Status = copyPointer(clusterSummary.Status)
+	out.Href = copyPointer(clusterSummary.Href)
+	out.FileShares = clusterSummary.FileShares.DeepCopy()
+	return out
+}
+
+// Equal returns true if the ClusterSummary and "other" have the same fields, apart from those ignored by "options".
+func (clusterSummary *ClusterSummary) Equal(other *ClusterSummary, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	clusterSummary.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the ClusterSummary that differ in "other", apart from those ignored by "options".
+func (clusterSummary *ClusterSummary) Diff(other *ClusterSummary, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	clusterSummary.diff(d, "", other)
+	return d.changes
+}
+
+func (clusterSummary *ClusterSummary) diff(d *differ, path string, other *ClusterSummary) {
+	if diffNil(d, path, clusterSummary, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "name", false); ok {
+		diffPointers(d, fieldPath, clusterSummary.Name, other.Name)
+	}
+	if fieldPath, ok := d.field(path, "host_count", false); ok {
+		diffPointers(d, fieldPath, clusterSummary.HostCount, other.HostCount)
+	}
+	if fieldPath, ok := d.field(path, "host_profile", false); ok {
+		diffPointers(d, fieldPath, clusterSummary.HostProfile, other.HostProfile)
+	}
+	if fieldPath, ok := d.field(path, "id", false); ok {
+		diffPointers(d, fieldPath, clusterSummary.ID, other.ID)
+	}
+	if fieldPath, ok := d.field(path, "data_center_name", false); ok {
+		diffPointers(d, fieldPath, clusterSummary.DataCenterName, other.DataCenterName)
+	}
+	if fieldPath, ok := d.field(path, "status", false); ok {
+		diffPointers(d, fieldPath, clusterSummary.Status, other.Status)
+	}
+	if fieldPath, ok := d.field(path, "href", true); ok {
+		diffPointers(d, fieldPath, clusterSummary.Href, other.Href)
+	}
+	if fieldPath, ok := d.field(path, "file_shares", false); ok {
+		clusterSummary.FileShares.diff(d, fieldPath, other.FileShares)
+	}
+}
+
+// DeepCopy returns a copy of the DataCenter that shares no memory with it.
+func (dataCenter *DataCenter) DeepCopy() *DataCenter {
+	if dataCenter == nil {
+		return nil
+	}
+	out := new(DataCenter)
+	out.DisplayName = copyPointer(dataCenter.DisplayName)
+	out.Name = copyPointer(dataCenter.Name)
+	out.UplinkSpeed = copyPointer(dataCenter.UplinkSpeed)
+	return out
+}
+
+// Equal returns true if the DataCenter and "other" have the same fields, apart from those ignored by "options".
+func (dataCenter *DataCenter) Equal(other *DataCenter, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	dataCenter.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the DataCenter that differ in "other", apart from those ignored by "options".
+func (dataCenter *DataCenter) Diff(other *DataCenter, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	dataCenter.diff(d, "", other)
+	return d.changes
+}
+
+func (dataCenter *DataCenter) diff(d *differ, path string, other *DataCenter) {
+	if diffNil(d, path, dataCenter, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "display_name", false); ok {
+		diffPointers(d, fieldPath, dataCenter.DisplayName, other.DisplayName)
+	}
+	if fieldPath, ok := d.field(path, "name", false); ok {
+		diffPointers(d, fieldPath, dataCenter.Name, other.Name)
+	}
+	if fieldPath, ok := d.field(path, "uplink_speed", false); ok {
+		diffPointers(d, fieldPath, dataCenter.UplinkSpeed, other.UplinkSpeed)
+	}
+}
+
+// DeepCopy returns a copy of the DirectorSite that shares no memory with it.
+func (directorSite *DirectorSite) DeepCopy() *DirectorSite {
+	if directorSite == nil {
+		return nil
+	}
+	out := new(DirectorSite)
+	out.Crn = copyPointer(directorSite.Crn)
+	out.Href = copyPointer(directorSite.Href)
+	out.ID = copyPointer(directorSite.ID)
+	out.OrderedAt = copyPointer(directorSite.OrderedAt)
+	out.ProvisionedAt = copyPointer(directorSite.ProvisionedAt)
+	out.Name = copyPointer(directorSite.Name)
+	out.Status = copyPointer(directorSite.Status)
+	out.ResourceGroup = directorSite.ResourceGroup.DeepCopy()
+	out.Pvdcs = copyModels(directorSite.Pvdcs, (*PVDC).DeepCopy)
+	out.Type = copyPointer(directorSite.Type)
+	out.Services = copyModels(directorSite.Services, (*Service).DeepCopy)
+	out.RhelVmActivationKey = copyPointer(directorSite.RhelVmActivationKey)
+	out.ConsoleConnectionType = copyPointer(directorSite.ConsoleConnectionType)
+	out.ConsoleConnectionStatus = copyPointer(directorSite.ConsoleConnectionStatus)
+	out.IpAllowList = copyValues(directorSite.IpAllowList)
+	return out
+}
+
+// Equal returns true if the DirectorSite and "other" have the same fields, apart from those ignored by "options".
+func (directorSite *DirectorSite) Equal(other *DirectorSite, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	directorSite.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the DirectorSite that differ in "other", apart from those ignored by "options".
+func (directorSite *DirectorSite) Diff(other *DirectorSite, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	directorSite.diff(d, "", other)
+	return d.changes
+}
+
+func (directorSite *DirectorSite) diff(d *differ, path string, other *DirectorSite) {
+	if diffNil(d, path, directorSite, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "crn", false); ok {
+		diffPointers(d, fieldPath, directorSite.Crn, other.Crn)
+	}
+	if fieldPath, ok := d.field(path, "href", true); ok {
+		diffPointers(d, fieldPath, directorSite.Href, other.Href)
+	}
+	if fieldPath, ok := d.field(path, "id", false); ok {
+		diffPointers(d, fieldPath, directorSite.ID, other.ID)
+	}
+	if fieldPath, ok := d.field(path, "ordered_at", true); ok {
+		diffDateTimes(d, fieldPath, directorSite.OrderedAt, other.OrderedAt)
+	}
+	if fieldPath, ok := d.field(path, "provisioned_at", true); ok {
+		diffDateTimes(d, fieldPath, directorSite.ProvisionedAt, other.ProvisionedAt)
+	}
+	if fieldPath, ok := d.field(path, "name", false); ok {
+		diffPointers(d, fieldPath, directorSite.Name, other.Name)
+	}
+	if fieldPath, ok := d.field(path, "status", false); ok {
+		diffPointers(d, fieldPath, directorSite.Status, other.Status)
+	}
+	if fieldPath, ok := d.field(path, "resource_group", false); ok {
+		directorSite.ResourceGroup.diff(d, fieldPath, other.ResourceGroup)
+	}
+	if fieldPath, ok := d.field(path, "pvdcs", false); ok {
+		diffModels(d, fieldPath, directorSite.Pvdcs, other.Pvdcs, (*PVDC).diff)
+	}
+	if fieldPath, ok := d.field(path, "type", false); ok {
+		diffPointers(d, fieldPath, directorSite.Type, other.Type)
+	}
+	if fieldPath, ok := d.field(path, "services", false); ok {
+		diffModels(d, fieldPath, directorSite.Services, other.Services, (*Service).diff)
+	}
+	if fieldPath, ok := d.field(path, "rhel_vm_activation_key", false); ok {
+		diffPointers(d, fieldPath, directorSite.RhelVmActivationKey, other.RhelVmActivationKey)
+	}
+	if fieldPath, ok := d.field(path, "console_connection_type", false); ok {
+		diffPointers(d, fieldPath, directorSite.ConsoleConnectionType, other.ConsoleConnectionType)
+	}
+	if fieldPath, ok := d.field(path, "console_connection_status", false); ok {
+		diffPointers(d, fieldPath, directorSite.ConsoleConnectionStatus, other.ConsoleConnectionStatus)
+	}
+	if fieldPath, ok := d.field(path, "ip_allow_list", false); ok {
+		diffValues(d, fieldPath, directorSite.IpAllowList, other.IpAllowList)
+	}
+}
+
+// DeepCopy returns a copy of the DirectorSiteCollection that shares no memory with it.
+func (directorSiteCollection *DirectorSiteCollection) DeepCopy() *DirectorSiteCollection {
+	if directorSiteCollection == nil {
+		return nil
+	}
+	out := new(DirectorSiteCollection)
+	out.DirectorSites = copyModels(directorSiteCollection.DirectorSites, (*DirectorSite).DeepCopy)
+	return out
+}
+
+// Equal returns true if the DirectorSiteCollection and "other" have the same fields, apart from those ignored by "options".
+func (directorSiteCollection *DirectorSiteCollection) Equal(other *DirectorSiteCollection, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	directorSiteCollection.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the DirectorSiteCollection that differ in "other", apart from those ignored by "options".
+func (directorSiteCollection *DirectorSiteCollection) Diff(other *DirectorSiteCollection, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	directorSiteCollection.diff(d, "", other)
+	return d.changes
+}
+
+func (directorSiteCollection *DirectorSiteCollection) diff(d *differ, path string, other *DirectorSiteCollection) {
+	if diffNil(d, path, directorSiteCollection, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "director_sites", false); ok {
+		diffModels(d, fieldPath, directorSiteCollection.DirectorSites, other.DirectorSites, (*DirectorSite).diff)
+	}
+}
+
+// DeepCopy returns a copy of the DirectorSiteHostProfile that shares no memory with it.
+func (directorSiteHostProfile *DirectorSiteHostProfile) DeepCopy() *DirectorSiteHostProfile {
+	if directorSiteHostProfile == nil {
+		return nil
+	}
+	out := new(DirectorSiteHostProfile)
+	out.ID = copyPointer(directorSiteHostProfile.ID)
+	out.Cpu = copyPointer(directorSiteHostProfile.Cpu)
+	out.Family = copyPointer(directorSiteHostProfile.Family)
+	out.Processor = copyPointer(directorSiteHostProfile.Processor)
+	out.Ram = copyPointer(directorSiteHostProfile.Ram)
+	out.Socket = copyPointer(directorSiteHostProfile.Socket)
+	out.Speed = copyPointer(directorSiteHostProfile.Speed)
+	out.Manufacturer = copyPointer(directorSiteHostProfile.Manufacturer)
+	out.Features = copyValues(directorSiteHostProfile.Features)
+	return out
+}
+
+// Equal returns true if the DirectorSiteHostProfile and "other" have the same fields, apart from those ignored by "options".
+func (directorSiteHostProfile *DirectorSiteHostProfile) Equal(other *DirectorSiteHostProfile, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	directorSiteHostProfile.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the DirectorSiteHostProfile that differ in "other", apart from those ignored by "options".
+func (directorSiteHostProfile *DirectorSiteHostProfile) Diff(other *DirectorSiteHostProfile, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	directorSiteHostProfile.diff(d, "", other)
+	return d.changes
+}
+
+func (directorSiteHostProfile *DirectorSiteHostProfile) diff(d *differ, path string, other *DirectorSiteHostProfile) {
+	if diffNil(d, path, directorSiteHostProfile, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "id", false); ok {
+		diffPointers(d, fieldPath, directorSiteHostProfile.ID, other.ID)
+	}
+	if fieldPath, ok := d.field(path, "cpu", false); ok {
+		diffPointers(d, fieldPath, directorSiteHostProfile.Cpu, other.Cpu)
+	}
+	if fieldPath, ok := d.field(path, "family", false); ok {
+		diffPointers(d, fieldPath, directorSiteHostProfile.Family, other.Family)
+	}
+	if fieldPath, ok := d.field(path, "processor", false); ok {
+		diffPointers(d, fieldPath, directorSiteHostProfile.Processor, other.Processor)
+	}
+	if fieldPath, ok := d.field(path, "ram", false); ok {
+		diffPointers(d, fieldPath, directorSiteHostProfile.Ram, other.Ram)
+	}
+	if fieldPath, ok := d.field(path, "socket", false); ok {
+		diffPointers(d, fieldPath, directorSiteHostProfile.Socket, other.Socket)
+	}
+	if fieldPath, ok := d.field(path, "speed", false); ok {
+		diffPointers(d, fieldPath, directorSiteHostProfile.Speed, other.Speed)
+	}
+	if fieldPath, ok := d.field(path, "manufacturer", false); ok {
+		diffPointers(d, fieldPath, directorSiteHostProfile.Manufacturer, other.Manufacturer)
+	}
+	if fieldPath, ok := d.field(path, "features", false); ok {
+		diffValues(d, fieldPath, directorSiteHostProfile.Features, other.Features)
+	}
+}
+
+// DeepCopy returns a copy of the DirectorSiteHostProfileCollection that shares no memory with it.
+func (directorSiteHostProfileCollection *DirectorSiteHostProfileCollection) DeepCopy() *DirectorSiteHostProfileCollection {
+	if directorSiteHostProfileCollection == nil {
+		return nil
+	}
+	out := new(DirectorSiteHostProfileCollection)
+	out.DirectorSiteHostProfiles = copyModels(directorSiteHostProfileCollection.DirectorSiteHostProfiles, (*DirectorSiteHostProfile).DeepCopy)
+	return out
+}
+
+// Equal returns true if the DirectorSiteHostProfileCollection and "other" have the same fields, apart from those ignored by "options".
+func (directorSiteHostProfileCollection *DirectorSiteHostProfileCollection) Equal(other *DirectorSiteHostProfileCollection, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	directorSiteHostProfileCollection.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the DirectorSiteHostProfileCollection that differ in "other", apart from those ignored by "options".
+func (directorSiteHostProfileCollection *DirectorSiteHostProfileCollection) Diff(other *DirectorSiteHostProfileCollection, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	directorSiteHostProfileCollection.diff(d, "", other)
+	return d.changes
+}
+
+func (directorSiteHostProfileCollection *DirectorSiteHostProfileCollection) diff(d *differ, path string, other *DirectorSiteHostProfileCollection) {
+	if diffNil(d, path, directorSiteHostProfileCollection, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "director_site_host_profiles", false); ok {
+		diffModels(d, fieldPath, directorSiteHostProfileCollection.DirectorSiteHostProfiles, other.DirectorSiteHostProfiles, (*DirectorSiteHostProfile).diff)
+	}
+}
+
+// DeepCopy returns a copy of the DirectorSitePVDC that shares no memory with it.
+func (directorSitePVDC *DirectorSitePVDC) DeepCopy() *DirectorSitePVDC {
+	if directorSitePVDC == nil {
+		return nil
+	}
+	out := new(DirectorSitePVDC)
+	out.ComputeHaEnabled = copyPointer(directorSitePVDC.ComputeHaEnabled)
+	out.ID = copyPointer(directorSitePVDC.ID)
+	out.ProviderType = directorSitePVDC.ProviderType.DeepCopy()
+	return out
+}
+
+// Equal returns true if the DirectorSitePVDC and "other" have the same fields, apart from those ignored by "options".
+func (directorSitePVDC *DirectorSitePVDC) Equal(other *DirectorSitePVDC, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	directorSitePVDC.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the DirectorSitePVDC that differ in "other", apart from those ignored by "options".
+func (directorSitePVDC *DirectorSitePVDC) Diff(other *DirectorSitePVDC, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	directorSitePVDC.diff(d, "", other)
+	return d.changes
+}
+
+func (directorSitePVDC *DirectorSitePVDC) diff(d *differ, path string, other *DirectorSitePVDC) {
+	if diffNil(d, path, directorSitePVDC, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "compute_ha_enabled", false); ok {
+		diffPointers(d, fieldPath, directorSitePVDC.ComputeHaEnabled, other.ComputeHaEnabled)
+	}
+	if fieldPath, ok := d.field(path, "id", false); ok {
+		diffPointers(d, fieldPath, directorSitePVDC.ID, other.ID)
+	}
+	if fieldPath, ok := d.field(path, "provider_type", false); ok {
+		directorSitePVDC.ProviderType.diff(d, fieldPath, other.ProviderType)
+	}
+}
+
+// DeepCopy returns a copy of the DirectorSitePVDCResponse that shares no memory with it.
+func (directorSitePVDCResponse *DirectorSitePVDCResponse) DeepCopy() *DirectorSitePVDCResponse {
+	if directorSitePVDCResponse == nil {
+		return nil
+	}
+	out := new(DirectorSitePVDCResponse)
+	out.ID = copyPointer(directorSitePVDCResponse.ID)
+	out.ProviderType = directorSitePVDCResponse.ProviderType.DeepCopy()
+	return out
+}
+
+// Equal returns true if the DirectorSitePVDCResponse and "other" have the same fields, apart from those ignored by "options".
+func (directorSitePVDCResponse *DirectorSitePVDCResponse) Equal(other *DirectorSitePVDCResponse, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	directorSitePVDCResponse.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the DirectorSitePVDCResponse that differ in "other", apart from those ignored by "options".
+func (directorSitePVDCResponse *DirectorSitePVDCResponse) Diff(other *DirectorSitePVDCResponse, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	directorSitePVDCResponse.diff(d, "", other)
+	return d.changes
+}
+
+func (directorSitePVDCResponse *DirectorSitePVDCResponse) diff(d *differ, path string, other *DirectorSitePVDCResponse) {
+	if diffNil(d, path, directorSitePVDCResponse, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "id", false); ok {
+		diffPointers(d, fieldPath, directorSitePVDCResponse.ID, other.ID)
+	}
+	if fieldPath, ok := d.field(path, "provider_type", false); ok {
+		directorSitePVDCResponse.ProviderType.diff(d, fieldPath, other.ProviderType)
+	}
+}
+
+// DeepCopy returns a copy of the DirectorSiteReference that shares no memory with it.
+func (directorSiteReference *DirectorSiteReference) DeepCopy() *DirectorSiteReference {
+	if directorSiteReference == nil {
+		return nil
+	}
+	out := new(DirectorSiteReference)
+	out.Crn = copyPointer(directorSiteReference.Crn)
+	out.Href = copyPointer(directorSiteReference.Href)
+	out.ID = copyPointer(directorSiteReference.ID)
+	return out
+}
+
+// Equal returns true if the DirectorSiteReference and "other" have the same fields, apart from those ignored by "options".
+func (directorSiteReference *DirectorSiteReference) Equal(other *DirectorSiteReference, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	directorSiteReference.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the DirectorSiteReference that differ in "other", apart from those ignored by "options".
+func (directorSiteReference *DirectorSiteReference) Diff(other *DirectorSiteReference, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	directorSiteReference.diff(d, "", other)
+	return d.changes
+}
+
+func (directorSiteReference *DirectorSiteReference) diff(d *differ, path string, other *DirectorSiteReference) {
+	if diffNil(d, path, directorSiteReference, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "crn", false); ok {
+		diffPointers(d, fieldPath, directorSiteReference.Crn, other.Crn)
+	}
+	if fieldPath, ok := d.field(path, "href", true); ok {
+		diffPointers(d, fieldPath, directorSiteReference.Href, other.Href)
+	}
+	if fieldPath, ok := d.field(path, "id", false); ok {
+		diffPointers(d, fieldPath, directorSiteReference.ID, other.ID)
+	}
+}
+
+// DeepCopy returns a copy of the DirectorSiteRegion that shares no memory with it.
+func (directorSiteRegion *DirectorSiteRegion) DeepCopy() *DirectorSiteRegion {
+	if directorSiteRegion == nil {
+		return nil
+	}
+	out := new(DirectorSiteRegion)
+	out.Name = copyPointer(directorSiteRegion.Name)
+	out.DataCenters = copyModels(directorSiteRegion.DataCenters, (*DataCenter).DeepCopy)
+	out.Endpoint = copyPointer(directorSiteRegion.Endpoint)
+	return out
+}
+
+// Equal returns true if the DirectorSiteRegion and "other" have the same fields, apart from those ignored by "options".
+func (directorSiteRegion *DirectorSiteRegion) Equal(other *DirectorSiteRegion, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	directorSiteRegion.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the DirectorSiteRegion that differ in "other", apart from those ignored by "options".
+func (directorSiteRegion *DirectorSiteRegion) Diff(other *DirectorSiteRegion, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	directorSiteRegion.diff(d, "", other)
+	return d.changes
+}
+
+func (directorSiteRegion *DirectorSiteRegion) diff(d *differ, path string, other *DirectorSiteRegion) {
+	if diffNil(d, path, directorSiteRegion, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "name", false); ok {
+		diffPointers(d, fieldPath, directorSiteRegion.Name, other.Name)
+	}
+	if fieldPath, ok := d.field(path, "data_centers", false); ok {
+		diffModels(d, fieldPath, directorSiteRegion.DataCenters, other.DataCenters, (*DataCenter).diff)
+	}
+	if fieldPath, ok := d.field(path, "endpoint", false); ok {
+		diffPointers(d, fieldPath, directorSiteRegion.Endpoint, other.Endpoint)
+	}
+}
+
+// DeepCopy returns a copy of the DirectorSiteRegionCollection that shares no memory with it.
+func (directorSiteRegionCollection *DirectorSiteRegionCollection) DeepCopy() *DirectorSiteRegionCollection {
+	if directorSiteRegionCollection == nil {
+		return nil
+	}
+	out := new(DirectorSiteRegionCollection)
+	out.DirectorSiteRegions = copyModels(directorSiteRegionCollection.DirectorSiteRegions, (*DirectorSiteRegion).DeepCopy)
+	return out
+}
+
+// Equal returns true if the DirectorSiteRegionCollection and "other" have the same fields, apart from those ignored by "options".
+func (directorSiteRegionCollection *DirectorSiteRegionCollection) Equal(other *DirectorSiteRegionCollection, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	directorSiteRegionCollection.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the DirectorSiteRegionCollection that differ in "other", apart from those ignored by "options".
+func (directorSiteRegionCollection *DirectorSiteRegionCollection) Diff(other *DirectorSiteRegionCollection, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	directorSiteRegionCollection.diff(d, "", other)
+	return d.changes
+}
+
+func (directorSiteRegionCollection *DirectorSiteRegionCollection) diff(d *differ, path string, other *DirectorSiteRegionCollection) {
+	if diffNil(d, path, directorSiteRegionCollection, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "director_site_regions", false); ok {
+		diffModels(d, fieldPath, directorSiteRegionCollection.DirectorSiteRegions, other.DirectorSiteRegions, (*DirectorSiteRegion).diff)
+	}
+}
+
+// DeepCopy returns a copy of the Edge that shares no memory with it.
+func (edge *Edge) DeepCopy() *Edge {
+	if edge == nil {
+		return nil
+	}
+	out := new(Edge)
+	out.ID = copyPointer(edge.ID)
+	out.PublicIps = copyValues(edge.PublicIps)
+	out.PrivateIps = copyValues(edge.PrivateIps)
+	out.PrivateOnly = copyPointer(edge.PrivateOnly)
+	out.Size = copyPointer(edge.Size)
+	out.Status = copyPointer(edge.Status)
+	out.TransitGateways = copyModels(edge.TransitGateways, (*TransitGateway).DeepCopy)
+	out.Type = copyPointer(edge.Type)
+	out.Version = copyPointer(edge.Version)
+	out.PrimaryDataCenterName = copyPointer(edge.PrimaryDataCenterName)
+	out.SecondaryDataCenterName = copyPointer(edge.SecondaryDataCenterName)
+	out.PrimaryPvdcID = copyPointer(edge.PrimaryPvdcID)
+	out.SecondaryPvdcID = copyPointer(edge.SecondaryPvdcID)
+	return out
+}
+
+// Equal returns true if the Edge and "other" have the same fields, apart from those ignored by "options".
+func (edge *Edge) Equal(other *Edge, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	edge.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the Edge that differ in "other", apart from those ignored by "options".
+func (edge *Edge) Diff(other *Edge, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	edge.diff(d, "", other)
+	return d.changes
+}
+
+func (edge *Edge) diff(d *differ, path string, other *Edge) {
+	if diffNil(d, path, edge, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "id", false); ok {
+		diffPointers(d, fieldPath, edge.ID, other.ID)
+	}
+	if fieldPath, ok := d.field(path, "public_ips", false); ok {
+		diffValues(d, fieldPath, edge.PublicIps, other.PublicIps)
+	}
+	if fieldPath, ok := d.field(path, "private_ips", false); ok {
+		diffValues(d, fieldPath, edge.PrivateIps, other.PrivateIps)
+	}
+	if fieldPath, ok := d.field(path, "private_only", false); ok {
+		diffPointers(d, fieldPath, edge.PrivateOnly, other.PrivateOnly)
+	}
+	if fieldPath, ok := d.field(path, "size", false); ok {
+		diffPointers(d, fieldPath, edge.Size, other.Size)
+	}
+	if fieldPath, ok := d.field(path, "status", false); ok {
+		diffPointers(d, fieldPath, edge.Status, other.Status)
+	}
+	if fieldPath, ok := d.field(path, "transit_gateways", false); ok {
+		diffModels(d, fieldPath, edge.TransitGateways, other.TransitGateways, (*TransitGateway).diff)
+	}
+	if fieldPath, ok := d.field(path, "type", false); ok {
+		diffPointers(d, fieldPath, edge.Type, other.Type)
+	}
+	if fieldPath, ok := d.field(path, "version", false); ok {
+		diffPointers(d, fieldPath, edge.Version, other.Version)
+	}
+	if fieldPath, ok := d.field(path, "primary_data_center_name", false); ok {
+		diffPointers(d, fieldPath, edge.PrimaryDataCenterName, other.PrimaryDataCenterName)
+	}
+	if fieldPath, ok := d.field(path, "secondary_data_center_name", false); ok {
+		diffPointers(d, fieldPath, edge.SecondaryDataCenterName, other.SecondaryDataCenterName)
+	}
+	if fieldPath, ok := d.field(path, "primary_pvdc_id", false); ok {
+		diffPointers(d, fieldPath, edge.PrimaryPvdcID, other.PrimaryPvdcID)
+	}
+	if fieldPath, ok := d.field(path, "secondary_pvdc_id", false); ok {
+		diffPointers(d, fieldPath, edge.SecondaryPvdcID, other.SecondaryPvdcID)
+	}
+}
+
+// DeepCopy returns a copy of the FileShares that shares no memory with it.
+func (fileShares *FileShares) DeepCopy() *FileShares {
+	if fileShares == nil {
+		return nil
+	}
+	out := new(FileShares)
+	out.STORAGEPOINTTWOFIVEIOPSGB = copyPointer(fileShares.STORAGEPOINTTWOFIVEIOPSGB)
+	out.STORAGETWOIOPSGB = copyPointer(fileShares.STORAGETWOIOPSGB)
+	out.STORAGEFOURIOPSGB = copyPointer(fileShares.STORAGEFOURIOPSGB)
+	out.STORAGETENIOPSGB = copyPointer(fileShares.STORAGETENIOPSGB)
+	return out
+}
+
+// Equal returns true if the FileShares and "other" have the same fields, apart from those ignored by "options".
+func (fileShares *FileShares) Equal(other *FileShares, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	fileShares.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the FileShares that differ in "other", apart from those ignored by "options".
+func (fileShares *FileShares) Diff(other *FileShares, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	fileShares.diff(d, "", other)
+	return d.changes
+}
+
+func (fileShares *FileShares) diff(d *differ, path string, other *FileShares) {
+	if diffNil(d, path, fileShares, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "STORAGE_POINT_TWO_FIVE_IOPS_GB", false); ok {
+		diffPointers(d, fieldPath, fileShares.STORAGEPOINTTWOFIVEIOPSGB, other.STORAGEPOINTTWOFIVEIOPSGB)
+	}
+	if fieldPath, ok := d.field(path, "STORAGE_TWO_IOPS_GB", false); ok {
+		diffPointers(d, fieldPath, fileShares.STORAGETWOIOPSGB, other.STORAGETWOIOPSGB)
+	}
+	if fieldPath, ok := d.field(path, "STORAGE_FOUR_IOPS_GB", false); ok {
+		diffPointers(d, fieldPath, fileShares.STORAGEFOURIOPSGB, other.STORAGEFOURIOPSGB)
+	}
+	if fieldPath, ok := d.field(path, "STORAGE_TEN_IOPS_GB", false); ok {
+		diffPointers(d, fieldPath, fileShares.STORAGETENIOPSGB, other.STORAGETENIOPSGB)
+	}
+}
+
+// DeepCopy returns a copy of the FileSharesPrototype that shares no memory with it.
+func (fileSharesPrototype *FileSharesPrototype) DeepCopy() *FileSharesPrototype {
+	if fileSharesPrototype == nil {
+		return nil
+	}
+	out := new(FileSharesPrototype)
+	out.STORAGEPOINTTWOFIVEIOPSGB = copyPointer(fileSharesPrototype.STORAGEPOINTTWOFIVEIOPSGB)
+	out.STORAGETWOIOPSGB = copyPointer(fileSharesPrototype.STORAGETWOIOPSGB)
+	out.STORAGEFOURIOPSGB = copyPointer(fileSharesPrototype.STORAGEFOURIOPSGB)
+	out.STORAGETENIOPSGB = copyPointer(fileSharesPrototype.STORAGETENIOPSGB)
+	return out
+}
+
+// Equal returns true if the FileSharesPrototype and "other" have the same fields, apart from those ignored by "options".
+func (fileSharesPrototype *FileSharesPrototype) Equal(other *FileSharesPrototype, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	fileSharesPrototype.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the FileSharesPrototype that differ in "other", apart from those ignored by "options".
+func (fileSharesPrototype *FileSharesPrototype) Diff(other *FileSharesPrototype, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	fileSharesPrototype.diff(d, "", other)
+	return d.changes
+}
+
+func (fileSharesPrototype *FileSharesPrototype) diff(d *differ, path string, other *FileSharesPrototype) {
+	if diffNil(d, path, fileSharesPrototype, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "STORAGE_POINT_TWO_FIVE_IOPS_GB", false); ok {
+		diffPointers(d, fieldPath, fileSharesPrototype.STORAGEPOINTTWOFIVEIOPSGB, other.STORAGEPOINTTWOFIVEIOPSGB)
+	}
+	if fieldPath, ok := d.field(path, "STORAGE_TWO_IOPS_GB", false); ok {
+		diffPointers(d, fieldPath, fileSharesPrototype.STORAGETWOIOPSGB, other.STORAGETWOIOPSGB)
+	}
+	if fieldPath, ok := d.field(path, "STORAGE_FOUR_IOPS_GB", false); ok {
+		diffPointers(d, fieldPath, fileSharesPrototype.STORAGEFOURIOPSGB, other.STORAGEFOURIOPSGB)
+	}
+	if fieldPath, ok := d.field(path, "STORAGE_TEN_IOPS_GB", false); ok {
+		diffPointers(d, fieldPath, fileSharesPrototype.STORAGETENIOPSGB, other.STORAGETENIOPSGB)
+	}
+}
+
+// DeepCopy returns a copy of the License that shares no memory with it.
+func (license *License) DeepCopy() *License {
+	if license == nil {
+		return nil
+	}
+	out := new(License)
+	out.LicenseKeys = copyModels(license.LicenseKeys, (*LicenseKey).DeepCopy)
+	out.Version = copyPointer(license.Version)
+	return out
+}
+
+// Equal returns true if the License and "other" have the same fields, apart from those ignored by "options".
+func (license *License) Equal(other *License, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	license.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the License that differ in "other", apart from those ignored by "options".
+func (license *License) Diff(other *License, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	license.diff(d, "", other)
+	return d.changes
+}
+
+func (license *License) diff(d *differ, path string, other *License) {
+	if diffNil(d, path, license, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "license_keys", false); ok {
+		diffModels(d, fieldPath, license.LicenseKeys, other.LicenseKeys, (*LicenseKey).diff)
+	}
+	if fieldPath, ok := d.field(path, "version", false); ok {
+		diffPointers(d, fieldPath, license.Version, other.Version)
+	}
+}
+
+// DeepCopy returns a copy of the LicenseCollection that shares no memory with it.
+func (licenseCollection *LicenseCollection) DeepCopy() *LicenseCollection {
+	if licenseCollection == nil {
+		return nil
+	}
+	out := new(LicenseCollection)
+	out.Licenses = copyModels(licenseCollection.Licenses, (*License).DeepCopy)
+	return out
+}
+
+// Equal returns true if the LicenseCollection and "other" have the same fields, apart from those ignored by "options".
+func (licenseCollection *LicenseCollection) Equal(other *LicenseCollection, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	licenseCollection.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the LicenseCollection that differ in "other", apart from those ignored by "options".
+func (licenseCollection *LicenseCollection) Diff(other *LicenseCollection, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	licenseCollection.diff(d, "", other)
+	return d.changes
+}
+
+func (licenseCollection *LicenseCollection) diff(d *differ, path string, other *LicenseCollection) {
+	if diffNil(d, path, licenseCollection, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "licenses", false); ok {
+		diffModels(d, fieldPath, licenseCollection.Licenses, other.Licenses, (*License).diff)
+	}
+}
+
+// DeepCopy returns a copy of the LicenseKey that shares no memory with it.
+func (licenseKey *LicenseKey) DeepCopy() *LicenseKey {
+	if licenseKey == nil {
+		return nil
+	}
+	out := new(LicenseKey)
+	out.Value = copyPointer(licenseKey.Value)
+	out.Name = copyPointer(licenseKey.Name)
+	return out
+}
+
+// Equal returns true if the LicenseKey and "other" have the same fields, apart from those ignored by "options".
+func (licenseKey *LicenseKey) Equal(other *LicenseKey, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	licenseKey.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the LicenseKey that differ in "other", apart from those ignored by "options".
+func (licenseKey *LicenseKey) Diff(other *LicenseKey, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	licenseKey.diff(d, "", other)
+	return d.changes
+}
+
+func (licenseKey *LicenseKey) diff(d *differ, path string, other *LicenseKey) {
+	if diffNil(d, path, licenseKey, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "value", false); ok {
+		diffPointers(d, fieldPath, licenseKey.Value, other.Value)
+	}
+	if fieldPath, ok := d.field(path, "name", false); ok {
+		diffPointers(d, fieldPath, licenseKey.Name, other.Name)
+	}
+}
+
+// DeepCopy returns a copy of the MultitenantDirectorSite that shares no memory with it.
+func (multitenantDirectorSite *MultitenantDirectorSite) DeepCopy() *MultitenantDirectorSite {
+	if multitenantDirectorSite == nil {
+		return nil
+	}
+	out := new(MultitenantDirectorSite)
+	out.Name = copyPointer(multitenantDirectorSite.Name)
+	out.DisplayName = copyPointer(multitenantDirectorSite.DisplayName)
+	out.ID = copyPointer(multitenantDirectorSite.ID)
+	out.PrivateOnly = copyPointer(multitenantDirectorSite.PrivateOnly)
+	out.Region = copyPointer(multitenantDirectorSite.Region)
+	out.Pvdcs = copyModels(multitenantDirectorSite.Pvdcs, (*MultitenantPVDC).DeepCopy)
+	out.Services = copyValues(multitenantDirectorSite.Services)
+	return out
+}
+
+// Equal returns true if the MultitenantDirectorSite and "other" have the same fields, apart from those ignored by "options".
+func (multitenantDirectorSite *MultitenantDirectorSite) Equal(other *MultitenantDirectorSite, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	multitenantDirectorSite.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the MultitenantDirectorSite that differ in "other", apart from those ignored by "options".
+func (multitenantDirectorSite *MultitenantDirectorSite) Diff(other *MultitenantDirectorSite, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	multitenantDirectorSite.diff(d, "", other)
+	return d.changes
+}
+
+func (multitenantDirectorSite *MultitenantDirectorSite) diff(d *differ, path string, other *MultitenantDirectorSite) {
+	if diffNil(d, path, multitenantDirectorSite, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "name", false); ok {
+		diffPointers(d, fieldPath, multitenantDirectorSite.Name, other.Name)
+	}
+	if fieldPath, ok := d.field(path, "display_name", false); ok {
+		diffPointers(d, fieldPath, multitenantDirectorSite.DisplayName, other.DisplayName)
+	}
+	if fieldPath, ok := d.field(path, "id", false); ok {
+		diffPointers(d, fieldPath, multitenantDirectorSite.ID, other.ID)
+	}
+	if fieldPath, ok := d.field(path, "private_only", false); ok {
+		diffPointers(d, fieldPath, multitenantDirectorSite.PrivateOnly, other.PrivateOnly)
+	}
+	if fieldPath, ok := d.field(path, "region", false); ok {
+		diffPointers(d, fieldPath, multitenantDirectorSite.Region, other.Region)
+	}
+	if fieldPath, ok := d.field(path, "pvdcs", false); ok {
+		diffModels(d, fieldPath, multitenantDirectorSite.Pvdcs, other.Pvdcs, (*MultitenantPVDC).diff)
+	}
+	if fieldPath, ok := d.field(path, "services", false); ok {
+		diffValues(d, fieldPath, multitenantDirectorSite.Services, other.Services)
+	}
+}
+
+// DeepCopy returns a copy of the MultitenantDirectorSiteCollection that shares no memory with it.
+func (multitenantDirectorSiteCollection *MultitenantDirectorSiteCollection) DeepCopy() *MultitenantDirectorSiteCollection {
+	if multitenantDirectorSiteCollection == nil {
+		return nil
+	}
+	out := new(MultitenantDirectorSiteCollection)
+	out.MultitenantDirectorSites = copyModels(multitenantDirectorSiteCollection.MultitenantDirectorSites, (*MultitenantDirectorSite).DeepCopy)
+	return out
+}
+
+// Equal returns true if the MultitenantDirectorSiteCollection and "other" have the same fields, apart from those ignored by "options".
+func (multitenantDirectorSiteCollection *MultitenantDirectorSiteCollection) Equal(other *MultitenantDirectorSiteCollection, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	multitenantDirectorSiteCollection.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the MultitenantDirectorSiteCollection that differ in "other", apart from those ignored by "options".
+func (multitenantDirectorSiteCollection *MultitenantDirectorSiteCollection) Diff(other *MultitenantDirectorSiteCollection, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	multitenantDirectorSiteCollection.diff(d, "", other)
+	return d.changes
+}
+
+func (multitenantDirectorSiteCollection *MultitenantDirectorSiteCollection) diff(d *differ, path string, other *MultitenantDirectorSiteCollection) {
+	if diffNil(d, path, multitenantDirectorSiteCollection, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "multitenant_director_sites", false); ok {
+		diffModels(d, fieldPath, multitenantDirectorSiteCollection.MultitenantDirectorSites, other.MultitenantDirectorSites, (*MultitenantDirectorSite).diff)
+	}
+}
+
+// DeepCopy returns a copy of the MultitenantPVDC that shares no memory with it.
+func (multitenantPVDC *MultitenantPVDC) DeepCopy() *MultitenantPVDC {
+	if multitenantPVDC == nil {
+		return nil
+	}
+	out := new(MultitenantPVDC)
+	out.Name = copyPointer(multitenantPVDC.Name)
+	out.ID = copyPointer(multitenantPVDC.ID)
+	out.DataCenterName = copyPointer(multitenantPVDC.DataCenterName)
+	out.PrivateOnly = copyPointer(multitenantPVDC.PrivateOnly)
+	out.ProviderTypes = copyModels(multitenantPVDC.ProviderTypes, (*ProviderType).DeepCopy)
+	return out
+}
+
+// Equal returns true if the MultitenantPVDC and "other" have the same fields, apart from those ignored by "options".
+func (multitenantPVDC *MultitenantPVDC) Equal(other *MultitenantPVDC, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	multitenantPVDC.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the MultitenantPVDC that differ in "other", apart from those ignored by "options".
+func (multitenantPVDC *MultitenantPVDC) Diff(other *MultitenantPVDC, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	multitenantPVDC.diff(d, "", other)
+	return d.changes
+}
+
+func (multitenantPVDC *MultitenantPVDC) diff(d *differ, path string, other *MultitenantPVDC) {
+	if diffNil(d, path, multitenantPVDC, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "name", false); ok {
+		diffPointers(d, fieldPath, multitenantPVDC.Name, other.Name)
+	}
+	if fieldPath, ok := d.field(path, "id", false); ok {
+		diffPointers(d, fieldPath, multitenantPVDC.ID, other.ID)
+	}
+	if fieldPath, ok := d.field(path, "data_center_name", false); ok {
+		diffPointers(d, fieldPath, multitenantPVDC.DataCenterName, other.DataCenterName)
+	}
+	if fieldPath, ok := d.field(path, "private_only", false); ok {
+		diffPointers(d, fieldPath, multitenantPVDC.PrivateOnly, other.PrivateOnly)
+	}
+	if fieldPath, ok := d.field(path, "provider_types", false); ok {
+		diffModels(d, fieldPath, multitenantPVDC.ProviderTypes, other.ProviderTypes, (*ProviderType).diff)
+	}
+}
+
+// DeepCopy returns a copy of the OIDC that shares no memory with it.
+func (oIDC *OIDC) DeepCopy() *OIDC {
+	if oIDC == nil {
+		return nil
+	}
+	out := new(OIDC)
+	out.Status = copyPointer(oIDC.Status)
+	out.LastSetAt = copyPointer(oIDC.LastSetAt)
+	return out
+}
+
+// Equal returns true if the OIDC and "other" have the same fields, apart from those ignored by "options".
+func (oIDC *OIDC) Equal(other *OIDC, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	oIDC.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the OIDC that differ in "other", apart from those ignored by "options".
+func (oIDC *OIDC) Diff(other *OIDC, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	oIDC.diff(d, "", other)
+	return d.changes
+}
+
+func (oIDC *OIDC) diff(d *differ, path string, other *OIDC) {
+	if diffNil(d, path, oIDC, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "status", false); ok {
+		diffPointers(d, fieldPath, oIDC.Status, other.Status)
+	}
+	if fieldPath, ok := d.field(path, "last_set_at", true); ok {
+		diffDateTimes(d, fieldPath, oIDC.LastSetAt, other.LastSetAt)
+	}
+}
+
+// DeepCopy returns a copy of the PVDC that shares no memory with it.
+func (pVDC *PVDC) DeepCopy() *PVDC {
+	if pVDC == nil {
+		return nil
+	}
+	out := new(PVDC)
+	out.Name = copyPointer(pVDC.Name)
+	out.DataCenterName = copyPointer(pVDC.DataCenterName)
+	out.ID = copyPointer(pVDC.ID)
+	out.Href = copyPointer(pVDC.Href)
+	out.Clusters = copyModels(pVDC.Clusters, (*ClusterSummary).DeepCopy)
+	out.Status = copyPointer(pVDC.Status)
+	out.ProviderTypes = copyModels(pVDC.ProviderTypes, (*ProviderType).DeepCopy)
+	return out
+}
+
+// Equal returns true if the PVDC and "other" have the same fields, apart from those ignored by "options".
+func (pVDC *PVDC) Equal(other *PVDC, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	pVDC.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the PVDC that differ in "other", apart from those ignored by "options".
+func (pVDC *PVDC) Diff(other *PVDC, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	pVDC.diff(d, "", other)
+	return d.changes
+}
+
+func (pVDC *PVDC) diff(d *differ, path string, other *PVDC) {
+	if diffNil(d, path, pVDC, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "name", false); ok {
+		diffPointers(d, fieldPath, pVDC.Name, other.Name)
+	}
+	if fieldPath, ok := d.field(path, "data_center_name", false); ok {
+		diffPointers(d, fieldPath, pVDC.DataCenterName, other.DataCenterName)
+	}
+	if fieldPath, ok := d.field(path, "id", false); ok {
+		diffPointers(d, fieldPath, pVDC.ID, other.ID)
+	}
+	if fieldPath, ok := d.field(path, "href", true); ok {
+		diffPointers(d, fieldPath, pVDC.Href, other.Href)
+	}
+	if fieldPath, ok := d.field(path, "clusters", false); ok {
+		diffModels(d, fieldPath, pVDC.Clusters, other.Clusters, (*ClusterSummary).diff)
+	}
+	if fieldPath, ok := d.field(path, "status", false); ok {
+		diffPointers(d, fieldPath, pVDC.Status, other.Status)
+	}
+	if fieldPath, ok := d.field(path, "provider_types", false); ok {
+		diffModels(d, fieldPath, pVDC.ProviderTypes, other.ProviderTypes, (*ProviderType).diff)
+	}
+}
+
+// DeepCopy returns a copy of the PVDCCollection that shares no memory with it.
+func (pVDCCollection *PVDCCollection) DeepCopy() *PVDCCollection {
+	if pVDCCollection == nil {
+		return nil
+	}
+	out := new(PVDCCollection)
+	out.Pvdcs = copyModels(pVDCCollection.Pvdcs, (*PVDC).DeepCopy)
+	return out
+}
+
+// Equal returns true if the PVDCCollection and "other" have the same fields, apart from those ignored by "options".
+func (pVDCCollection *PVDCCollection) Equal(other *PVDCCollection, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	pVDCCollection.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the PVDCCollection that differ in "other", apart from those ignored by "options".
+func (pVDCCollection *PVDCCollection) Diff(other *PVDCCollection, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	pVDCCollection.diff(d, "", other)
+	return d.changes
+}
+
+func (pVDCCollection *PVDCCollection) diff(d *differ, path string, other *PVDCCollection) {
+	if diffNil(d, path, pVDCCollection, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "pvdcs", false); ok {
+		diffModels(d, fieldPath, pVDCCollection.Pvdcs, other.Pvdcs, (*PVDC).diff)
+	}
+}
+
+// DeepCopy returns a copy of the PVDCPrototype that shares no memory with it.
+func (pVDCPrototype *PVDCPrototype) DeepCopy() *PVDCPrototype {
+	if pVDCPrototype == nil {
+		return nil
+	}
+	out := new(PVDCPrototype)
+	out.Name = copyPointer(pVDCPrototype.Name)
+	out.DataCenterName = copyPointer(pVDCPrototype.DataCenterName)
+	out.Clusters = copyModels(pVDCPrototype.Clusters, (*ClusterPrototype).DeepCopy)
+	return out
+}
+
+// Equal returns true if the PVDCPrototype and "other" have the same fields, apart from those ignored by "options".
+func (pVDCPrototype *PVDCPrototype) Equal(other *PVDCPrototype, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	pVDCPrototype.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the PVDCPrototype that differ in "other", apart from those ignored by "options".
+func (pVDCPrototype *PVDCPrototype) Diff(other *PVDCPrototype, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	pVDCPrototype.diff(d, "", other)
+	return d.changes
+}
+
+func (pVDCPrototype *PVDCPrototype) diff(d *differ, path string, other *PVDCPrototype) {
+	if diffNil(d, path, pVDCPrototype, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "name", false); ok {
+		diffPointers(d, fieldPath, pVDCPrototype.Name, other.Name)
+	}
+	if fieldPath, ok := d.field(path, "data_center_name", false); ok {
+		diffPointers(d, fieldPath, pVDCPrototype.DataCenterName, other.DataCenterName)
+	}
+	if fieldPath, ok := d.field(path, "clusters", false); ok {
+		diffModels(d, fieldPath, pVDCPrototype.Clusters, other.Clusters, (*ClusterPrototype).diff)
+	}
+}
+
+// DeepCopy returns a copy of the ProviderType that shares no memory with it.
+func (providerType *ProviderType) DeepCopy() *ProviderType {
+	if providerType == nil {
+		return nil
+	}
+	out := new(ProviderType)
+	out.Name = copyPointer(providerType.Name)
+	return out
+}
+
+// Equal returns true if the ProviderType and "other" have the same fields, apart from those ignored by "options".
+func (providerType *ProviderType) Equal(other *ProviderType, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	providerType.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the ProviderType that differ in "other", apart from those ignored by "options".
+func (providerType *ProviderType) Diff(other *ProviderType, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	providerType.diff(d, "", other)
+	return d.changes
+}
+
+func (providerType *ProviderType) diff(d *differ, path string, other *ProviderType) {
+	if diffNil(d, path, providerType, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "name", false); ok {
+		diffPointers(d, fieldPath, providerType.Name, other.Name)
+	}
+}
+
+// DeepCopy returns a copy of the ResourceGroupIdentity that shares no memory with it.
+func (resourceGroupIdentity *ResourceGroupIdentity) DeepCopy() *ResourceGroupIdentity {
+	if resourceGroupIdentity == nil {
+		return nil
+	}
+	out := new(ResourceGroupIdentity)
+	out.ID = copyPointer(resourceGroupIdentity.ID)
+	return out
+}
+
+// Equal returns true if the ResourceGroupIdentity and "other" have the same fields, apart from those ignored by "options".
+func (resourceGroupIdentity *ResourceGroupIdentity) Equal(other *ResourceGroupIdentity, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	resourceGroupIdentity.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the ResourceGroupIdentity that differ in "other", apart from those ignored by "options".
+func (resourceGroupIdentity *ResourceGroupIdentity) Diff(other *ResourceGroupIdentity, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	resourceGroupIdentity.diff(d, "", other)
+	return d.changes
+}
+
+func (resourceGroupIdentity *ResourceGroupIdentity) diff(d *differ, path string, other *ResourceGroupIdentity) {
+	if diffNil(d, path, resourceGroupIdentity, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "id", false); ok {
+		diffPointers(d, fieldPath, resourceGroupIdentity.ID, other.ID)
+	}
+}
+
+// DeepCopy returns a copy of the ResourceGroupReference that shares no memory with it.
+func (resourceGroupReference *ResourceGroupReference) DeepCopy() *ResourceGroupReference {
+	if resourceGroupReference == nil {
+		return nil
+	}
+	out := new(ResourceGroupReference)
+	out.ID = copyPointer(resourceGroupReference.ID)
+	out.Name = copyPointer(resourceGroupReference.Name)
+	out.Crn = copyPointer(resourceGroupReference.Crn)
+	return out
+}
+
+// Equal returns true if the ResourceGroupReference and "other" have the same fields, apart from those ignored by "options".
+func (resourceGroupReference *ResourceGroupReference) Equal(other *ResourceGroupReference, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	resourceGroupReference.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the ResourceGroupReference that differ in "other", apart from those ignored by "options".
+func (resourceGroupReference *ResourceGroupReference) Diff(other *ResourceGroupReference, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	resourceGroupReference.diff(d, "", other)
+	return d.changes
+}
+
+func (resourceGroupReference *ResourceGroupReference) diff(d *differ, path string, other *ResourceGroupReference) {
+	if diffNil(d, path, resourceGroupReference, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "id", false); ok {
+		diffPointers(d, fieldPath, resourceGroupReference.ID, other.ID)
+	}
+	if fieldPath, ok := d.field(path, "name", false); ok {
+		diffPointers(d, fieldPath, resourceGroupReference.Name, other.Name)
+	}
+	if fieldPath, ok := d.field(path, "crn", false); ok {
+		diffPointers(d, fieldPath, resourceGroupReference.Crn, other.Crn)
+	}
+}
+
+// DeepCopy returns a copy of the Service that shares no memory with it.
+func (service *Service) DeepCopy() *Service {
+	if service == nil {
+		return nil
+	}
+	out := new(Service)
+	out.Name = copyPointer(service.Name)
+	out.ID = copyPointer(service.ID)
+	out.OrderedAt = copyPointer(service.OrderedAt)
+	out.ProvisionedAt = copyPointer(service.ProvisionedAt)
+	out.Status = copyPointer(service.Status)
+	out.ConsoleURL = copyPointer(service.ConsoleURL)
+	out.Replicators = copyPointer(service.Replicators)
+	out.Connections = copyModels(service.Connections, (*VcdaConnection).DeepCopy)
+	out.Sobrs = copyModels(service.Sobrs, (*Sobr).DeepCopy)
+	return out
+}
+
+// Equal returns true if the Service and "other" have the same fields, apart from those ignored by "options".
+func (service *Service) Equal(other *Service, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	service.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the Service that differ in "other", apart from those ignored by "options".
+func (service *Service) Diff(other *Service, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	service.diff(d, "", other)
+	return d.changes
+}
+
+func (service *Service) diff(d *differ, path string, other *Service) {
+	if diffNil(d, path, service, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "name", false); ok {
+		diffPointers(d, fieldPath, service.Name, other.Name)
+	}
+	if fieldPath, ok := d.field(path, "id", false); ok {
+		diffPointers(d, fieldPath, service.ID, other.ID)
+	}
+	if fieldPath, ok := d.field(path, "ordered_at", true); ok {
+		diffDateTimes(d, fieldPath, service.OrderedAt, other.OrderedAt)
+	}
+	if fieldPath, ok := d.field(path, "provisioned_at", true); ok {
+		diffDateTimes(d, fieldPath, service.ProvisionedAt, other.ProvisionedAt)
+	}
+	if fieldPath, ok := d.field(path, "status", false); ok {
+		diffPointers(d, fieldPath, service.Status, other.Status)
+	}
+	if fieldPath, ok := d.field(path, "console_url", false); ok {
+		diffPointers(d, fieldPath, service.ConsoleURL, other.ConsoleURL)
+	}
+	if fieldPath, ok := d.field(path, "replicators", false); ok {
+		diffPointers(d, fieldPath, service.Replicators, other.Replicators)
+	}
+	if fieldPath, ok := d.field(path, "connections", false); ok {
+		diffModels(d, fieldPath, service.Connections, other.Connections, (*VcdaConnection).diff)
+	}
+	if fieldPath, ok := d.field(path, "sobrs", false); ok {
+		diffModels(d, fieldPath, service.Sobrs, other.Sobrs, (*Sobr).diff)
+	}
+}
+
+// DeepCopy returns a copy of the ServiceEnabled that shares no memory with it.
+func (serviceEnabled *ServiceEnabled) DeepCopy() *ServiceEnabled {
+	if serviceEnabled == nil {
+		return nil
+	}
+	out := new(ServiceEnabled)
+	out.Message = copyPointer(serviceEnabled.Message)
+	return out
+}
+
+// Equal returns true if the ServiceEnabled and "other" have the same fields, apart from those ignored by "options".
+func (serviceEnabled *ServiceEnabled) Equal(other *ServiceEnabled, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	serviceEnabled.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the ServiceEnabled that differ in "other", apart from those ignored by "options".
+func (serviceEnabled *ServiceEnabled) Diff(other *ServiceEnabled, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	serviceEnabled.diff(d, "", other)
+	return d.changes
+}
+
+func (serviceEnabled *ServiceEnabled) diff(d *differ, path string, other *ServiceEnabled) {
+	if diffNil(d, path, serviceEnabled, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "message", false); ok {
+		diffPointers(d, fieldPath, serviceEnabled.Message, other.Message)
+	}
+}
+
+// DeepCopy returns a copy of the ServiceIdentity that shares no memory with it.
+func (serviceIdentity *ServiceIdentity) DeepCopy() *ServiceIdentity {
+	if serviceIdentity == nil {
+		return nil
+	}
+	out := new(ServiceIdentity)
+	out.Name = copyPointer(serviceIdentity.Name)
+	return out
+}
+
+// Equal returns true if the ServiceIdentity and "other" have the same fields, apart from those ignored by "options".
+func (serviceIdentity *ServiceIdentity) Equal(other *ServiceIdentity, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	serviceIdentity.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the ServiceIdentity that differ in "other", apart from those ignored by "options".
+func (serviceIdentity *ServiceIdentity) Diff(other *ServiceIdentity, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	serviceIdentity.diff(d, "", other)
+	return d.changes
+}
+
+func (serviceIdentity *ServiceIdentity) diff(d *differ, path string, other *ServiceIdentity) {
+	if diffNil(d, path, serviceIdentity, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "name", false); ok {
+		diffPointers(d, fieldPath, serviceIdentity.Name, other.Name)
+	}
+}
+
+// DeepCopy returns a copy of the Sobr that shares no memory with it.
+func (sobr *Sobr) DeepCopy() *Sobr {
+	if sobr == nil {
+		return nil
+	}
+	out := new(Sobr)
+	out.ID = copyPointer(sobr.ID)
+	out.Name = copyPointer(sobr.Name)
+	out.Size = copyPointer(sobr.Size)
+	out.DataCenter = copyPointer(sobr.DataCenter)
+	out.ImmutabilityTime = copyPointer(sobr.ImmutabilityTime)
+	out.StorageType = copyPointer(sobr.StorageType)
+	out.Type = copyPointer(sobr.Type)
+	out.VeeamOrgConfigID = copyPointer(sobr.VeeamOrgConfigID)
+	out.Status = copyPointer(sobr.Status)
+	out.CreatedAt = copyPointer(sobr.CreatedAt)
+	return out
+}
+
+// Equal returns true if the Sobr and "other" have the same fields, apart from those ignored by "options".
+func (sobr *Sobr) Equal(other *Sobr, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	sobr.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the Sobr that differ in "other", apart from those ignored by "options".
+func (sobr *Sobr) Diff(other *Sobr, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	sobr.diff(d, "", other)
+	return d.changes
+}
+
+func (sobr *Sobr) diff(d *differ, path string, other *Sobr) {
+	if diffNil(d, path, sobr, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "id", false); ok {
+		diffPointers(d, fieldPath, sobr.ID, other.ID)
+	}
+	if fieldPath, ok := d.field(path, "name", false); ok {
+		diffPointers(d, fieldPath, sobr.Name, other.Name)
+	}
+	if fieldPath, ok := d.field(path, "size", false); ok {
+		diffPointers(d, fieldPath, sobr.Size, other.Size)
+	}
+	if fieldPath, ok := d.field(path, "data_center", false); ok {
+		diffPointers(d, fieldPath, sobr.DataCenter, other.DataCenter)
+	}
+	if fieldPath, ok := d.field(path, "immutability_time", false); ok {
+		diffPointers(d, fieldPath, sobr.ImmutabilityTime, other.ImmutabilityTime)
+	}
+	if fieldPath, ok := d.field(path, "storage_type", false); ok {
+		diffPointers(d, fieldPath, sobr.StorageType, other.StorageType)
+	}
+	if fieldPath, ok := d.field(path, "type", false); ok {
+		diffPointers(d, fieldPath, sobr.Type, other.Type)
+	}
+	if fieldPath, ok := d.field(path, "veeam_org_config_id", false); ok {
+		diffPointers(d, fieldPath, sobr.VeeamOrgConfigID, other.VeeamOrgConfigID)
+	}
+	if fieldPath, ok := d.field(path, "status", false); ok {
+		diffPointers(d, fieldPath, sobr.Status, other.Status)
+	}
+	if fieldPath, ok := d.field(path, "created_at", true); ok {
+		diffDateTimes(d, fieldPath, sobr.CreatedAt, other.CreatedAt)
+	}
+}
+
+// DeepCopy returns a copy of the StatusReason that shares no memory with it.
+func (statusReason *StatusReason) DeepCopy() *StatusReason {
+	if statusReason == nil {
+		return nil
+	}
+	out := new(StatusReason)
+	out.Code = copyPointer(statusReason.Code)
+	out.Message = copyPointer(statusReason.Message)
+	out.MoreInfo = copyPointer(statusReason.MoreInfo)
+	return out
+}
+
+// Equal returns true if the StatusReason and "other" have the same fields, apart from those ignored by "options".
+func (statusReason *StatusReason) Equal(other *StatusReason, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	statusReason.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the StatusReason that differ in "other", apart from those ignored by "options".
+func (statusReason *StatusReason) Diff(other *StatusReason, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	statusReason.diff(d, "", other)
+	return d.changes
+}
+
+func (statusReason *StatusReason) diff(d *differ, path string, other *StatusReason) {
+	if diffNil(d, path, statusReason, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "code", false); ok {
+		diffPointers(d, fieldPath, statusReason.Code, other.Code)
+	}
+	if fieldPath, ok := d.field(path, "message", false); ok {
+		diffPointers(d, fieldPath, statusReason.Message, other.Message)
+	}
+	if fieldPath, ok := d.field(path, "more_info", false); ok {
+		diffPointers(d, fieldPath, statusReason.MoreInfo, other.MoreInfo)
+	}
+}
+
+// DeepCopy returns a copy of the SwapHaEdgeSitesResponse that shares no memory with it.
+func (swapHaEdgeSitesResponse *SwapHaEdgeSitesResponse) DeepCopy() *SwapHaEdgeSitesResponse {
+	if swapHaEdgeSitesResponse == nil {
+		return nil
+	}
+	out := new(SwapHaEdgeSitesResponse)
+	out.Message = copyPointer(swapHaEdgeSitesResponse.Message)
+	return out
+}
+
+// Equal returns true if the SwapHaEdgeSitesResponse and "other" have the same fields, apart from those ignored by "options".
+func (swapHaEdgeSitesResponse *SwapHaEdgeSitesResponse) Equal(other *SwapHaEdgeSitesResponse, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	swapHaEdgeSitesResponse.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the SwapHaEdgeSitesResponse that differ in "other", apart from those ignored by "options".
+func (swapHaEdgeSitesResponse *SwapHaEdgeSitesResponse) Diff(other *SwapHaEdgeSitesResponse, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	swapHaEdgeSitesResponse.diff(d, "", other)
+	return d.changes
+}
+
+func (swapHaEdgeSitesResponse *SwapHaEdgeSitesResponse) diff(d *differ, path string, other *SwapHaEdgeSitesResponse) {
+	if diffNil(d, path, swapHaEdgeSitesResponse, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "message", false); ok {
+		diffPointers(d, fieldPath, swapHaEdgeSitesResponse.Message, other.Message)
+	}
+}
+
+// DeepCopy returns a copy of the TransitGateway that shares no memory with it.
+func (transitGateway *TransitGateway) DeepCopy() *TransitGateway {
+	if transitGateway == nil {
+		return nil
+	}
+	out := new(TransitGateway)
+	out.ID = copyPointer(transitGateway.ID)
+	out.Connections = copyModels(transitGateway.Connections, (*TransitGatewayConnection).DeepCopy)
+	out.Status = copyPointer(transitGateway.Status)
+	out.Region = copyPointer(transitGateway.Region)
+	return out
+}
+
+// Equal returns true if the TransitGateway and "other" have the same fields, apart from those ignored by "options".
+func (transitGateway *TransitGateway) Equal(other *TransitGateway, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	transitGateway.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the TransitGateway that differ in "other", apart from those ignored by "options".
+func (transitGateway *TransitGateway) Diff(other *TransitGateway, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	transitGateway.diff(d, "", other)
+	return d.changes
+}
+
+func (transitGateway *TransitGateway) diff(d *differ, path string, other *TransitGateway) {
+	if diffNil(d, path, transitGateway, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "id", false); ok {
+		diffPointers(d, fieldPath, transitGateway.ID, other.ID)
+	}
+	if fieldPath, ok := d.field(path, "connections", false); ok {
+		diffModels(d, fieldPath, transitGateway.Connections, other.Connections, (*TransitGatewayConnection).diff)
+	}
+	if fieldPath, ok := d.field(path, "status", false); ok {
+		diffPointers(d, fieldPath, transitGateway.Status, other.Status)
+	}
+	if fieldPath, ok := d.field(path, "region", false); ok {
+		diffPointers(d, fieldPath, transitGateway.Region, other.Region)
+	}
+}
+
+// DeepCopy returns a copy of the TransitGatewayConnection that shares no memory with it.
+func (transitGatewayConnection *TransitGatewayConnection) DeepCopy() *TransitGatewayConnection {
+	if transitGatewayConnection == nil {
+		return nil
+	}
+	out := new(TransitGatewayConnection)
+	out.Name = copyPointer(transitGatewayConnection.Name)
+	out.TransitGatewayConnectionName = copyPointer(transitGatewayConnection.TransitGatewayConnectionName)
+	out.Status = copyPointer(transitGatewayConnection.Status)
+	out.LocalGatewayIp = copyPointer(transitGatewayConnection.LocalGatewayIp)
+	out.RemoteGatewayIp = copyPointer(transitGatewayConnection.RemoteGatewayIp)
+	out.LocalTunnelIp = copyPointer(transitGatewayConnection.LocalTunnelIp)
+	out.RemoteTunnelIp = copyPointer(transitGatewayConnection.RemoteTunnelIp)
+	out.LocalBgpAsn = copyPointer(transitGatewayConnection.LocalBgpAsn)
+	out.RemoteBgpAsn = copyPointer(transitGatewayConnection.RemoteBgpAsn)
+	out.NetworkAccountID = copyPointer(transitGatewayConnection.NetworkAccountID)
+	out.NetworkType = copyPointer(transitGatewayConnection.NetworkType)
+	out.BaseNetworkType = copyPointer(transitGatewayConnection.BaseNetworkType)
+	out.Zone = copyPointer(transitGatewayConnection.Zone)
+	return out
+}
+
+// Equal returns true if the TransitGatewayConnection and "other" have the same fields, apart from those ignored by "options".
+func (transitGatewayConnection *TransitGatewayConnection) Equal(other *TransitGatewayConnection, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	transitGatewayConnection.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the TransitGatewayConnection that differ in "other", apart from those ignored by "options".
+func (transitGatewayConnection *TransitGatewayConnection) Diff(other *TransitGatewayConnection, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	transitGatewayConnection.diff(d, "", other)
+	return d.changes
+}
+
+func (transitGatewayConnection *TransitGatewayConnection) diff(d *differ, path string, other *TransitGatewayConnection) {
+	if diffNil(d, path, transitGatewayConnection, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "name", false); ok {
+		diffPointers(d, fieldPath, transitGatewayConnection.Name, other.Name)
+	}
+	if fieldPath, ok := d.field(path, "transit_gateway_connection_name", false); ok {
+		diffPointers(d, fieldPath, transitGatewayConnection.TransitGatewayConnectionName, other.TransitGatewayConnectionName)
+	}
+	if fieldPath, ok := d.field(path, "status", false); ok {
+		diffPointers(d, fieldPath, transitGatewayConnection.Status, other.Status)
+	}
+	if fieldPath, ok := d.field(path, "local_gateway_ip", false); ok {
+		diffPointers(d, fieldPath, transitGatewayConnection.LocalGatewayIp, other.LocalGatewayIp)
+	}
+	if fieldPath, ok := d.field(path, "remote_gateway_ip", false); ok {
+		diffPointers(d, fieldPath, transitGatewayConnection.RemoteGatewayIp, other.RemoteGatewayIp)
+	}
+	if fieldPath, ok := d.field(path, "local_tunnel_ip", false); ok {
+		diffPointers(d, fieldPath, transitGatewayConnection.LocalTunnelIp, other.LocalTunnelIp)
+	}
+	if fieldPath, ok := d.field(path, "remote_tunnel_ip", false); ok {
+		diffPointers(d, fieldPath, transitGatewayConnection.RemoteTunnelIp, other.RemoteTunnelIp)
+	}
+	if fieldPath, ok := d.field(path, "local_bgp_asn", false); ok {
+		diffPointers(d, fieldPath, transitGatewayConnection.LocalBgpAsn, other.LocalBgpAsn)
+	}
+	if fieldPath, ok := d.field(path, "remote_bgp_asn", false); ok {
+		diffPointers(d, fieldPath, transitGatewayConnection.RemoteBgpAsn, other.RemoteBgpAsn)
+	}
+	if fieldPath, ok := d.field(path, "network_account_id", false); ok {
+		diffPointers(d, fieldPath, transitGatewayConnection.NetworkAccountID, other.NetworkAccountID)
+	}
+	if fieldPath, ok := d.field(path, "network_type", false); ok {
+		diffPointers(d, fieldPath, transitGatewayConnection.NetworkType, other.NetworkType)
+	}
+	if fieldPath, ok := d.field(path, "base_network_type", false); ok {
+		diffPointers(d, fieldPath, transitGatewayConnection.BaseNetworkType, other.BaseNetworkType)
+	}
+	if fieldPath, ok := d.field(path, "zone", false); ok {
+		diffPointers(d, fieldPath, transitGatewayConnection.Zone, other.Zone)
+	}
+}
+
+// DeepCopy returns a copy of the UpdateCluster that shares no memory with it.
+func (updateCluster *UpdateCluster) DeepCopy() *UpdateCluster {
+	if updateCluster == nil {
+		return nil
+	}
+	out := new(UpdateCluster)
+	out.ID = copyPointer(updateCluster.ID)
+	out.Name = copyPointer(updateCluster.Name)
+	out.Href = copyPointer(updateCluster.Href)
+	out.OrderedAt = copyPointer(updateCluster.OrderedAt)
+	out.ProvisionedAt = copyPointer(updateCluster.ProvisionedAt)
+	out.HostCount = copyPointer(updateCluster.HostCount)
+	out.Status = copyPointer(updateCluster.Status)
+	out.DataCenterName = copyPointer(updateCluster.DataCenterName)
+	out.DirectorSite = updateCluster.DirectorSite.DeepCopy()
+	out.HostProfile = copyPointer(updateCluster.HostProfile)
+	out.StorageType = copyPointer(updateCluster.StorageType)
+	out.BillingPlan = copyPointer(updateCluster.BillingPlan)
+	out.FileShares = updateCluster.FileShares.DeepCopy()
+	out.Message = copyPointer(updateCluster.Message)
+	out.OperationID = copyPointer(updateCluster.OperationID)
+	return out
+}
+
+// Equal returns true if the UpdateCluster and "other" have the same fields, apart from those ignored by "options".
+func (updateCluster *UpdateCluster) Equal(other *UpdateCluster, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	updateCluster.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the UpdateCluster that differ in "other", apart from those ignored by "options".
+func (updateCluster *UpdateCluster) Diff(other *UpdateCluster, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	updateCluster.diff(d, "", other)
+	return d.changes
+}
+
+func (updateCluster *UpdateCluster) diff(d *differ, path string, other *UpdateCluster) {
+	if diffNil(d, path, updateCluster, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "id", false); ok {
+		diffPointers(d, fieldPath, updateCluster.ID, other.ID)
+	}
+	if fieldPath, ok := d.field(path, "name", false); ok {
+		diffPointers(d, fieldPath, updateCluster.Name, other.Name)
+	}
+	if fieldPath, ok := d.field(path, "href", true); ok {
+		diffPointers(d, fieldPath, updateCluster.Href, other.Href)
+	}
+	if fieldPath, ok := d.field(path, "ordered_at", true); ok {
+		diffDateTimes(d, fieldPath, updateCluster.OrderedAt, other.OrderedAt)
+	}
+	if fieldPath, ok := d.field(path, "provisioned_at", true); ok {
+		diffDateTimes(d, fieldPath, updateCluster.ProvisionedAt, other.ProvisionedAt)
+	}
+	if fieldPath, ok := d.field(path, "host_count", false); ok {
+		diffPointers(d, fieldPath, updateCluster.HostCount, other.HostCount)
+	}
+	if fieldPath, ok := d.field(path, "status", false); ok {
+		diffPointers(d, fieldPath, updateCluster.Status, other.Status)
+	}
+	if fieldPath, ok := d.field(path, "data_center_name", false); ok {
+		diffPointers(d, fieldPath, updateCluster.DataCenterName, other.DataCenterName)
+	}
+	if fieldPath, ok := d.field(path, "director_site", false); ok {
+		updateCluster.DirectorSite.diff(d, fieldPath, other.DirectorSite)
+	}
+	if fieldPath, ok := d.field(path, "host_profile", false); ok {
+		diffPointers(d, fieldPath, updateCluster.HostProfile, other.HostProfile)
+	}
+	if fieldPath, ok := d.field(path, "storage_type", false); ok {
+		diffPointers(d, fieldPath, updateCluster.StorageType, other.StorageType)
+	}
+	if fieldPath, ok := d.field(path, "billing_plan", false); ok {
+		diffPointers(d, fieldPath, updateCluster.BillingPlan, other.BillingPlan)
+	}
+	if fieldPath, ok := d.field(path, "file_shares", false); ok {
+		updateCluster.FileShares.diff(d, fieldPath, other.FileShares)
+	}
+	if fieldPath, ok := d.field(path, "message", false); ok {
+		diffPointers(d, fieldPath, updateCluster.Message, other.Message)
+	}
+	if fieldPath, ok := d.field(path, "operation_id", false); ok {
+		diffPointers(d, fieldPath, updateCluster.OperationID, other.OperationID)
+	}
+}
+
+// DeepCopy returns a copy of the UpdatedVcdaC2c that shares no memory with it.
+func (updatedVcdaC2c *UpdatedVcdaC2c) DeepCopy() *UpdatedVcdaC2c {
+	if updatedVcdaC2c == nil {
+		return nil
+	}
+	out := new(UpdatedVcdaC2c)
+	out.ID = copyPointer(updatedVcdaC2c.ID)
+	out.Note = copyPointer(updatedVcdaC2c.Note)
+	return out
+}
+
+// Equal returns true if the UpdatedVcdaC2c and "other" have the same fields, apart from those ignored by "options".
+func (updatedVcdaC2c *UpdatedVcdaC2c) Equal(other *UpdatedVcdaC2c, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	updatedVcdaC2c.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the UpdatedVcdaC2c that differ in "other", apart from those ignored by "options".
+func (updatedVcdaC2c *UpdatedVcdaC2c) Diff(other *UpdatedVcdaC2c, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	updatedVcdaC2c.diff(d, "", other)
+	return d.changes
+}
+
+func (updatedVcdaC2c *UpdatedVcdaC2c) diff(d *differ, path string, other *UpdatedVcdaC2c) {
+	if diffNil(d, path, updatedVcdaC2c, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "id", false); ok {
+		diffPointers(d, fieldPath, updatedVcdaC2c.ID, other.ID)
+	}
+	if fieldPath, ok := d.field(path, "note", false); ok {
+		diffPointers(d, fieldPath, updatedVcdaC2c.Note, other.Note)
+	}
+}
+
+// DeepCopy returns a copy of the UpdatedVcdaConnection that shares no memory with it.
+func (updatedVcdaConnection *UpdatedVcdaConnection) DeepCopy() *UpdatedVcdaConnection {
+	if updatedVcdaConnection == nil {
+		return nil
+	}
+	out := new(UpdatedVcdaConnection)
+	out.ID = copyPointer(updatedVcdaConnection.ID)
+	out.Status = copyPointer(updatedVcdaConnection.Status)
+	return out
+}
+
+// Equal returns true if the UpdatedVcdaConnection and "other" have the same fields, apart from those ignored by "options".
+func (updatedVcdaConnection *UpdatedVcdaConnection) Equal(other *UpdatedVcdaConnection, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	updatedVcdaConnection.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the UpdatedVcdaConnection that differ in "other", apart from those ignored by "options".
+func (updatedVcdaConnection *UpdatedVcdaConnection) Diff(other *UpdatedVcdaConnection, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	updatedVcdaConnection.diff(d, "", other)
+	return d.changes
+}
+
+func (updatedVcdaConnection *UpdatedVcdaConnection) diff(d *differ, path string, other *UpdatedVcdaConnection) {
+	if diffNil(d, path, updatedVcdaConnection, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "id", false); ok {
+		diffPointers(d, fieldPath, updatedVcdaConnection.ID, other.ID)
+	}
+	if fieldPath, ok := d.field(path, "status", false); ok {
+		diffPointers(d, fieldPath, updatedVcdaConnection.Status, other.Status)
+	}
+}
+
+// DeepCopy returns a copy of the UsageMeter that shares no memory with it.
+func (usageMeter *UsageMeter) DeepCopy() *UsageMeter {
+	if usageMeter == nil {
+		return nil
+	}
+	out := new(UsageMeter)
+	out.ID = copyPointer(usageMeter.ID)
+	out.Health = copyPointer(usageMeter.Health)
+	out.Version = copyPointer(usageMeter.Version)
+	return out
+}
+
+// Equal returns true if the UsageMeter and "other" have the same fields, apart from those ignored by "options".
+func (usageMeter *UsageMeter) Equal(other *UsageMeter, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	usageMeter.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the UsageMeter that differ in "other", apart from those ignored by "options".
+func (usageMeter *UsageMeter) Diff(other *UsageMeter, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	usageMeter.diff(d, "", other)
+	return d.changes
+}
+
+func (usageMeter *UsageMeter) diff(d *differ, path string, other *UsageMeter) {
+	if diffNil(d, path, usageMeter, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "id", false); ok {
+		diffPointers(d, fieldPath, usageMeter.ID, other.ID)
+	}
+	if fieldPath, ok := d.field(path, "health", false); ok {
+		diffPointers(d, fieldPath, usageMeter.Health, other.Health)
+	}
+	if fieldPath, ok := d.field(path, "version", false); ok {
+		diffPointers(d, fieldPath, usageMeter.Version, other.Version)
+	}
+}
+
+// DeepCopy returns a copy of the UsageMeterIdentity that shares no memory with it.
+func (usageMeterIdentity *UsageMeterIdentity) DeepCopy() *UsageMeterIdentity {
+	if usageMeterIdentity == nil {
+		return nil
+	}
+	out := new(UsageMeterIdentity)
+	out.ID = copyPointer(usageMeterIdentity.ID)
+	return out
+}
+
+// Equal returns true if the UsageMeterIdentity and "other" have the same fields, apart from those ignored by "options".
+func (usageMeterIdentity *UsageMeterIdentity) Equal(other *UsageMeterIdentity, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	usageMeterIdentity.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the UsageMeterIdentity that differ in "other", apart from those ignored by "options".
+func (usageMeterIdentity *UsageMeterIdentity) Diff(other *UsageMeterIdentity, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	usageMeterIdentity.diff(d, "", other)
+	return d.changes
+}
+
+func (usageMeterIdentity *UsageMeterIdentity) diff(d *differ, path string, other *UsageMeterIdentity) {
+	if diffNil(d, path, usageMeterIdentity, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "id", false); ok {
+		diffPointers(d, fieldPath, usageMeterIdentity.ID, other.ID)
+	}
+}
+
+// DeepCopy returns a copy of the UsageMeterRegistration that shares no memory with it.
+func (usageMeterRegistration *UsageMeterRegistration) DeepCopy() *UsageMeterRegistration {
+	if usageMeterRegistration == nil {
+		return nil
+	}
+	out := new(UsageMeterRegistration)
+	out.ID = copyPointer(usageMeterRegistration.ID)
+	out.Crn = copyPointer(usageMeterRegistration.Crn)
+	out.AccessToken = copyPointer(usageMeterRegistration.AccessToken)
+	out.Name = copyPointer(usageMeterRegistration.Name)
+	out.Status = copyPointer(usageMeterRegistration.Status)
+	out.UsageMeter = usageMeterRegistration.UsageMeter.DeepCopy()
+	out.Locked = copyPointer(usageMeterRegistration.Locked)
+	out.CreatedAt = copyPointer(usageMeterRegistration.CreatedAt)
+	out.Href = copyPointer(usageMeterRegistration.Href)
+	return out
+}
+
+// Equal returns true if the UsageMeterRegistration and "other" have the same fields, apart from those ignored by "options".
+func (usageMeterRegistration *UsageMeterRegistration) Equal(other *UsageMeterRegistration, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	usageMeterRegistration.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the UsageMeterRegistration that differ in "other", apart from those ignored by "options".
+func (usageMeterRegistration *UsageMeterRegistration) Diff(other *UsageMeterRegistration, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	usageMeterRegistration.diff(d, "", other)
+	return d.changes
+}
+
+func (usageMeterRegistration *UsageMeterRegistration) diff(d *differ, path string, other *UsageMeterRegistration) {
+	if diffNil(d, path, usageMeterRegistration, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "id", false); ok {
+		diffPointers(d, fieldPath, usageMeterRegistration.ID, other.ID)
+	}
+	if fieldPath, ok := d.field(path, "crn", false); ok {
+		diffPointers(d, fieldPath, usageMeterRegistration.Crn, other.Crn)
+	}
+	if fieldPath, ok := d.field(path, "access_token", false); ok {
+		diffPointers(d, fieldPath, usageMeterRegistration.AccessToken, other.AccessToken)
+	}
+	if fieldPath, ok := d.field(path, "name", false); ok {
+		diffPointers(d, fieldPath, usageMeterRegistration.Name, other.Name)
+	}
+	if fieldPath, ok := d.field(path, "status", false); ok {
+		diffPointers(d, fieldPath, usageMeterRegistration.Status, other.Status)
+	}
+	if fieldPath, ok := d.field(path, "usage_meter", false); ok {
+		usageMeterRegistration.UsageMeter.diff(d, fieldPath, other.UsageMeter)
+	}
+	if fieldPath, ok := d.field(path, "locked", false); ok {
+		diffPointers(d, fieldPath, usageMeterRegistration.Locked, other.Locked)
+	}
+	if fieldPath, ok := d.field(path, "created_at", true); ok {
+		diffDateTimes(d, fieldPath, usageMeterRegistration.CreatedAt, other.CreatedAt)
+	}
+	if fieldPath, ok := d.field(path, "href", true); ok {
+		diffPointers(d, fieldPath, usageMeterRegistration.Href, other.Href)
+	}
+}
+
+// DeepCopy returns a copy of the UsageMeterRegistrationCollection that shares no memory with it.
+func (usageMeterRegistrationCollection *UsageMeterRegistrationCollection) DeepCopy() *UsageMeterRegistrationCollection {
+	if usageMeterRegistrationCollection == nil {
+		return nil
+	}
+	out := new(UsageMeterRegistrationCollection)
+	out.UsageMeterRegistrations = copyModels(usageMeterRegistrationCollection.UsageMeterRegistrations, (*UsageMeterRegistration).DeepCopy)
+	return out
+}
+
+// Equal returns true if the UsageMeterRegistrationCollection and "other" have the same fields, apart from those ignored by "options".
+func (usageMeterRegistrationCollection *UsageMeterRegistrationCollection) Equal(other *UsageMeterRegistrationCollection, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	usageMeterRegistrationCollection.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the UsageMeterRegistrationCollection that differ in "other", apart from those ignored by "options".
+func (usageMeterRegistrationCollection *UsageMeterRegistrationCollection) Diff(other *UsageMeterRegistrationCollection, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	usageMeterRegistrationCollection.diff(d, "", other)
+	return d.changes
+}
+
+func (usageMeterRegistrationCollection *UsageMeterRegistrationCollection) diff(d *differ, path string, other *UsageMeterRegistrationCollection) {
+	if diffNil(d, path, usageMeterRegistrationCollection, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "usage_meter_registrations", false); ok {
+		diffModels(d, fieldPath, usageMeterRegistrationCollection.UsageMeterRegistrations, other.UsageMeterRegistrations, (*UsageMeterRegistration).diff)
+	}
+}
+
+// DeepCopy returns a copy of the VDC that shares no memory with it.
+func (vDC *VDC) DeepCopy() *VDC {
+	if vDC == nil {
+		return nil
+	}
+	out := new(VDC)
+	out.Href = copyPointer(vDC.Href)
+	out.ID = copyPointer(vDC.ID)
+	out.ProvisionedAt = copyPointer(vDC.ProvisionedAt)
+	out.Cpu = copyPointer(vDC.Cpu)
+	out.Crn = copyPointer(vDC.Crn)
+	out.DeletedAt = copyPointer(vDC.DeletedAt)
+	out.Ha = copyPointer(vDC.Ha)
+	out.DirectorSite = vDC.DirectorSite.DeepCopy()
+	out.Edges = copyModels(vDC.Edges, (*Edge).DeepCopy)
+	out.StatusReasons = copyModels(vDC.StatusReasons, (*StatusReason).DeepCopy)
+	out.Name = copyPointer(vDC.Name)
+	out.OrderedAt = copyPointer(vDC.OrderedAt)
+	out.OrgHref = copyPointer(vDC.OrgHref)
+	out.OrgName = copyPointer(vDC.OrgName)
+	out.Ram = copyPointer(vDC.Ram)
+	out.Status = copyPointer(vDC.Status)
+	out.Type = copyPointer(vDC.Type)
+	out.FastProvisioningEnabled = copyPointer(vDC.FastProvisioningEnabled)
+	out.RhelByol = copyPointer(vDC.RhelByol)
+	out.WindowsByol = copyPointer(vDC.WindowsByol)
+	return out
+}
+
+// Equal returns true if the VDC and "other" have the same fields, apart from those ignored by "options".
+func (vDC *VDC) Equal(other *VDC, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	vDC.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the VDC that differ in "other", apart from those ignored by "options".
+func (vDC *VDC) Diff(other *VDC, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	vDC.diff(d, "", other)
+	return d.changes
+}
+
+func (vDC *VDC) diff(d *differ, path string, other *VDC) {
+	if diffNil(d, path, vDC, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "href", true); ok {
+		diffPointers(d, fieldPath, vDC.Href, other.Href)
+	}
+	if fieldPath, ok := d.field(path, "id", false); ok {
+		diffPointers(d, fieldPath, vDC.ID, other.ID)
+	}
+	if fieldPath, ok := d.field(path, "provisioned_at", true); ok {
+		diffDateTimes(d, fieldPath, vDC.ProvisionedAt, other.ProvisionedAt)
+	}
+	if fieldPath, ok := d.field(path, "cpu", false); ok {
+		diffPointers(d, fieldPath, vDC.Cpu, other.Cpu)
+	}
+	if fieldPath, ok := d.field(path, "crn", false); ok {
+		diffPointers(d, fieldPath, vDC.Crn, other.Crn)
+	}
+	if fieldPath, ok := d.field(path, "deleted_at", true); ok {
+		diffDateTimes(d, fieldPath, vDC.DeletedAt, other.DeletedAt)
+	}
+	if fieldPath, ok := d.field(path, "ha", false); ok {
+		diffPointers(d, fieldPath, vDC.Ha, other.Ha)
+	}
+	if fieldPath, ok := d.field(path, "director_site", false); ok {
+		vDC.DirectorSite.diff(d, fieldPath, other.DirectorSite)
+	}
+	if fieldPath, ok := d.field(path, "edges", false); ok {
+		diffModels(d, fieldPath, vDC.Edges, other.Edges, (*Edge).diff)
+	}
+	if fieldPath, ok := d.field(path, "status_reasons", false); ok {
+		diffModels(d, fieldPath, vDC.StatusReasons, other.StatusReasons, (*StatusReason).diff)
+	}
+	if fieldPath, ok := d.field(path, "name", false); ok {
+		diffPointers(d, fieldPath, vDC.Name, other.Name)
+	}
+	if fieldPath, ok := d.field(path, "ordered_at", true); ok {
+		diffDateTimes(d, fieldPath, vDC.OrderedAt, other.OrderedAt)
+	}
+	if fieldPath, ok := d.field(path, "org_href", false); ok {
+		diffPointers(d, fieldPath, vDC.OrgHref, other.OrgHref)
+	}
+	if fieldPath, ok := d.field(path, "org_name", false); ok {
+		diffPointers(d, fieldPath, vDC.OrgName, other.OrgName)
+	}
+	if fieldPath, ok := d.field(path, "ram", false); ok {
+		diffPointers(d, fieldPath, vDC.Ram, other.Ram)
+	}
+	if fieldPath, ok := d.field(path, "status", false); ok {
+		diffPointers(d, fieldPath, vDC.Status, other.Status)
+	}
+	if fieldPath, ok := d.field(path, "type", false); ok {
+		diffPointers(d, fieldPath, vDC.Type, other.Type)
+	}
+	if fieldPath, ok := d.field(path, "fast_provisioning_enabled", false); ok {
+		diffPointers(d, fieldPath, vDC.FastProvisioningEnabled, other.FastProvisioningEnabled)
+	}
+	if fieldPath, ok := d.field(path, "rhel_byol", false); ok {
+		diffPointers(d, fieldPath, vDC.RhelByol, other.RhelByol)
+	}
+	if fieldPath, ok := d.field(path, "windows_byol", false); ok {
+		diffPointers(d, fieldPath, vDC.WindowsByol, other.WindowsByol)
+	}
+}
+
+// DeepCopy returns a copy of the VDCCollection that shares no memory with it.
+func (vDCCollection *VDCCollection) DeepCopy() *VDCCollection {
+	if vDCCollection == nil {
+		return nil
+	}
+	out := new(VDCCollection)
+	out.Vdcs = copyModels(vDCCollection.Vdcs, (*VDC).DeepCopy)
+	return out
+}
+
+// Equal returns true if the VDCCollection and "other" have the same fields, apart from those ignored by "options".
+func (vDCCollection *VDCCollection) Equal(other *VDCCollection, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	vDCCollection.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the VDCCollection that differ in "other", apart from those ignored by "options".
+func (vDCCollection *VDCCollection) Diff(other *VDCCollection, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	vDCCollection.diff(d, "", other)
+	return d.changes
+}
+
+func (vDCCollection *VDCCollection) diff(d *differ, path string, other *VDCCollection) {
+	if diffNil(d, path, vDCCollection, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "vdcs", false); ok {
+		diffModels(d, fieldPath, vDCCollection.Vdcs, other.Vdcs, (*VDC).diff)
+	}
+}
+
+// DeepCopy returns a copy of the VDCDirectorSite that shares no memory with it.
+func (vDCDirectorSite *VDCDirectorSite) DeepCopy() *VDCDirectorSite {
+	if vDCDirectorSite == nil {
+		return nil
+	}
+	out := new(VDCDirectorSite)
+	out.ID = copyPointer(vDCDirectorSite.ID)
+	out.Pvdc = vDCDirectorSite.Pvdc.DeepCopy()
+	out.URL = copyPointer(vDCDirectorSite.URL)
+	return out
+}
+
+// Equal returns true if the VDCDirectorSite and "other" have the same fields, apart from those ignored by "options".
+func (vDCDirectorSite *VDCDirectorSite) Equal(other *VDCDirectorSite, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	vDCDirectorSite.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the VDCDirectorSite that differ in "other", apart from those ignored by "options".
+func (vDCDirectorSite *VDCDirectorSite) Diff(other *VDCDirectorSite, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	vDCDirectorSite.diff(d, "", other)
+	return d.changes
+}
+
+func (vDCDirectorSite *VDCDirectorSite) diff(d *differ, path string, other *VDCDirectorSite) {
+	if diffNil(d, path, vDCDirectorSite, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "id", false); ok {
+		diffPointers(d, fieldPath, vDCDirectorSite.ID, other.ID)
+	}
+	if fieldPath, ok := d.field(path, "pvdc", false); ok {
+		vDCDirectorSite.Pvdc.diff(d, fieldPath, other.Pvdc)
+	}
+	if fieldPath, ok := d.field(path, "url", false); ok {
+		diffPointers(d, fieldPath, vDCDirectorSite.URL, other.URL)
+	}
+}
+
+// DeepCopy returns a copy of the VDCDirectorSitePrototype that shares no memory with it.
+func (vDCDirectorSitePrototype *VDCDirectorSitePrototype) DeepCopy() *VDCDirectorSitePrototype {
+	if vDCDirectorSitePrototype == nil {
+		return nil
+	}
+	out := new(VDCDirectorSitePrototype)
+	out.ID = copyPointer(vDCDirectorSitePrototype.ID)
+	out.Pvdc = vDCDirectorSitePrototype.Pvdc.DeepCopy()
+	return out
+}
+
+// Equal returns true if the VDCDirectorSitePrototype and "other" have the same fields, apart from those ignored by "options".
+func (vDCDirectorSitePrototype *VDCDirectorSitePrototype) Equal(other *VDCDirectorSitePrototype, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	vDCDirectorSitePrototype.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the VDCDirectorSitePrototype that differ in "other", apart from those ignored by "options".
+func (vDCDirectorSitePrototype *VDCDirectorSitePrototype) Diff(other *VDCDirectorSitePrototype, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	vDCDirectorSitePrototype.diff(d, "", other)
+	return d.changes
+}
+
+func (vDCDirectorSitePrototype *VDCDirectorSitePrototype) diff(d *differ, path string, other *VDCDirectorSitePrototype) {
+	if diffNil(d, path, vDCDirectorSitePrototype, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "id", false); ok {
+		diffPointers(d, fieldPath, vDCDirectorSitePrototype.ID, other.ID)
+	}
+	if fieldPath, ok := d.field(path, "pvdc", false); ok {
+		vDCDirectorSitePrototype.Pvdc.diff(d, fieldPath, other.Pvdc)
+	}
+}
+
+// DeepCopy returns a copy of the VDCEdgePrototype that shares no memory with it.
+func (vDCEdgePrototype *VDCEdgePrototype) DeepCopy() *VDCEdgePrototype {
+	if vDCEdgePrototype == nil {
+		return nil
+	}
+	out := new(VDCEdgePrototype)
+	out.Size = copyPointer(vDCEdgePrototype.Size)
+	out.Type = copyPointer(vDCEdgePrototype.Type)
+	out.PrivateOnly = copyPointer(vDCEdgePrototype.PrivateOnly)
+	out.NetworkHa = deepCopyVDCEdgePrototypeNetworkHaIntf(vDCEdgePrototype.NetworkHa)
+	return out
+}
+
+// Equal returns true if the VDCEdgePrototype and "other" have the same fields, apart from those ignored by "options".
+func (vDCEdgePrototype *VDCEdgePrototype) Equal(other *VDCEdgePrototype, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	vDCEdgePrototype.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the VDCEdgePrototype that differ in "other", apart from those ignored by "options".
+func (vDCEdgePrototype *VDCEdgePrototype) Diff(other *VDCEdgePrototype, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	vDCEdgePrototype.diff(d, "", other)
+	return d.changes
+}
+
+func (vDCEdgePrototype *VDCEdgePrototype) diff(d *differ, path string, other *VDCEdgePrototype) {
+	if diffNil(d, path, vDCEdgePrototype, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "size", false); ok {
+		diffPointers(d, fieldPath, vDCEdgePrototype.Size, other.Size)
+	}
+	if fieldPath, ok := d.field(path, "type", false); ok {
+		diffPointers(d, fieldPath, vDCEdgePrototype.Type, other.Type)
+	}
+	if fieldPath, ok := d.field(path, "private_only", false); ok {
+		diffPointers(d, fieldPath, vDCEdgePrototype.PrivateOnly, other.PrivateOnly)
+	}
+	if fieldPath, ok := d.field(path, "network_ha", false); ok {
+		diffVDCEdgePrototypeNetworkHaIntf(d, fieldPath, vDCEdgePrototype.NetworkHa, other.NetworkHa)
+	}
+}
+
+// DeepCopy returns a copy of the VDCEdgePrototypeNetworkHa that shares no memory with it.
+func (vDCEdgePrototypeNetworkHa *VDCEdgePrototypeNetworkHa) DeepCopy() *VDCEdgePrototypeNetworkHa {
+	if vDCEdgePrototypeNetworkHa == nil {
+		return nil
+	}
+	out := new(VDCEdgePrototypeNetworkHa)
+	out.PrimaryDataCenterName = copyPointer(vDCEdgePrototypeNetworkHa.PrimaryDataCenterName)
+	out.SecondaryDataCenterName = copyPointer(vDCEdgePrototypeNetworkHa.SecondaryDataCenterName)
+	out.SecondaryPvdcID = copyPointer(vDCEdgePrototypeNetworkHa.SecondaryPvdcID)
+	return out
+}
+
+// Equal returns true if the VDCEdgePrototypeNetworkHa and "other" have the same fields, apart from those ignored by "options".
+func (vDCEdgePrototypeNetworkHa *VDCEdgePrototypeNetworkHa) Equal(other *VDCEdgePrototypeNetworkHa, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	vDCEdgePrototypeNetworkHa.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the VDCEdgePrototypeNetworkHa that differ in "other", apart from those ignored by "options".
+func (vDCEdgePrototypeNetworkHa *VDCEdgePrototypeNetworkHa) Diff(other *VDCEdgePrototypeNetworkHa, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	vDCEdgePrototypeNetworkHa.diff(d, "", other)
+	return d.changes
+}
+
+func (vDCEdgePrototypeNetworkHa *VDCEdgePrototypeNetworkHa) diff(d *differ, path string, other *VDCEdgePrototypeNetworkHa) {
+	if diffNil(d, path, vDCEdgePrototypeNetworkHa, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "primary_data_center_name", false); ok {
+		diffPointers(d, fieldPath, vDCEdgePrototypeNetworkHa.PrimaryDataCenterName, other.PrimaryDataCenterName)
+	}
+	if fieldPath, ok := d.field(path, "secondary_data_center_name", false); ok {
+		diffPointers(d, fieldPath, vDCEdgePrototypeNetworkHa.SecondaryDataCenterName, other.SecondaryDataCenterName)
+	}
+	if fieldPath, ok := d.field(path, "secondary_pvdc_id", false); ok {
+		diffPointers(d, fieldPath, vDCEdgePrototypeNetworkHa.SecondaryPvdcID, other.SecondaryPvdcID)
+	}
+}
+
+// DeepCopy returns a copy of the VDCEdgePrototypeNetworkHaNetworkHaOnNonStretched that shares no memory with it.
+func (vDCEdgePrototypeNetworkHaNetworkHaOnNonStretched *VDCEdgePrototypeNetworkHaNetworkHaOnNonStretched) DeepCopy() *VDCEdgePrototypeNetworkHaNetworkHaOnNonStretched {
+	if vDCEdgePrototypeNetworkHaNetworkHaOnNonStretched == nil {
+		return nil
+	}
+	out := new(VDCEdgePrototypeNetworkHaNetworkHaOnNonStretched)
+	out.SecondaryPvdcID = copyPointer(vDCEdgePrototypeNetworkHaNetworkHaOnNonStretched.SecondaryPvdcID)
+	return out
+}
+
+// Equal returns true if the VDCEdgePrototypeNetworkHaNetworkHaOnNonStretched and "other" have the same fields, apart from those ignored by "options".
+func (vDCEdgePrototypeNetworkHaNetworkHaOnNonStretched *VDCEdgePrototypeNetworkHaNetworkHaOnNonStretched) Equal(other *VDCEdgePrototypeNetworkHaNetworkHaOnNonStretched, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	vDCEdgePrototypeNetworkHaNetworkHaOnNonStretched.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the VDCEdgePrototypeNetworkHaNetworkHaOnNonStretched that differ in "other", apart from those ignored by "options".
+func (vDCEdgePrototypeNetworkHaNetworkHaOnNonStretched *VDCEdgePrototypeNetworkHaNetworkHaOnNonStretched) Diff(other *VDCEdgePrototypeNetworkHaNetworkHaOnNonStretched, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	vDCEdgePrototypeNetworkHaNetworkHaOnNonStretched.diff(d, "", other)
+	return d.changes
+}
+
+func (vDCEdgePrototypeNetworkHaNetworkHaOnNonStretched *VDCEdgePrototypeNetworkHaNetworkHaOnNonStretched) diff(d *differ, path string, other *VDCEdgePrototypeNetworkHaNetworkHaOnNonStretched) {
+	if diffNil(d, path, vDCEdgePrototypeNetworkHaNetworkHaOnNonStretched, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "secondary_pvdc_id", false); ok {
+		diffPointers(d, fieldPath, vDCEdgePrototypeNetworkHaNetworkHaOnNonStretched.SecondaryPvdcID, other.SecondaryPvdcID)
+	}
+}
+
+// DeepCopy returns a copy of the VDCEdgePrototypeNetworkHaNetworkHaOnStretched that shares no memory with it.
+func (vDCEdgePrototypeNetworkHaNetworkHaOnStretched *VDCEdgePrototypeNetworkHaNetworkHaOnStretched) DeepCopy() *VDCEdgePrototypeNetworkHaNetworkHaOnStretched {
+	if vDCEdgePrototypeNetworkHaNetworkHaOnStretched == nil {
+		return nil
+	}
+	out := new(VDCEdgePrototypeNetworkHaNetworkHaOnStretched)
+	out.PrimaryDataCenterName = copyPointer(vDCEdgePrototypeNetworkHaNetworkHaOnStretched.PrimaryDataCenterName)
+	out.SecondaryDataCenterName = copyPointer(vDCEdgePrototypeNetworkHaNetworkHaOnStretched.SecondaryDataCenterName)
+	return out
+}
+
+// Equal returns true if the VDCEdgePrototypeNetworkHaNetworkHaOnStretched and "other" have the same fields, apart from those ignored by "options".
+func (vDCEdgePrototypeNetworkHaNetworkHaOnStretched *VDCEdgePrototypeNetworkHaNetworkHaOnStretched) Equal(other *VDCEdgePrototypeNetworkHaNetworkHaOnStretched, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	vDCEdgePrototypeNetworkHaNetworkHaOnStretched.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the VDCEdgePrototypeNetworkHaNetworkHaOnStretched that differ in "other", apart from those ignored by "options".
+func (vDCEdgePrototypeNetworkHaNetworkHaOnStretched *VDCEdgePrototypeNetworkHaNetworkHaOnStretched) Diff(other *VDCEdgePrototypeNetworkHaNetworkHaOnStretched, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	vDCEdgePrototypeNetworkHaNetworkHaOnStretched.diff(d, "", other)
+	return d.changes
+}
+
+func (vDCEdgePrototypeNetworkHaNetworkHaOnStretched *VDCEdgePrototypeNetworkHaNetworkHaOnStretched) diff(d *differ, path string, other *VDCEdgePrototypeNetworkHaNetworkHaOnStretched) {
+	if diffNil(d, path, vDCEdgePrototypeNetworkHaNetworkHaOnStretched, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "primary_data_center_name", false); ok {
+		diffPointers(d, fieldPath, vDCEdgePrototypeNetworkHaNetworkHaOnStretched.PrimaryDataCenterName, other.PrimaryDataCenterName)
+	}
+	if fieldPath, ok := d.field(path, "secondary_data_center_name", false); ok {
+		diffPointers(d, fieldPath, vDCEdgePrototypeNetworkHaNetworkHaOnStretched.SecondaryDataCenterName, other.SecondaryDataCenterName)
+	}
+}
+
+// DeepCopy returns a copy of the VDCPatch that shares no memory with it.
+func (vDCPatch *VDCPatch) DeepCopy() *VDCPatch {
+	if vDCPatch == nil {
+		return nil
+	}
+	out := new(VDCPatch)
+	out.Cpu = copyPointer(vDCPatch.Cpu)
+	out.FastProvisioningEnabled = copyPointer(vDCPatch.FastProvisioningEnabled)
+	out.Ram = copyPointer(vDCPatch.Ram)
+	return out
+}
+
+// Equal returns true if the VDCPatch and "other" have the same fields, apart from those ignored by "options".
+func (vDCPatch *VDCPatch) Equal(other *VDCPatch, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	vDCPatch.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the VDCPatch that differ in "other", apart from those ignored by "options".
+func (vDCPatch *VDCPatch) Diff(other *VDCPatch, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	vDCPatch.diff(d, "", other)
+	return d.changes
+}
+
+func (vDCPatch *VDCPatch) diff(d *differ, path string, other *VDCPatch) {
+	if diffNil(d, path, vDCPatch, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "cpu", false); ok {
+		diffPointers(d, fieldPath, vDCPatch.Cpu, other.Cpu)
+	}
+	if fieldPath, ok := d.field(path, "fast_provisioning_enabled", false); ok {
+		diffPointers(d, fieldPath, vDCPatch.FastProvisioningEnabled, other.FastProvisioningEnabled)
+	}
+	if fieldPath, ok := d.field(path, "ram", false); ok {
+		diffPointers(d, fieldPath, vDCPatch.Ram, other.Ram)
+	}
+}
+
+// DeepCopy returns a copy of the VDCProviderType that shares no memory with it.
+func (vDCProviderType *VDCProviderType) DeepCopy() *VDCProviderType {
+	if vDCProviderType == nil {
+		return nil
+	}
+	out := new(VDCProviderType)
+	out.Name = copyPointer(vDCProviderType.Name)
+	return out
+}
+
+// Equal returns true if the VDCProviderType and "other" have the same fields, apart from those ignored by "options".
+func (vDCProviderType *VDCProviderType) Equal(other *VDCProviderType, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	vDCProviderType.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the VDCProviderType that differ in "other", apart from those ignored by "options".
+func (vDCProviderType *VDCProviderType) Diff(other *VDCProviderType, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	vDCProviderType.diff(d, "", other)
+	return d.changes
+}
+
+func (vDCProviderType *VDCProviderType) diff(d *differ, path string, other *VDCProviderType) {
+	if diffNil(d, path, vDCProviderType, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "name", false); ok {
+		diffPointers(d, fieldPath, vDCProviderType.Name, other.Name)
+	}
+}
+
+// DeepCopy returns a copy of the VcdaC2c that shares no memory with it.
+func (vcdaC2c *VcdaC2c) DeepCopy() *VcdaC2c {
+	if vcdaC2c == nil {
+		return nil
+	}
+	out := new(VcdaC2c)
+	out.ID = copyPointer(vcdaC2c.ID)
+	out.Status = copyPointer(vcdaC2c.Status)
+	out.PeerOffering = copyPointer(vcdaC2c.PeerOffering)
+	out.LocalDataCenterName = copyPointer(vcdaC2c.LocalDataCenterName)
+	out.LocalSiteName = copyPointer(vcdaC2c.LocalSiteName)
+	out.PeerSiteName = copyPointer(vcdaC2c.PeerSiteName)
+	out.PeerRegion = copyPointer(vcdaC2c.PeerRegion)
+	out.Note = copyPointer(vcdaC2c.Note)
+	return out
+}
+
+// Equal returns true if the VcdaC2c and "other" have the same fields, apart from those ignored by "options".
+func (vcdaC2c *VcdaC2c) Equal(other *VcdaC2c, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	vcdaC2c.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the VcdaC2c that differ in "other", apart from those ignored by "options".
+func (vcdaC2c *VcdaC2c) Diff(other *VcdaC2c, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	vcdaC2c.diff(d, "", other)
+	return d.changes
+}
+
+func (vcdaC2c *VcdaC2c) diff(d *differ, path string, other *VcdaC2c) {
+	if diffNil(d, path, vcdaC2c, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "id", false); ok {
+		diffPointers(d, fieldPath, vcdaC2c.ID, other.ID)
+	}
+	if fieldPath, ok := d.field(path, "status", false); ok {
+		diffPointers(d, fieldPath, vcdaC2c.Status, other.Status)
+	}
+	if fieldPath, ok := d.field(path, "peer_offering", false); ok {
+		diffPointers(d, fieldPath, vcdaC2c.PeerOffering, other.PeerOffering)
+	}
+	if fieldPath, ok := d.field(path, "local_data_center_name", false); ok {
+		diffPointers(d, fieldPath, vcdaC2c.LocalDataCenterName, other.LocalDataCenterName)
+	}
+	if fieldPath, ok := d.field(path, "local_site_name", false); ok {
+		diffPointers(d, fieldPath, vcdaC2c.LocalSiteName, other.LocalSiteName)
+	}
+	if fieldPath, ok := d.field(path, "peer_site_name", false); ok {
+		diffPointers(d, fieldPath, vcdaC2c.PeerSiteName, other.PeerSiteName)
+	}
+	if fieldPath, ok := d.field(path, "peer_region", false); ok {
+		diffPointers(d, fieldPath, vcdaC2c.PeerRegion, other.PeerRegion)
+	}
+	if fieldPath, ok := d.field(path, "note", false); ok {
+		diffPointers(d, fieldPath, vcdaC2c.Note, other.Note)
+	}
+}
+
+// DeepCopy returns a copy of the VcdaConnection that shares no memory with it.
+func (vcdaConnection *VcdaConnection) DeepCopy() *VcdaConnection {
+	if vcdaConnection == nil {
+		return nil
+	}
+	out := new(VcdaConnection)
+	out.ID = copyPointer(vcdaConnection.ID)
+	out.Status = copyPointer(vcdaConnection.Status)
+	out.Type = copyPointer(vcdaConnection.Type)
+	out.Speed = copyPointer(vcdaConnection.Speed)
+	out.DataCenterName = copyPointer(vcdaConnection.DataCenterName)
+	out.AllowList = copyValues(vcdaConnection.AllowList)
+	return out
+}
+
+// Equal returns true if the VcdaConnection and "other" have the same fields, apart from those ignored by "options".
+func (vcdaConnection *VcdaConnection) Equal(other *VcdaConnection, options ...*DiffOptions) bool {
+	d := newDiffer(options, true)
+	vcdaConnection.diff(d, "", other)
+	return !d.differs
+}
+
+// Diff returns the fields of the VcdaConnection that differ in "other", apart from those ignored by "options".
+func (vcdaConnection *VcdaConnection) Diff(other *VcdaConnection, options ...*DiffOptions) []FieldChange {
+	d := newDiffer(options, false)
+	vcdaConnection.diff(d, "", other)
+	return d.changes
+}
+
+func (vcdaConnection *VcdaConnection) diff(d *differ, path string, other *VcdaConnection) {
+	if diffNil(d, path, vcdaConnection, other) {
+		return
+	}
+	if fieldPath, ok := d.field(path, "id", false); ok {
+		diffPointers(d, fieldPath, vcdaConnection.ID, other.ID)
+	}
+	if fieldPath, ok := d.field(path, "status", false); ok {
+		diffPointers(d, fieldPath, vcdaConnection.Status, other.Status)
+	}
+	if fieldPath, ok := d.field(path, "type", false); ok {
+		diffPointers(d, fieldPath, vcdaConnection.Type, other.Type)
+	}
+	if fieldPath, ok := d.field(path, "speed", false); ok {
+		diffPointers(d, fieldPath, vcdaConnection.Speed, other.Speed)
+	}
+	if fieldPath, ok := d.field(path, "data_center_name", false); ok {
+		diffPointers(d, fieldPath, vcdaConnection.DataCenterName, other.DataCenterName)
+	}
+	if fieldPath, ok := d.field(path, "allow_list", false); ok {
+		diffValues(d, fieldPath, vcdaConnection.AllowList, other.AllowList)
+	}
+}
+
+// deepCopyVDCEdgePrototypeNetworkHaIntf returns a copy of "in", whatever its implementation.
+func deepCopyVDCEdgePrototypeNetworkHaIntf(in VDCEdgePrototypeNetworkHaIntf) VDCEdgePrototypeNetworkHaIntf {
+	switch in := in.(type) {
+	case *VDCEdgePrototypeNetworkHa:
+		if in != nil {
+			return in.DeepCopy()
+		}
+	case *VDCEdgePrototypeNetworkHaNetworkHaOnNonStretched:
+		if in != nil {
+			return in.DeepCopy()
+		}
+	case *VDCEdgePrototypeNetworkHaNetworkHaOnStretched:
+		if in != nil {
+			return in.DeepCopy()
+		}
+	}
+	return in
+}
+
+// diffVDCEdgePrototypeNetworkHaIntf compares "old" and "other", which must have the same implementation to be equal.
+func diffVDCEdgePrototypeNetworkHaIntf(d *differ, path string, old VDCEdgePrototypeNetworkHaIntf, other VDCEdgePrototypeNetworkHaIntf) {
+	switch old := old.(type) {
+	case nil:
+		if other == nil {
+			return
+		}
+	case *VDCEdgePrototypeNetworkHa:
+		if other, ok := other.(*VDCEdgePrototypeNetworkHa); ok {
+			old.diff(d, path, other)
+			return
+		}
+	case *VDCEdgePrototypeNetworkHaNetworkHaOnNonStretched:
+		if other, ok := other.(*VDCEdgePrototypeNetworkHaNetworkHaOnNonStretched); ok {
+			old.diff(d, path, other)
+			return
+		}
+	case *VDCEdgePrototypeNetworkHaNetworkHaOnStretched:
+		if other, ok := other.(*VDCEdgePrototypeNetworkHaNetworkHaOnStretched); ok {
+			old.diff(d, path, other)
+			return
+		}
+	}
+	d.change(path, old, other)
+}
+
+// allModels has the zero value of every model, so that their fields can be inspected with reflection.
+var allModels = []interface{}{
+	Cluster{},
+	ClusterCollection{},
+	ClusterPatch{},
+	ClusterPrototype{},
+	ClusterSummary{},
+	DataCenter{},
+	DirectorSite{},
+	DirectorSiteCollection{},
+	DirectorSiteHostProfile{},
+	DirectorSiteHostProfileCollection{},
+	DirectorSitePVDC{},
+	DirectorSitePVDCResponse{},
+	DirectorSiteReference{},
+	DirectorSiteRegion{},
+	DirectorSiteRegionCollection{},
+	Edge{},
+	FileShares{},
+	FileSharesPrototype{},
+	License{},
+	LicenseCollection{},
+	LicenseKey{},
+	MultitenantDirectorSite{},
+	MultitenantDirectorSiteCollection{},
+	MultitenantPVDC{},
+	OIDC{},
+	PVDC{},
+	PVDCCollection{},
+	PVDCPrototype{},
+	ProviderType{},
+	ResourceGroupIdentity{},
+	ResourceGroupReference{},
+	Service{},
+	ServiceEnabled{},
+	ServiceIdentity{},
+	Sobr{},
+	StatusReason{},
+	SwapHaEdgeSitesResponse{},
+	TransitGateway{},
+	TransitGatewayConnection{},
+	UpdateCluster{},
+	UpdatedVcdaC2c{},
+	UpdatedVcdaConnection{},
+	UsageMeter{},
+	UsageMeterIdentity{},
+	UsageMeterRegistration{},
+	UsageMeterRegistrationCollection{},
+	VDC{},
+	VDCCollection{},
+	VDCDirectorSite{},
+	VDCDirectorSitePrototype{},
+	VDCEdgePrototype{},
+	VDCEdgePrototypeNetworkHa{},
+	VDCEdgePrototypeNetworkHaNetworkHaOnNonStretched{},
+	VDCEdgePrototypeNetworkHaNetworkHaOnStretched{},
+	VDCPatch{},
+	VDCProviderType{},
+	VcdaC2c{},
+	VcdaConnection{},
+}
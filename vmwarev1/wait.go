@@ -0,0 +1,93 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vmwarev1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vmware-go-sdk/common"
+)
+
+// DefaultPollInterval is the default interval between two polls of a resource that is settling.
+const DefaultPollInterval = 30 * time.Second
+
+// DefaultWaitTimeout is the default maximum time to wait for a resource to settle.
+const DefaultWaitTimeout = 4 * time.Hour
+
+// WaitOptions : Options that control how a workflow polls a resource until it settles.
+type WaitOptions struct {
+	// The interval between two polls. If zero, DefaultPollInterval is used.
+	PollInterval time.Duration
+
+	// The maximum time to wait. If zero, DefaultWaitTimeout is used. A context deadline that expires sooner takes
+	// precedence.
+	Timeout time.Duration
+}
+
+// NewWaitOptions : Instantiate WaitOptions
+func (*VmwareV1) NewWaitOptions(pollInterval time.Duration, timeout time.Duration) *WaitOptions {
+	return &WaitOptions{
+		PollInterval: pollInterval,
+		Timeout:      timeout,
+	}
+}
+
+func (waitOptions *WaitOptions) pollInterval() time.Duration {
+	if waitOptions == nil || waitOptions.PollInterval <= 0 {
+		return DefaultPollInterval
+	}
+	return waitOptions.PollInterval
+}
+
+func (waitOptions *WaitOptions) timeout() time.Duration {
+	if waitOptions == nil || waitOptions.Timeout <= 0 {
+		return DefaultWaitTimeout
+	}
+	return waitOptions.Timeout
+}
+
+// waitUntil invokes "check" until it reports that the awaited condition is met, it fails, the timeout in
+// "waitOptions" elapses or "ctx" is done. "description" names the awaited condition in the timeout error.
+func waitUntil(ctx context.Context, waitOptions *WaitOptions, description string, check func(ctx context.Context) (bool, error)) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, waitOptions.timeout())
+	defer cancel()
+
+	ticker := time.NewTicker(waitOptions.pollInterval())
+	defer ticker.Stop()
+
+	for {
+		var done bool
+		done, err = check(ctx)
+		if err != nil || done {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			if ctx.Err() == context.DeadlineExceeded {
+				err = core.SDKErrorf(ctx.Err(), fmt.Sprintf("timed out waiting for %s", description), "wait-timeout", common.GetComponentInfo())
+			} else {
+				err = core.SDKErrorf(ctx.Err(), "", "wait-canceled", common.GetComponentInfo())
+			}
+			return
+		case <-ticker.C:
+		}
+	}
+}